@@ -0,0 +1,4 @@
+resource "okta_account_recovery" "test" {
+  sms_recovery         = "ACTIVE"
+  recovery_email_token = 60
+}
@@ -0,0 +1,4 @@
+resource "okta_account_recovery" "test" {
+  sms_recovery         = "INACTIVE"
+  recovery_email_token = 120
+}
@@ -0,0 +1,5 @@
+resource "okta_admin_role_custom" "test" {
+  label       = "testAccUpdated_replace_with_uuid"
+  description = "testing, testing updated"
+  permissions = ["okta.users.read", "okta.users.manage", "okta.groups.manage"]
+}
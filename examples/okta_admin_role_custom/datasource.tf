@@ -0,0 +1,9 @@
+resource "okta_admin_role_custom" "test" {
+  label       = "testAcc_replace_with_uuid"
+  description = "testing, testing"
+  permissions = ["okta.users.read", "okta.groups.read"]
+}
+
+data "okta_admin_role_custom" "test" {
+  label = okta_admin_role_custom.test.label
+}
@@ -0,0 +1,34 @@
+resource "okta_group" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "testing, testing"
+}
+
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc_replace_with_uuid@example.com"
+  email      = "testAcc_replace_with_uuid@example.com"
+}
+
+resource "okta_resource_set" "test" {
+  label       = "testAcc_replace_with_uuid"
+  description = "testing, testing"
+  resources   = [
+    "https://your.okta.org/api/v1/users",
+  ]
+}
+
+resource "okta_admin_role_custom" "test" {
+  label       = "testAcc_replace_with_uuid"
+  description = "testing, testing"
+  permissions = ["okta.users.read"]
+}
+
+resource "okta_admin_role_custom_assignments" "test" {
+  resource_set_id = okta_resource_set.test.id
+  custom_role_id  = okta_admin_role_custom.test.id
+  members         = [
+    "https://your.okta.org/api/v1/users/${okta_user.test.id}",
+    "https://your.okta.org/api/v1/groups/${okta_group.test.id}",
+  ]
+}
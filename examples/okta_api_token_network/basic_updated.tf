@@ -0,0 +1,9 @@
+resource "okta_network_zone" "test" {
+  name     = "testAcc_replace_with_uuid"
+  type     = "IP"
+  gateways = ["192.0.2.0/24"]
+}
+
+resource "okta_api_token_network" "test" {
+  network_connection = "ANYWHERE"
+}
@@ -19,3 +19,7 @@ data "okta_app" "test2" {
 data "okta_app" "test3" {
   label_prefix = okta_app_oauth.test.label
 }
+
+data "okta_app" "test4" {
+  client_id = okta_app_oauth.test.client_id
+}
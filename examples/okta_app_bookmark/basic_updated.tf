@@ -14,6 +14,10 @@ resource "okta_app_bookmark" "test" {
   label = "testAcc_replace_with_uuid"
   url   = "https://test.com"
 
+  app_links_json = jsonencode({
+    login = false
+  })
+
   users {
     id       = okta_user.user.id
     username = okta_user.user.email
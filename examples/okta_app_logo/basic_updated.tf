@@ -0,0 +1,9 @@
+resource "okta_app_bookmark" "test" {
+  label = "testAcc_replace_with_uuid"
+  url   = "https://test.com"
+}
+
+resource "okta_app_logo" "test" {
+  app_id = okta_app_bookmark.test.id
+  file   = "../examples/okta_app_logo/terraform_icon_updated.png"
+}
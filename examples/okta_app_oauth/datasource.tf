@@ -17,3 +17,7 @@ data "okta_app_oauth" "test" {
 data "okta_app_oauth" "test_label" {
   label = okta_app_oauth.test.label
 }
+
+data "okta_app_oauth" "test_client_id" {
+  client_id = okta_app_oauth.test.client_id
+}
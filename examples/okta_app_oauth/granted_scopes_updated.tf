@@ -0,0 +1,8 @@
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "service"
+  response_types = ["token"]
+  grant_types    = ["client_credentials"]
+  redirect_uris  = ["http://d.com/"]
+  granted_scopes = ["okta.users.read"]
+}
@@ -0,0 +1,10 @@
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "web"
+  grant_types    = ["implicit", "authorization_code"]
+  redirect_uris  = ["http://d.com/"]
+  response_types = ["code", "token", "id_token"]
+  login_uri      = "http://d.com/login"
+  login_mode     = "OKTA"
+  login_scopes   = ["openid", "profile"]
+}
@@ -0,0 +1,16 @@
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "native"
+  grant_types    = ["authorization_code"]
+  redirect_uris  = ["com.example.app:/callback"]
+  response_types = ["code"]
+  issuer_mode    = "ORG_URL"
+  consent_method = "REQUIRED"
+  tos_uri        = "https://example.com/tos"
+  policy_uri     = "https://example.com/policy"
+  hide_ios       = false
+  hide_web       = true
+  app_links_json = jsonencode({
+    oidc_client_link = true
+  })
+}
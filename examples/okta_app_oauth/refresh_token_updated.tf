@@ -0,0 +1,9 @@
+resource "okta_app_oauth" "test" {
+  label                  = "testAcc_replace_with_uuid"
+  type                   = "web"
+  grant_types            = ["authorization_code", "refresh_token"]
+  redirect_uris          = ["http://d.com/"]
+  response_types         = ["code"]
+  refresh_token_rotation = "STATIC"
+  refresh_token_leeway   = 10
+}
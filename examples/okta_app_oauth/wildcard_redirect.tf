@@ -0,0 +1,8 @@
+resource "okta_app_oauth" "test" {
+  label             = "testAcc_replace_with_uuid"
+  type              = "web"
+  grant_types       = ["authorization_code"]
+  redirect_uris     = ["https://*.d.com/callback"]
+  response_types    = ["code"]
+  wildcard_redirect = "SUBDOMAIN"
+}
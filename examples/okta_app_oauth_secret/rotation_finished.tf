@@ -0,0 +1,14 @@
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "service"
+  response_types = ["token"]
+  grant_types    = ["client_credentials"]
+  redirect_uris  = ["http://d.com/"]
+}
+
+resource "okta_app_oauth_secret" "test" {
+  app_id           = okta_app_oauth.test.id
+  revoke_unmanaged = true
+  rotate_secret_on = "2"
+  retain_previous  = false
+}
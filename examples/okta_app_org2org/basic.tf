@@ -0,0 +1,4 @@
+resource "okta_app_org2org" "test" {
+  label    = "testAcc_replace_with_uuid"
+  base_url = "https://testAcc-replace_with_uuid.okta.com"
+}
@@ -0,0 +1,11 @@
+resource "okta_group" "group" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_app_org2org" "test" {
+  label                = "testAcc_replace_with_uuid Updated"
+  base_url             = "https://testAcc-replace_with_uuid.okta.com"
+  acs_url              = "https://testAcc-replace_with_uuid.okta.com/sso/saml2/0oa1234567890abcdef"
+  audience_restriction = "https://www.okta.com/saml2/service-provider/spaaaaaaaaaaaaaaaaaa"
+  groups               = [okta_group.group.id]
+}
@@ -0,0 +1,13 @@
+variable "spoke_api_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "okta_app_org2org" "example" {
+  label                         = "Spoke Org"
+  base_url                      = "https://spoke.okta.com"
+  api_token                     = var.spoke_api_token
+  provisioning_create_users     = true
+  provisioning_update_profiles  = true
+  provisioning_deactivate_users = true
+}
@@ -0,0 +1,14 @@
+resource "okta_app_saml" "test" {
+  label                    = "testAcc_replace_with_uuid"
+  sso_url                  = "http://google.com"
+  recipient                = "http://here.com"
+  destination              = "http://its-about-the-journey.com"
+  audience                 = "http://audience.com"
+  subject_name_id_template = "$${user.userName}"
+  subject_name_id_format   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  signature_algorithm      = "RSA_SHA256"
+  digest_algorithm         = "SHA256"
+  key_years_valid          = 2
+  key_name                 = "first"
+  next_key_name            = "second"
+}
@@ -0,0 +1,12 @@
+resource "okta_app_saml" "test" {
+  preconfigured_app = "amazon_aws"
+  label             = "testAcc_replace_with_uuid"
+
+  preconfigured_app_settings {
+    amazon_aws {
+      identity_provider_arn = "arn:aws:iam::123456789012:saml-provider/okta"
+      session_duration      = 7200
+      join_all_roles        = true
+    }
+  }
+}
@@ -0,0 +1,13 @@
+resource "okta_app_signon_policy" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "Terraform Acceptance Test App Sign-On Policy"
+}
+
+resource "okta_app_oauth" "test" {
+  label                 = "testAcc_replace_with_uuid"
+  type                  = "web"
+  grant_types           = ["authorization_code"]
+  redirect_uris         = ["https://example.com/"]
+  response_types        = ["code"]
+  authentication_policy = okta_app_signon_policy.test.id
+}
@@ -0,0 +1,4 @@
+resource "okta_app_signon_policy" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "Terraform Acceptance Test App Sign-On Policy"
+}
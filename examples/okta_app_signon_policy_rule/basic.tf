@@ -0,0 +1,9 @@
+resource "okta_app_signon_policy" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "Terraform Acceptance Test App Sign-On Policy"
+}
+
+resource "okta_app_signon_policy_rule" "test" {
+  policyid = okta_app_signon_policy.test.id
+  name     = "testAcc_replace_with_uuid"
+}
@@ -0,0 +1,25 @@
+data "okta_group" "all" {
+  name = "Everyone"
+}
+
+resource "okta_app_signon_policy" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "Terraform Acceptance Test App Sign-On Policy"
+}
+
+resource "okta_app_signon_policy_rule" "test" {
+  policyid                    = okta_app_signon_policy.test.id
+  name                        = "testAcc_replace_with_uuid"
+  groups_included             = [data.okta_group.all.id]
+  device_is_registered        = true
+  device_is_managed           = false
+  factor_mode                 = "1FA"
+  re_authentication_frequency = "PT43800H"
+  constraints = [
+    jsonencode({
+      knowledge = {
+        types = ["password"]
+      }
+    })
+  ]
+}
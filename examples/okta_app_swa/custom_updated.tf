@@ -5,4 +5,8 @@ resource "okta_app_swa" "test" {
   password_field = "txtbox-password-updated"
   username_field = "txtbox-username-updated"
   url            = "https://example.com/login-updated.html"
+
+  accessibility_self_service       = true
+  accessibility_error_redirect_url = "https://example.com/error.html"
+  accessibility_login_redirect_url = "https://example.com/login.html"
 }
@@ -0,0 +1,23 @@
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "native"
+  grant_types    = ["authorization_code"]
+  redirect_uris  = ["http://d.com/"]
+  response_types = ["code"]
+}
+
+resource "okta_app_user_schema" "test" {
+  app_id      = okta_app_oauth.test.id
+  index       = "testAcc_replace_with_uuid"
+  title       = "terraform acceptance test"
+  type        = "string"
+  description = "terraform acceptance test updated"
+  required    = true
+  master      = "OVERRIDE"
+  scope       = "SELF"
+
+  master_override_priority {
+    type  = "APP"
+    value = okta_app_oauth.test.id
+  }
+}
@@ -0,0 +1,11 @@
+resource "okta_group" "group" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_app_ws_federation" "test" {
+  label     = "testAcc_replace_with_uuid"
+  site_url  = "https://sharepoint.example.com"
+  reply_url = "https://sharepoint.example.com/_trust/"
+  realm     = "urn:sharepoint:testAcc_replace_with_uuid"
+  groups    = [okta_group.group.id]
+}
@@ -0,0 +1,24 @@
+resource "okta_user" "user" {
+  admin_roles = ["APP_ADMIN", "USER_ADMIN"]
+  first_name  = "TestAcc"
+  last_name   = "blah"
+  login       = "testAcc-replace_with_uuid@example.com"
+  email       = "testAcc-replace_with_uuid@example.com"
+}
+
+resource "okta_app_ws_federation" "test" {
+  label                = "testAcc_replace_with_uuid"
+  site_url             = "https://sharepoint.example.com"
+  reply_url            = "https://sharepoint.example.com/_trust/"
+  reply_override       = true
+  realm                = "urn:sharepoint:testAcc_replace_with_uuid"
+  group_name           = "http://schemas.microsoft.com/ws/2008/06/identity/claims/role"
+  group_filter         = "app1.*"
+  group_value_format   = "dn"
+  attribute_statements = "givenname|$${user.firstName}|,surname|$${user.lastName}|"
+
+  users {
+    id       = okta_user.user.id
+    username = okta_user.user.email
+  }
+}
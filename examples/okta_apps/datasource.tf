@@ -0,0 +1,11 @@
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "web"
+  grant_types    = ["authorization_code"]
+  redirect_uris  = ["http://d.com/"]
+  response_types = ["code"]
+}
+
+data "okta_apps" "test" {
+  q = okta_app_oauth.test.label
+}
@@ -0,0 +1,34 @@
+# Copies the bookmark apps from the preview org to the production org.
+provider "okta" {
+  alias    = "preview"
+  org_name = "example"
+  base_url = "oktapreview.com"
+}
+
+provider "okta" {
+  alias    = "production"
+  org_name = "example"
+  base_url = "okta.com"
+}
+
+data "okta_apps" "preview" {
+  provider = okta.preview
+  q        = "Example"
+}
+
+locals {
+  bookmarks = {
+    for app in data.okta_apps.preview.apps : app.label => jsondecode(app.json)
+    if app.name == "bookmark"
+  }
+}
+
+resource "okta_app_bookmark" "production" {
+  provider = okta.production
+  for_each = local.bookmarks
+
+  label    = each.key
+  url      = each.value.settings.app.url
+  hide_ios = each.value.visibility.hide.iOS
+  hide_web = each.value.visibility.hide.web
+}
@@ -0,0 +1,3 @@
+data "okta_auth_server_metadata" "test" {
+  auth_server_id = "default"
+}
@@ -1,5 +1,12 @@
-data "okta_group" "all" {
-  name = "Everyone"
+resource "okta_group" "test" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
 }
 
 resource "okta_auth_server_policy_rule" "test" {
@@ -8,7 +15,8 @@ resource "okta_auth_server_policy_rule" "test" {
   status               = "ACTIVE"
   name                 = "test_updated"
   priority             = 1
-  group_whitelist      = [data.okta_group.all.id]
+  group_whitelist      = [okta_group.test.id]
+  user_whitelist       = [okta_user.test.id]
   grant_type_whitelist = ["password"]
 }
 
@@ -0,0 +1,2 @@
+resource "okta_brand" "test" {
+}
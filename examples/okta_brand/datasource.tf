@@ -0,0 +1,2 @@
+data "okta_brand" "test" {
+}
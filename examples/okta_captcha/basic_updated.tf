@@ -0,0 +1,6 @@
+resource "okta_captcha" "test" {
+  name       = "testAcc_replace_with_uuid_updated"
+  type       = "RECAPTCHA_V2"
+  site_key   = "random_key_updated"
+  secret_key = "random_key_updated"
+}
@@ -0,0 +1,7 @@
+data "okta_brand" "test" {
+}
+
+resource "okta_domain" "test" {
+  name     = "testacc-replace_with_uuid.example.com"
+  brand_id = data.okta_brand.test.id
+}
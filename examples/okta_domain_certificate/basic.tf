@@ -0,0 +1,11 @@
+resource "okta_domain" "test" {
+  name                    = "testacc-replace_with_uuid.example.com"
+  certificate_source_type = "MANUAL"
+}
+
+resource "okta_domain_certificate" "test" {
+  domain_id         = okta_domain.test.id
+  certificate       = file("certificate.pem")
+  certificate_chain = file("chain.pem")
+  private_key       = file("private_key.pem")
+}
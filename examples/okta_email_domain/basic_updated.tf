@@ -0,0 +1,9 @@
+data "okta_brand" "test" {
+}
+
+resource "okta_email_domain" "test" {
+  brand_id     = data.okta_brand.test.id
+  domain       = "testacc-replace_with_uuid.example.com"
+  display_name = "Test Acc Updated"
+  user_name    = "notifications"
+}
@@ -0,0 +1,23 @@
+data "okta_brand" "example" {
+}
+
+resource "okta_email_domain" "example" {
+  brand_id     = data.okta_brand.example.id
+  domain       = "example.com"
+  display_name = "Example"
+  user_name    = "no-reply"
+}
+
+resource "aws_route53_record" "example" {
+  count   = length(okta_email_domain.example.dns_validation_records)
+  zone_id = "<zone id>"
+  name    = okta_email_domain.example.dns_validation_records[count.index].fqdn
+  type    = okta_email_domain.example.dns_validation_records[count.index].record_type
+  ttl     = 300
+  records = [okta_email_domain.example.dns_validation_records[count.index].value]
+}
+
+resource "okta_email_domain_verification" "example" {
+  email_domain_id = okta_email_domain.example.id
+  depends_on      = [aws_route53_record.example]
+}
@@ -0,0 +1,5 @@
+resource "okta_email_sender" "test" {
+  from_name    = "Test Acc"
+  from_address = "no-reply@testacc-replace_with_uuid.example.com"
+  subdomain    = "mail"
+}
@@ -0,0 +1,19 @@
+resource "okta_email_sender" "example" {
+  from_name    = "Example"
+  from_address = "no-reply@example.com"
+  subdomain    = "mail"
+}
+
+resource "aws_route53_record" "example" {
+  count   = length(okta_email_sender.example.dns_records)
+  zone_id = "<zone id>"
+  name    = okta_email_sender.example.dns_records[count.index].fqdn
+  type    = okta_email_sender.example.dns_records[count.index].record_type
+  ttl     = 300
+  records = [okta_email_sender.example.dns_records[count.index].value]
+}
+
+resource "okta_email_sender_verification" "example" {
+  sender_id  = okta_email_sender.example.id
+  depends_on = [aws_route53_record.example]
+}
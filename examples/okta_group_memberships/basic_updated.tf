@@ -0,0 +1,21 @@
+resource "okta_group" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "testing, testing"
+}
+
+resource "okta_user" "test" {
+  count      = 3
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc_${count.index}_replace_with_uuid@example.com"
+  email      = "testAcc_${count.index}_replace_with_uuid@example.com"
+
+  lifecycle {
+    ignore_changes = [group_memberships]
+  }
+}
+
+resource "okta_group_memberships" "test" {
+  group_id = okta_group.test.id
+  users    = [okta_user.test[1].id, okta_user.test[2].id]
+}
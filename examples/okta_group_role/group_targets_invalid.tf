@@ -0,0 +1,15 @@
+resource "okta_group" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "testing"
+}
+
+resource "okta_group" "test_target1" {
+  name        = "testTarget1Acc_replace_with_uuid"
+  description = "testing"
+}
+
+resource "okta_group_role" "test" {
+  group_id          = okta_group.test.id
+  role_type         = "READ_ONLY_ADMIN"
+  target_group_list = [okta_group.test_target1.id]
+}
@@ -0,0 +1,20 @@
+resource "okta_group" "test" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_user" "test" {
+  count      = 2
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc_${count.index}_replace_with_uuid@example.com"
+  email      = "testAcc_${count.index}_replace_with_uuid@example.com"
+}
+
+resource "okta_group_rule" "test" {
+  name              = "testAcc_replace_with_uuid"
+  status            = "ACTIVE"
+  group_assignments = [okta_group.test.id]
+  expression_type   = "urn:okta:expression:1.0"
+  expression_value  = "String.startsWith(user.firstName,\"andy\")"
+  users_excluded    = okta_user.test[*].id
+}
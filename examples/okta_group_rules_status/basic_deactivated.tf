@@ -0,0 +1,21 @@
+resource "okta_group" "test" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_group_rule" "test" {
+  count             = 2
+  name              = "testAcc_${count.index}_replace_with_uuid"
+  status            = "INACTIVE"
+  group_assignments = [okta_group.test.id]
+  expression_type   = "urn:okta:expression:1.0"
+  expression_value  = "String.startsWith(user.firstName,\"andy${count.index}\")"
+
+  lifecycle {
+    ignore_changes = [status]
+  }
+}
+
+resource "okta_group_rules_status" "test" {
+  rule_ids = okta_group_rule.test.*.id
+  status   = "INACTIVE"
+}
@@ -0,0 +1,5 @@
+data "okta_log" "test" {
+  filter     = "eventType eq \"user.session.start\""
+  sort_order = "DESCENDING"
+  limit      = 5
+}
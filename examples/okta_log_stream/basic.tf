@@ -0,0 +1,9 @@
+resource "okta_log_stream" "test" {
+  name = "testAcc_replace_with_uuid"
+
+  aws_eventbridge {
+    account_id        = "123456789012"
+    event_source_name = "testAcc_replace_with_uuid"
+    region            = "us-east-1"
+  }
+}
@@ -0,0 +1,9 @@
+resource "okta_log_stream" "test" {
+  name = "testAcc_replace_with_uuid"
+
+  splunk_cloud {
+    edition = "aws"
+    host    = "testacc-replace_with_uuid.splunkcloud.com"
+    token   = "00000000-0000-0000-0000-000000000000"
+  }
+}
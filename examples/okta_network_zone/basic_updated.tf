@@ -9,4 +9,5 @@ resource "okta_network_zone" "dynamic_network_zone_example" {
   name              = "testAcc_replace_with_uuid Dynamic Updated"
   type              = "DYNAMIC"
   dynamic_locations = ["US", "AF-BGL", "UA-26"]
+  status            = "INACTIVE"
 }
@@ -0,0 +1,10 @@
+resource "okta_network_zone" "dynamic_v2_network_zone_example" {
+  name                          = "testAcc_replace_with_uuid Dynamic V2"
+  type                          = "DYNAMIC_V2"
+  usage                         = "BLOCKLIST"
+  asns                          = ["23457"]
+  dynamic_locations             = ["US", "AF-BGL"]
+  dynamic_locations_exclude     = ["US-CA"]
+  ip_service_categories_include = ["ALL_ANONYMIZERS"]
+  ip_service_categories_exclude = ["TOR_ANONYMIZER"]
+}
@@ -0,0 +1,8 @@
+resource "okta_network_zone" "dynamic_v2_network_zone_example" {
+  name                          = "testAcc_replace_with_uuid Dynamic V2"
+  type                          = "DYNAMIC_V2"
+  usage                         = "BLOCKLIST"
+  dynamic_locations             = ["US"]
+  ip_service_categories_include = ["ALL_ANONYMIZERS", "ALL_IP_SERVICES"]
+  status                        = "INACTIVE"
+}
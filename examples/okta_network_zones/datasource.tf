@@ -0,0 +1,18 @@
+resource "okta_network_zone" "ip" {
+  name     = "testAcc_replace_with_uuid"
+  type     = "IP"
+  gateways = ["1.2.3.4/24"]
+}
+
+resource "okta_network_zone" "dynamic" {
+  name              = "testAcc_replace_with_uuid Dynamic"
+  type              = "DYNAMIC"
+  dynamic_locations = ["US"]
+}
+
+data "okta_network_zones" "test" {
+  q    = "testAcc_replace_with_uuid"
+  type = "IP"
+
+  depends_on = [okta_network_zone.ip, okta_network_zone.dynamic]
+}
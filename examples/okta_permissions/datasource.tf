@@ -0,0 +1,5 @@
+data "okta_permissions" "test" {}
+
+output "read_permissions" {
+  value = [for p in data.okta_permissions.test.permissions : p.name if length(regexall("\\.read$", p.name)) > 0]
+}
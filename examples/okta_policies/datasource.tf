@@ -0,0 +1,4 @@
+data "okta_policies" "test" {
+  type          = "PASSWORD"
+  include_rules = true
+}
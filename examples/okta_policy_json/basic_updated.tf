@@ -0,0 +1,19 @@
+data "okta_group" "all" {
+  name = "Everyone"
+}
+
+resource "okta_policy_json" "test" {
+  type = "OKTA_SIGN_ON"
+  policy_json = jsonencode({
+    name        = "testAcc_replace_with_uuid"
+    description = "Terraform Acceptance Test JSON Policy Updated"
+    status      = "INACTIVE"
+    conditions = {
+      people = {
+        groups = {
+          include = [data.okta_group.all.id]
+        }
+      }
+    }
+  })
+}
@@ -14,6 +14,7 @@ resource "okta_policy_password" "test" {
   password_exclude_username              = false
   password_exclude_first_name            = true
   password_exclude_last_name             = true
+  password_dictionary_lookup             = true
   password_max_age_days                  = 60
   password_expire_warn_days              = 15
   password_min_age_minutes               = 60
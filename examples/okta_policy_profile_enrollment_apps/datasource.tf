@@ -0,0 +1,8 @@
+data "okta_policy" "test" {
+  name = "Default Policy"
+  type = "PROFILE_ENROLLMENT"
+}
+
+data "okta_policy_profile_enrollment_apps" "test" {
+  policy_id = data.okta_policy.test.id
+}
@@ -13,6 +13,18 @@ resource "okta_policy_rule_idp_discovery" "test" {
     type    = "MOBILE"
     os_type = "ANY"
   }
+
+  platform_exclude {
+    type    = "MOBILE"
+    os_type = "ANDROID"
+  }
+
+  user_identifier_type = "IDENTIFIER"
+
+  user_identifier_patterns {
+    match_type = "EXPRESSION"
+    value      = "String.stringContains(user.login, \"@example.com\")"
+  }
 }
 
 data "okta_policy" "test" {
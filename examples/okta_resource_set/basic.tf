@@ -0,0 +1,12 @@
+resource "okta_group" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "testing, testing"
+}
+
+resource "okta_resource_set" "test" {
+  label       = "testAcc_replace_with_uuid"
+  description = "testing, testing"
+  resources   = [
+    "https://your.okta.org/api/v1/groups/${okta_group.test.id}",
+  ]
+}
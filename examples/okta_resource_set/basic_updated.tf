@@ -0,0 +1,12 @@
+resource "okta_group" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "testing, testing"
+}
+
+resource "okta_resource_set" "test" {
+  label       = "testAccUpdated_replace_with_uuid"
+  description = "testing, testing updated"
+  resources   = [
+    "https://your.okta.org/api/v1/users",
+  ]
+}
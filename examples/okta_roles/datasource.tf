@@ -0,0 +1,17 @@
+resource "okta_admin_role_custom" "test" {
+  label       = "testAcc_replace_with_uuid"
+  description = "testing, testing"
+  permissions = ["okta.users.read", "okta.groups.read"]
+}
+
+data "okta_roles" "test" {
+  depends_on = [okta_admin_role_custom.test]
+}
+
+locals {
+  custom_role_ids = [for role in data.okta_roles.test.roles : role.id if role.label == "testAcc_replace_with_uuid"]
+}
+
+output "custom_role_id" {
+  value = local.custom_role_ids[0]
+}
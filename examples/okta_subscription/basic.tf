@@ -0,0 +1,13 @@
+resource "okta_user" "test" {
+  first_name  = "TestAcc"
+  last_name   = "Smith"
+  login       = "testAcc-replace_with_uuid@example.com"
+  email       = "testAcc-replace_with_uuid@example.com"
+  admin_roles = ["ORG_ADMIN"]
+}
+
+resource "okta_subscription" "test" {
+  user_id           = okta_user.test.id
+  notification_type = "USER_LOCKED_OUT"
+  subscribed        = false
+}
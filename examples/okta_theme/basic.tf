@@ -0,0 +1,14 @@
+data "okta_brand" "test" {
+}
+
+resource "okta_theme" "test" {
+  brand_id                               = data.okta_brand.test.id
+  logo                                   = "../examples/okta_theme/terraform_icon.png"
+  favicon                                = "../examples/okta_theme/terraform_icon.png"
+  primary_color_hex                      = "#1a73e8"
+  secondary_color_hex                    = "#f1f3f4"
+  sign_in_page_touch_point_variant       = "BACKGROUND_SECONDARY_COLOR"
+  end_user_dashboard_touch_point_variant = "FULL_THEME"
+  error_page_touch_point_variant         = "BACKGROUND_SECONDARY_COLOR"
+  email_template_touch_point_variant     = "FULL_THEME"
+}
@@ -0,0 +1,12 @@
+data "okta_brand" "test" {
+}
+
+resource "okta_theme" "test" {
+  brand_id                               = data.okta_brand.test.id
+  primary_color_hex                      = "#1662dd"
+  secondary_color_hex                    = "#ebebed"
+  sign_in_page_touch_point_variant       = "OKTA_DEFAULT"
+  end_user_dashboard_touch_point_variant = "OKTA_DEFAULT"
+  error_page_touch_point_variant         = "OKTA_DEFAULT"
+  email_template_touch_point_variant     = "OKTA_DEFAULT"
+}
@@ -0,0 +1,7 @@
+provider "okta" {
+  managed_marker_attribute = "managedBy"
+}
+
+data "okta_unmanaged_users" "test" {
+  search = "status eq \"ACTIVE\""
+}
@@ -0,0 +1,23 @@
+resource "okta_user_schema" "test" {
+  index  = "customAttribute123"
+  title  = "terraform acceptance test"
+  type   = "string"
+  master = "PROFILE_MASTER"
+}
+
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+  title      = "Engineer"
+
+  custom_profile_attributes_map = {
+    customAttribute123 = jsonencode("testing-custom-attribute")
+  }
+
+  # the rest of the profile, e.g. the department, is mastered by the HR system
+  managed_attributes = ["title", "customAttribute123"]
+
+  depends_on = [okta_user_schema.test]
+}
@@ -0,0 +1,7 @@
+resource "okta_user" "test" {
+  first_name            = "TestAcc"
+  last_name             = "Bot"
+  login                 = "testAcc-replace_with_uuid@example.com"
+  email                 = "testAcc-replace_with_uuid@example.com"
+  skip_activation_email = true
+}
@@ -0,0 +1,9 @@
+resource "okta_user" "test" {
+  first_name            = "TestAcc"
+  last_name             = "Bot"
+  login                 = "testAcc-replace_with_uuid@example.com"
+  email                 = "testAcc-replace_with_uuid@example.com"
+  skip_activation_email = true
+  recovery_question     = "What is the answer to life, the universe, and everything?"
+  recovery_answer       = "Forty Two"
+}
@@ -0,0 +1,20 @@
+resource "okta_user" "test" {
+  count      = 2
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc_${count.index}_replace_with_uuid@example.com"
+  email      = "testAcc_${count.index}_replace_with_uuid@example.com"
+  department = "testAcc_replace_with_uuid"
+
+  lifecycle {
+    ignore_changes = [status]
+  }
+}
+
+resource "okta_user_lifecycle_batch" "test" {
+  search         = "profile.department eq \"testAcc_replace_with_uuid\""
+  clear_sessions = true
+  confirmation   = "DEACTIVATE"
+
+  depends_on = [okta_user.test]
+}
@@ -0,0 +1,23 @@
+resource "okta_user_schema" "test" {
+  index       = "testAcc_replace_with_uuid"
+  title       = "terraform acceptance test"
+  type        = "array"
+  array_type  = "object"
+  description = "terraform acceptance test"
+  master      = "OKTA"
+  union       = true
+
+  array_properties {
+    index    = "street"
+    title    = "Street"
+    type     = "string"
+    required = true
+  }
+
+  array_properties {
+    index       = "zip"
+    title       = "ZIP code"
+    type        = "integer"
+    description = "Postal code"
+  }
+}
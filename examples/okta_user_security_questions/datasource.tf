@@ -0,0 +1,11 @@
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+}
+
+data "okta_user_security_questions" "test" {
+  user_id = okta_user.test.id
+  key     = "disliked_food"
+}
@@ -0,0 +1,14 @@
+resource "okta_app_saml" "example" {
+  preconfigured_app = "amazon_aws"
+  label             = "AWS"
+  key_name          = "aws"
+  key_years_valid   = 3
+}
+
+data "okta_x509_certificate" "example" {
+  certificate = okta_app_saml.example.certificate
+}
+
+output "certificate_expires_at" {
+  value = data.okta_x509_certificate.example.not_after
+}
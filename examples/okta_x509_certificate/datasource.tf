@@ -0,0 +1,24 @@
+data "okta_x509_certificate" "test" {
+  certificate = <<CERT
+-----BEGIN CERTIFICATE-----
+MIIDMTCCAhmgAwIBAgIUWT2LBK084Qxg+tsGFxsXDotCmWgwDQYJKoZIhvcNAQEL
+BQAwKDEUMBIGA1UEAwwLZXhhbXBsZS5jb20xEDAOBgNVBAoMB0V4YW1wbGUwHhcN
+MjYxMDE3MDIxODQyWhcNMzYxMDE0MDIxODQyWjAoMRQwEgYDVQQDDAtleGFtcGxl
+LmNvbTEQMA4GA1UECgwHRXhhbXBsZTCCASIwDQYJKoZIhvcNAQEBBQADggEPADCC
+AQoCggEBAKErPn4vjULjAOqNFnfqQlhLKNwX9RB82zKKzLMqX2dR9/CY+0FGJnAm
+5id8fUcnsAx/9/S/M725uH/PNd2yt7azR/PtVmropWMdhJDmD1dEcFNg9gNnt2/u
+pW23uNh0KB/f1Igmf3ZPEOAV1++PSwUmlNX4G8SfZUh3h8B8vEeI7PmjSakWEmi1
+Fday33CKUgLw8Gr/8Jg5QThoxY6AD+WHM6/mHff9+5jRSFmHJbbn9OeG7rCjaDrj
+LuVDpN9O9JmRs4zq7USegxOF3uoZ/GZyIq00PPBZ+sdL+ogeP0uOT8ExCHSy0Va8
+pk1vN92Guzeuh4aFerrsaH07UvWCNkMCAwEAAaNTMFEwHQYDVR0OBBYEFDQbk2hN
+Luf0B81nhgame07b0Z3IMB8GA1UdIwQYMBaAFDQbk2hNLuf0B81nhgame07b0Z3I
+MA8GA1UdEwEB/wQFMAMBAf8wDQYJKoZIhvcNAQELBQADggEBAJenlXr0zElEiwqN
+FZb9BqavpWzeZdboOSB74mMJqBgiBBDyKnvxMiR3CFnsEboaMKDBouPHQT+E0qGk
+f9VR5oQNYk6tPjhP412Q2mGYUqwVY8Uzc29Ca11w3YIKXqAzMRmHE/7CHqGlaWq6
+nZML85aaTQ7B+OzDuw+eiFgNeGa4j7FEJRR0Wr/sPD5/c40UUrZN9axTqy6VQk8G
+mXtACHZJVt8ZIWHcHAweLs9YT97GvgFUuZmGK0N/AU73HRFGsXA8fin22UW4Jtc3
+nGY8yYNL/LJD1MV5TFADAC/yR1s+4O6qTgtGBiUme5d1wB/ecqF/uubix/LJmsXC
+I2MSzxo=
+-----END CERTIFICATE-----
+CERT
+}
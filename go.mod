@@ -3,8 +3,11 @@ module github.com/okta/terraform-provider-okta
 go 1.15
 
 require (
+	github.com/aws/aws-sdk-go-v2 v1.2.0
+	github.com/aws/aws-sdk-go-v2/config v1.1.1
+	github.com/aws/aws-sdk-go-v2/service/secretsmanager v1.1.1
 	github.com/bflad/tfproviderlint v0.26.0
-	github.com/cenkalti/backoff/v4 v4.1.0
+	github.com/cenkalti/backoff/v4 v4.1.1
 	github.com/client9/misspell v0.3.4
 	github.com/crewjam/saml v0.4.5
 	github.com/hashicorp/go-cleanhttp v0.5.2
@@ -13,4 +16,8 @@ require (
 	github.com/hashicorp/go-retryablehttp v0.6.8
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.5.0
 	github.com/okta/okta-sdk-golang/v2 v2.3.1-0.20210317124025-8a2c70f65200
+	go.opentelemetry.io/otel v1.0.0
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp v1.0.0
+	go.opentelemetry.io/otel/sdk v1.0.0
+	go.opentelemetry.io/otel/trace v1.0.0
 )
@@ -0,0 +1,117 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+type (
+	// apiMetrics counts the requests made to Okta, so the summary can be logged when the provider exits. It helps to
+	// tune 'parallelism' and 'max_api_capacity' of the provider and of the runs in general.
+	apiMetrics struct {
+		lock        sync.Mutex
+		start       time.Time
+		calls       map[string]int
+		retries     int
+		rateLimited int
+		logger      hclog.Logger
+	}
+)
+
+var (
+	apiMetricsLock     sync.Mutex
+	apiMetricsRegistry []*apiMetrics
+)
+
+// newAPIMetrics returns metrics, which are registered to be logged by LogAPIMetrics.
+func newAPIMetrics(logger hclog.Logger) *apiMetrics {
+	m := &apiMetrics{
+		start:  time.Now(),
+		calls:  make(map[string]int),
+		logger: logger,
+	}
+	apiMetricsLock.Lock()
+	defer apiMetricsLock.Unlock()
+	apiMetricsRegistry = append(apiMetricsRegistry, m)
+	return m
+}
+
+// LogAPIMetrics logs the summary of the requests made by every configured provider, which has 'api_metrics_summary'
+// enabled. Terraform does not notify the provider about the end of the apply, so it should be called when the plugin
+// stops serving, which happens once Terraform is done with the provider.
+func LogAPIMetrics() {
+	apiMetricsLock.Lock()
+	defer apiMetricsLock.Unlock()
+	for _, m := range apiMetricsRegistry {
+		m.log()
+	}
+}
+
+// transport returns the http.RoundTripper, which counts every request sent to Okta, including the retries.
+func (m *apiMetrics) transport(next http.RoundTripper) http.RoundTripper {
+	return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		resp, err := next.RoundTrip(req)
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		m.calls[apiFamily(req.URL.Path)]++
+		// the retries made by the Okta SDK itself are marked with the header
+		if req.Header.Get("X-Okta-Retry-Count") != "" {
+			m.retries++
+		}
+		if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+			m.rateLimited++
+		}
+		return resp, err
+	})
+}
+
+// retryPolicy counts the retries made, when the provider is configured with 'backoff'.
+func (m *apiMetrics) retryPolicy(next retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		retry, retryErr := next(ctx, resp, err)
+		if retry {
+			m.lock.Lock()
+			m.retries++
+			m.lock.Unlock()
+		}
+		return retry, retryErr
+	}
+}
+
+func (m *apiMetrics) log() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	families := make([]string, 0, len(m.calls))
+	var total int
+	for family, n := range m.calls {
+		families = append(families, family)
+		total += n
+	}
+	sort.Strings(families)
+	args := []interface{}{"total_calls", total, "retries", m.retries, "rate_limited", m.rateLimited,
+		"wall_time", time.Since(m.start).Round(time.Millisecond).String()}
+	for _, family := range families {
+		args = append(args, "calls_"+family, m.calls[family])
+	}
+	m.logger.Info("Okta API usage summary", args...)
+}
+
+// apiFamily returns the family of the endpoint, e.g. 'apps' for '/api/v1/apps/{id}/users', or 'oauth2' for
+// '/oauth2/v1/token'.
+func apiFamily(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) >= 3 && parts[0] == "api" {
+		return parts[2]
+	}
+	if parts[0] == "" {
+		return "other"
+	}
+	return parts[0]
+}
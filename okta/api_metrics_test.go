@@ -0,0 +1,57 @@
+package okta
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestAPIFamily(t *testing.T) {
+	for path, expected := range map[string]string{
+		"/api/v1/apps/0oa1a2b3c4d5e6f7g8h9/users": "apps",
+		"/api/v1/users":    "users",
+		"/oauth2/v1/token": "oauth2",
+		"/":                "other",
+	} {
+		if actual := apiFamily(path); actual != expected {
+			t.Errorf("expected family of '%s' to be '%s', actual: '%s'", path, expected, actual)
+		}
+	}
+}
+
+func TestAPIMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/users" && r.Header.Get("X-Okta-Retry-Count") == "" {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+	m := newAPIMetrics(hclog.NewNullLogger())
+	client := &http.Client{Transport: m.transport(http.DefaultTransport)}
+	for _, path := range []string{"/api/v1/apps", "/api/v1/apps/0oa1a2b3c4d5e6f7g8h9", "/api/v1/users"} {
+		resp, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	req, _ := http.NewRequest("GET", server.URL+"/api/v1/users", nil)
+	req.Header.Set("X-Okta-Retry-Count", "1")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if m.calls["apps"] != 2 || m.calls["users"] != 2 {
+		t.Errorf("expected 2 calls to apps and users, actual: %v", m.calls)
+	}
+	if m.retries != 1 {
+		t.Errorf("expected 1 retry, actual: %d", m.retries)
+	}
+	if m.rateLimited != 1 {
+		t.Errorf("expected 1 rate limited response, actual: %d", m.rateLimited)
+	}
+	m.log()
+}
@@ -5,8 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
@@ -32,7 +37,14 @@ var appUserResource = &schema.Resource{
 		"password": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "Password for user application.",
+			Description: "Password for user application. It may refer to the AWS Secrets Manager or Vault secret.",
+		},
+		"profile": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: stringIsJSON,
+			StateFunc:        normalizeAppUserProfile,
+			Description:      "App user profile in JSON format. Only the attributes set here are managed.",
 		},
 	},
 }
@@ -57,6 +69,7 @@ var baseAppSchema = map[string]*schema.Schema{
 		Type:        schema.TypeSet,
 		Optional:    true,
 		Elem:        appUserResource,
+		Set:         hashAppUser,
 		Description: "Users associated with the application",
 	},
 	"groups": {
@@ -65,13 +78,31 @@ var baseAppSchema = map[string]*schema.Schema{
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Groups associated with the application",
 	},
-	"status": {
-		Type:             schema.TypeString,
-		Optional:         true,
-		Default:          statusActive,
-		ValidateDiagFunc: stringInSlice([]string{statusActive, statusInactive}),
-		Description:      "Status of application.",
+	"unmanaged_attributes": {
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Attributes of the application returned by the API, which are unknown to the provider. Only set when the provider is configured with 'log_unknown_attributes'.",
+	},
+	"retain_assignment": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Retain the user and group assignments in Okta, when they are removed from 'users' and 'groups'.",
 	},
+	"skip_users": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Ignore the user assignments of the application, e.g. when they are managed outside of the resource.",
+	},
+	"skip_groups": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Ignore the group assignments of the application, e.g. when they are managed outside of the resource.",
+	},
+	"status": buildStatusSchema("Status of application."),
 	"logo": {
 		Type:             schema.TypeString,
 		Optional:         true,
@@ -86,6 +117,23 @@ var baseAppSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "URL of the application's logo",
 	},
+	"app_links_json": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Displays specific appLinks for the app, e.g. '{\"login\":false}'",
+		ValidateDiagFunc: stringIsJSON,
+		StateFunc:        normalizeDataJSON,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// all the links are visible by default
+			return new == "" && reflect.DeepEqual(parseAppLinks(old), defaultAppLinks(old))
+		},
+	},
+	"allow_recreate": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Confirms that the application can be recreated, when the provider is configured with 'prevent_app_recreation'.",
+	},
 }
 
 var appVisibilitySchema = map[string]*schema.Schema{
@@ -122,6 +170,12 @@ var baseAppSwaSchema = map[string]*schema.Schema{
 		Description:      "Custom error page URL",
 		ValidateDiagFunc: stringIsURL(validURLSchemes...),
 	},
+	"accessibility_login_redirect_url": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Custom login page URL",
+		ValidateDiagFunc: stringIsURL(validURLSchemes...),
+	},
 	"auto_submit_toolbar": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -143,19 +197,17 @@ var baseAppSwaSchema = map[string]*schema.Schema{
 	"user_name_template": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Default:     "${source.login}",
-		Description: "Username template",
+		Description: "Username template, defaults to the provider's 'user_name_template' or '${source.login}'",
 	},
 	"user_name_template_suffix": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "Username template suffix",
+		Description: "Username template suffix, defaults to the provider's 'user_name_template_suffix'",
 	},
 	"user_name_template_type": {
 		Type:             schema.TypeString,
 		Optional:         true,
-		Default:          "BUILT_IN",
-		Description:      "Username template type",
+		Description:      "Username template type, defaults to the provider's 'user_name_template_type' or 'BUILT_IN'",
 		ValidateDiagFunc: stringInSlice([]string{"NONE", "CUSTOM", "BUILT_IN"}),
 	},
 }
@@ -171,11 +223,182 @@ func appRead(d *schema.ResourceData, name, status, signOn, label string, accy *o
 	_ = d.Set("status", status)
 	_ = d.Set("sign_on_mode", signOn)
 	_ = d.Set("label", label)
-	_ = d.Set("accessibility_self_service", *accy.SelfService)
-	_ = d.Set("accessibility_error_redirect_url", accy.ErrorRedirectUrl)
+	if accy != nil {
+		_ = d.Set("accessibility_self_service", accy.SelfService != nil && *accy.SelfService)
+		_ = d.Set("accessibility_error_redirect_url", accy.ErrorRedirectUrl)
+		_ = d.Set("accessibility_login_redirect_url", accy.LoginRedirectUrl)
+	}
 	_ = d.Set("auto_submit_toolbar", vis.AutoSubmitToolbar)
 	_ = d.Set("hide_ios", vis.Hide.IOS)
 	_ = d.Set("hide_web", vis.Hide.Web)
+	setAppLinks(d, vis.AppLinks)
+}
+
+// setAppLinks stores the visibility of the application's links. Preconfigured applications may have several links
+// (e.g. portal and mail links of Office 365), thus it is a generic map of the link names to booleans.
+func setAppLinks(d *schema.ResourceData, appLinks interface{}) {
+	if appLinks == nil {
+		return
+	}
+	payload, _ := json.Marshal(appLinks)
+	_ = d.Set("app_links_json", string(payload))
+}
+
+// addAppRecreationGuard makes the plans that replace any of the applications fail, when the provider is configured
+// with 'prevent_app_recreation'. Recreated application gets new ID, client credentials and certificates, which breaks
+// everything that depends on them. The guard runs after the CustomizeDiff of the resource, so the replacements forced
+// there are caught too.
+func addAppRecreationGuard(p *schema.Provider) {
+	for _, r := range p.ResourcesMap {
+		if _, ok := r.Schema["allow_recreate"]; !ok {
+			continue
+		}
+		s := r.Schema
+		guard := func(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if key := appReplacementKey(s, d); key != "" {
+				return checkAppRecreation(d, m, key)
+			}
+			return nil
+		}
+		if r.CustomizeDiff == nil {
+			r.CustomizeDiff = guard
+		} else {
+			r.CustomizeDiff = customdiff.Sequence(r.CustomizeDiff, guard)
+		}
+	}
+}
+
+// appReplacementKey returns the changed key, which forces the replacement of the application: either the key, which
+// schema (including the nested ones) is 'ForceNew', or the key forced to be new by the CustomizeDiff of the resource.
+// Only the computed keys can be updated by the CustomizeDiff otherwise, so the other updated keys are the forced ones.
+func appReplacementKey(s map[string]*schema.Schema, d *schema.ResourceDiff) string {
+	keys := d.GetChangedKeysPrefix("")
+	sort.Strings(keys)
+	for _, k := range keys {
+		if v := schemaForKey(s, k); v != nil && v.ForceNew {
+			return k
+		}
+	}
+	updated := d.UpdatedKeys()
+	sort.Strings(updated)
+	for _, k := range updated {
+		if v := schemaForKey(s, k); v != nil && (v.Optional || v.Required) {
+			return k
+		}
+	}
+	return ""
+}
+
+// schemaForKey returns the schema of the key in the flatmap format, e.g. 'group.0.id', or nil if it is not found. The
+// schema of the list, set or map is returned for its count and elements of the primitive types.
+func schemaForKey(s map[string]*schema.Schema, key string) *schema.Schema {
+	parts := strings.Split(key, ".")
+	var v *schema.Schema
+	for i := 0; i < len(parts); i++ {
+		var ok bool
+		v, ok = s[parts[i]]
+		if !ok {
+			return nil
+		}
+		r, ok := v.Elem.(*schema.Resource)
+		if !ok || i+2 >= len(parts) {
+			return v
+		}
+		// skip the index of the element
+		i++
+		s = r.Schema
+	}
+	return v
+}
+
+// checkAppRecreation returns an error if the change of the given key should not recreate the application.
+func checkAppRecreation(d *schema.ResourceDiff, m interface{}, key string) error {
+	if d.Id() == "" {
+		return nil
+	}
+	c, ok := m.(*Config)
+	if !ok || !c.preventAppRecreation || d.Get("allow_recreate").(bool) {
+		return nil
+	}
+	return fmt.Errorf("changing '%s' forces replacement of the application '%s', which will get new ID and credentials. "+
+		"Set 'allow_recreate' to true to confirm the replacement", key, d.Id())
+}
+
+// appLabels holds the labels of all the applications in the org. The applications are listed only once per provider
+// run, since the labels are checked for every application resource in the plan.
+type appLabels struct {
+	once   sync.Once
+	labels map[string][]string
+	err    error
+}
+
+// addAppLabelCheck makes the plans warn about application resources, which labels are already used by other
+// applications, when the provider is configured with 'check_app_labels'. Okta allows duplicate labels, but they
+// make the applications indistinguishable in the Admin Console and in the dashboards of the users.
+func addAppLabelCheck(p *schema.Provider) {
+	for _, r := range p.ResourcesMap {
+		if _, ok := r.Schema["allow_recreate"]; !ok {
+			continue
+		}
+		check := func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			checkAppLabel(ctx, d, m)
+			return nil
+		}
+		if r.CustomizeDiff == nil {
+			r.CustomizeDiff = check
+		} else {
+			r.CustomizeDiff = customdiff.Sequence(check, r.CustomizeDiff)
+		}
+	}
+}
+
+// checkAppLabel logs a warning if the configured label is used by any other application. Warnings can't be returned
+// from the plan, and listing failures should not fail the plan either.
+func checkAppLabel(ctx context.Context, d *schema.ResourceDiff, m interface{}) {
+	c, ok := m.(*Config)
+	if !ok || !c.checkAppLabels || c.appLabels == nil {
+		return
+	}
+	label := d.Get("label").(string)
+	if label == "" || !d.NewValueKnown("label") || !d.HasChange("label") {
+		return
+	}
+	c.appLabels.once.Do(func() {
+		apps, err := listApps(ctx, m, &appFilters{}, defaultPaginationLimit)
+		if err != nil {
+			c.appLabels.err = err
+			return
+		}
+		c.appLabels.labels = make(map[string][]string)
+		for _, app := range apps {
+			c.appLabels.labels[app.Label] = append(c.appLabels.labels[app.Label], app.Id)
+		}
+	})
+	if c.appLabels.err != nil {
+		logger(m).Warn("failed to list applications to check label uniqueness", "error", c.appLabels.err)
+		return
+	}
+	for _, id := range c.appLabels.labels[label] {
+		if id != d.Id() {
+			logger(m).Warn("label of the application is already used by another application",
+				"label", label, "id", id)
+			return
+		}
+	}
+}
+
+// appImporter imports the application by its ID. The user and group assignments, which are managed outside of the
+// resource, are skipped with the '<app_id>/skip_users', '<app_id>/skip_groups' or '<app_id>/skip_users/skip_groups' IDs.
+func appImporter(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	for _, flag := range parts[1:] {
+		if flag != "skip_users" && flag != "skip_groups" {
+			return nil, fmt.Errorf("invalid resource import specifier '%s', expecting the following format: <app_id>[/skip_users][/skip_groups]", d.Id())
+		}
+		_ = d.Set(flag, true)
+	}
+	d.SetId(parts[0])
+	return []*schema.ResourceData{d}, nil
 }
 
 func buildAppSchema(appSchema map[string]*schema.Schema) map[string]*schema.Schema {
@@ -186,21 +409,76 @@ func buildAppSchemaWithVisibility(appSchema map[string]*schema.Schema) map[strin
 	return buildSchema(baseAppSchema, appVisibilitySchema, appSchema)
 }
 
-func buildSchemeCreds(d *schema.ResourceData) *okta.SchemeApplicationCredentials {
+// buildSchemeCreds returns the credentials of the application, the shared password may refer to the secret, which is
+// resolved by resolveSecret, when the provider is configured with 'resolve_secret_references'.
+func buildSchemeCreds(ctx context.Context, d *schema.ResourceData, m interface{}) (*okta.SchemeApplicationCredentials, error) {
 	revealPass := d.Get("reveal_password").(bool)
-
+	password, err := resolveSecret(ctx, m, d.Get("shared_password").(string))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve shared password: %v", err)
+	}
 	return &okta.SchemeApplicationCredentials{
-		RevealPassword: &revealPass,
-		Scheme:         d.Get("credentials_scheme").(string),
-		UserNameTemplate: &okta.ApplicationCredentialsUsernameTemplate{
-			Template: d.Get("user_name_template").(string),
-			Type:     d.Get("user_name_template_type").(string),
-			Suffix:   d.Get("user_name_template_suffix").(string),
-		},
-		UserName: d.Get("shared_username").(string),
+		RevealPassword:   &revealPass,
+		Scheme:           d.Get("credentials_scheme").(string),
+		UserNameTemplate: buildUserNameTemplate(d, m),
+		UserName:         d.Get("shared_username").(string),
 		Password: &okta.PasswordCredential{
-			Value: d.Get("shared_password").(string),
+			Value: password,
 		},
+	}, nil
+}
+
+// defaultUserNameTemplate returns the username template of the applications, which don't set it: the defaults of the
+// provider, and then the defaults of Okta.
+func defaultUserNameTemplate(m interface{}) *okta.ApplicationCredentialsUsernameTemplate {
+	template := &okta.ApplicationCredentialsUsernameTemplate{
+		Template: "${source.login}",
+		Type:     "BUILT_IN",
+	}
+	if c, ok := m.(*Config); ok {
+		if c.userNameTemplate != "" {
+			template.Template = c.userNameTemplate
+		}
+		if c.userNameTemplateType != "" {
+			template.Type = c.userNameTemplateType
+		}
+		template.Suffix = c.userNameSuffix
+	}
+	return template
+}
+
+// buildUserNameTemplate returns the username template of the application. The attributes, which are not set in the
+// configuration, fall back to the defaults.
+func buildUserNameTemplate(d *schema.ResourceData, m interface{}) *okta.ApplicationCredentialsUsernameTemplate {
+	template := defaultUserNameTemplate(m)
+	if v := d.Get("user_name_template").(string); v != "" {
+		template.Template = v
+	}
+	if v := d.Get("user_name_template_type").(string); v != "" {
+		template.Type = v
+	}
+	if v := d.Get("user_name_template_suffix").(string); v != "" {
+		template.Suffix = v
+	}
+	return template
+}
+
+// setUserNameTemplate sets the username template of the application to the state. The attributes, which are not set,
+// stay empty while the application uses the defaults, so the changed defaults are applied on the next update.
+func setUserNameTemplate(d *schema.ResourceData, m interface{}, template *okta.ApplicationCredentialsUsernameTemplate) {
+	if template == nil {
+		return
+	}
+	defaults := defaultUserNameTemplate(m)
+	for attr, values := range map[string][2]string{
+		"user_name_template":        {template.Template, defaults.Template},
+		"user_name_template_type":   {template.Type, defaults.Type},
+		"user_name_template_suffix": {template.Suffix, defaults.Suffix},
+	} {
+		if d.Get(attr).(string) == "" && values[0] == values[1] {
+			continue
+		}
+		_ = d.Set(attr, values[0])
 	}
 }
 
@@ -208,21 +486,55 @@ func buildAppSwaSchema(appSchema map[string]*schema.Schema) map[string]*schema.S
 	return buildSchema(baseAppSchema, baseAppSwaSchema, appSchema)
 }
 
+func buildAccessibility(d *schema.ResourceData) *okta.ApplicationAccessibility {
+	selfService := d.Get("accessibility_self_service").(bool)
+	return &okta.ApplicationAccessibility{
+		SelfService:      &selfService,
+		ErrorRedirectUrl: d.Get("accessibility_error_redirect_url").(string),
+		LoginRedirectUrl: d.Get("accessibility_login_redirect_url").(string),
+	}
+}
+
 func buildVisibility(d *schema.ResourceData) *okta.ApplicationVisibility {
 	autoSubmit := d.Get("auto_submit_toolbar").(bool)
 	hideMobile := d.Get("hide_ios").(bool)
 	hideWeb := d.Get("hide_web").(bool)
 
-	return &okta.ApplicationVisibility{
+	vis := &okta.ApplicationVisibility{
 		AutoSubmitToolbar: &autoSubmit,
 		Hide: &okta.ApplicationVisibilityHide{
 			IOS: &hideMobile,
 			Web: &hideWeb,
 		},
 	}
+	if appLinks, ok := d.GetOk("app_links_json"); ok {
+		vis.AppLinks = parseAppLinks(appLinks.(string))
+	} else if prev, _ := d.GetChange("app_links_json"); prev.(string) != "" {
+		// the links that were hidden before are shown again
+		vis.AppLinks = defaultAppLinks(prev.(string))
+	}
+	return vis
+}
+
+func parseAppLinks(appLinks string) map[string]interface{} {
+	payload := map[string]interface{}{}
+	_ = json.Unmarshal([]byte(appLinks), &payload)
+	return payload
+}
+
+// defaultAppLinks returns the visibility of the same links with all of them shown, which is the default.
+func defaultAppLinks(appLinks string) map[string]interface{} {
+	payload := parseAppLinks(appLinks)
+	for link := range payload {
+		payload[link] = true
+	}
+	return payload
 }
 
 func fetchApp(ctx context.Context, d *schema.ResourceData, m interface{}, app okta.App) error {
+	if logUnknownAttributes(m) {
+		return fetchObjectWithUnknownAttributes(ctx, d, m, "/api/v1/apps/"+d.Id(), app)
+	}
 	return fetchAppByID(ctx, d.Id(), m, app)
 }
 
@@ -258,17 +570,28 @@ func handleAppGroups(ctx context.Context, id string, d *schema.ResourceData, cli
 				asyncActionList = append(asyncActionList, func() error {
 					_, resp, err := client.Application.CreateApplicationGroupAssignment(ctx, id,
 						groupID, okta.ApplicationGroupAssignment{})
-					return responseErr(resp, err)
+					if err := responseErr(resp, err); err != nil {
+						return fmt.Errorf("failed to assign group '%s': %v", groupID, err)
+					}
+					return nil
 				})
 			}
 		}
 	}
 
+	if d.Get("retain_assignment").(bool) {
+		// The removed assignments should be retained, so downstream provisioning does not deactivate them
+		return asyncActionList
+	}
+
 	for _, group := range existingGroups {
 		if !contains(groupIDList, group.Id) {
 			groupID := group.Id
 			asyncActionList = append(asyncActionList, func() error {
-				return suppressErrorOn404(client.Application.DeleteApplicationGroupAssignment(ctx, id, groupID))
+				if err := suppressErrorOn404(client.Application.DeleteApplicationGroupAssignment(ctx, id, groupID)); err != nil {
+					return fmt.Errorf("failed to unassign group '%s': %v", groupID, err)
+				}
+				return nil
 			})
 		}
 	}
@@ -285,6 +608,8 @@ func listApplicationGroupAssignments(ctx context.Context, client *okta.Client, i
 	for {
 		resGroups = append(resGroups, groups...)
 		if resp.HasNextPage() {
+			// every page is decoded into the new slice, since the decoder reuses the elements of the existing one
+			groups = nil
 			resp, err = resp.Next(ctx, &groups)
 			if err != nil {
 				return nil, err
@@ -315,31 +640,100 @@ func containsAppUser(userList []*okta.AppUser, id string) bool {
 	return false
 }
 
-func shouldUpdateUser(userList []*okta.AppUser, id, username string) bool {
+// shouldUpdateUser reports whether the assignment of the user differs from the configured one. The password is never
+// returned by the API, so its change is detected by the caller from the state.
+func shouldUpdateUser(userList []*okta.AppUser, id, username, profile string, passwordChanged bool) bool {
 	for _, user := range userList {
 		if user.Id == id &&
 			user.Scope == userScope &&
-			user.Credentials != nil &&
-			user.Credentials.UserName != username {
+			(passwordChanged ||
+				(user.Credentials != nil && user.Credentials.UserName != username) ||
+				(profile != "" && assignmentProfileJSON(user.Profile, profile) != normalizeDataJSON(profile))) {
 			return true
 		}
 	}
 	return false
 }
 
+// assignmentProfileJSON returns the profile of the app user or group assignment in JSON format, limited to the attributes
+// present in the configured profile. The profile also contains the attributes, which are mapped from the Okta user's
+// profile or set by other assignments, so the whole profile would never match the configuration. The whole profile is
+// returned, when there is no configured one.
+func assignmentProfileJSON(profile interface{}, configured string) string {
+	if profile == nil {
+		return ""
+	}
+	if configured != "" {
+		var configuredProfile map[string]interface{}
+		_ = json.Unmarshal([]byte(configured), &configuredProfile)
+		p, _ := profile.(map[string]interface{})
+		filtered := make(map[string]interface{}, len(configuredProfile))
+		for k := range configuredProfile {
+			if v, ok := p[k]; ok {
+				filtered[k] = v
+			}
+		}
+		profile = filtered
+	}
+	b, _ := json.Marshal(profile)
+	return string(b)
+}
+
+// filterProfile limits the profile to the attributes present in the configured one at every level of nesting, so the
+// attributes added by Okta to the nested objects (e.g. the defaults) are ignored as well. Arrays and values of other
+// types are returned as is.
+func filterProfile(profile, configured interface{}) interface{} {
+	p, ok := profile.(map[string]interface{})
+	if !ok {
+		return profile
+	}
+	c, ok := configured.(map[string]interface{})
+	if !ok {
+		return profile
+	}
+	filtered := make(map[string]interface{}, len(c))
+	for k, v := range c {
+		if pv, ok := p[k]; ok {
+			filtered[k] = filterProfile(pv, v)
+		}
+	}
+	return filtered
+}
+
+// mergeProfile sets the configured attributes in the profile, keeping the other attributes of it at every level of
+// nesting. The profile is not modified.
+func mergeProfile(profile, configured interface{}) interface{} {
+	p, ok := profile.(map[string]interface{})
+	if !ok {
+		return configured
+	}
+	c, ok := configured.(map[string]interface{})
+	if !ok {
+		return configured
+	}
+	merged := make(map[string]interface{}, len(p)+len(c))
+	for k, v := range p {
+		merged[k] = v
+	}
+	for k, v := range c {
+		merged[k] = mergeProfile(p[k], v)
+	}
+	return merged
+}
+
 // Handles the assigning of groups and users to Applications. Does so asynchronously.
 func handleAppGroupsAndUsers(ctx context.Context, id string, d *schema.ResourceData, m interface{}) error {
-	var wg sync.WaitGroup
-	resultChan := make(chan []*result, 1)
 	client := getOktaClientFromMetadata(m)
 
-	groupHandlers := handleAppGroups(ctx, id, d, client)
-	userHandlers := handleAppUsers(ctx, id, d, client)
+	var handlers []func() error
+	if !d.Get("skip_groups").(bool) {
+		handlers = append(handlers, handleAppGroups(ctx, id, d, client)...)
+	}
+	if !d.Get("skip_users").(bool) {
+		handlers = append(handlers, handleAppUsers(ctx, id, d, m)...)
+	}
 	con := getParallelismFromMetadata(m)
-	promiseAll(con, &wg, resultChan, append(groupHandlers, userHandlers...)...)
-	wg.Wait()
-
-	return getPromiseError(<-resultChan, "failed to associate user or groups with application")
+	return getPromiseError(promiseAll(ctx, con, handlers...), "failed to associate user or groups with application")
 }
 
 func handleAppLogo(ctx context.Context, d *schema.ResourceData, m interface{}, appID string, links interface{}) error {
@@ -351,7 +745,34 @@ func handleAppLogo(ctx context.Context, d *schema.ResourceData, m interface{}, a
 	return err
 }
 
-func handleAppUsers(ctx context.Context, id string, d *schema.ResourceData, client *okta.Client) []func() error {
+func buildAuthenticationPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "ID of the app sign-on policy, which is assigned to the application. Available only in Okta Identity Engine orgs",
+	}
+}
+
+func handleAppAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}, appID string) error {
+	policyID, ok := d.GetOk("authentication_policy")
+	if !ok || !d.HasChange("authentication_policy") {
+		return nil
+	}
+	_, err := getSupplementFromMetadata(m).SetAppAccessPolicy(ctx, appID, policyID.(string))
+	return err
+}
+
+// setAppAuthenticationPolicy sets the ID of the app sign-on policy, which is available only in the links of the
+// application.
+func setAppAuthenticationPolicy(d *schema.ResourceData, links interface{}) {
+	if href := linksValue(links, "accessPolicy", "href"); href != "" {
+		_ = d.Set("authentication_policy", path.Base(href))
+	}
+}
+
+func handleAppUsers(ctx context.Context, id string, d *schema.ResourceData, m interface{}) []func() error {
+	client := getOktaClientFromMetadata(m)
 	// Looking upstream for existing user's, rather then the config for accuracy.
 	existingUsers, _ := listApplicationUsers(ctx, client, id)
 	var (
@@ -360,6 +781,13 @@ func handleAppUsers(ctx context.Context, id string, d *schema.ResourceData, clie
 		userIDList      []string
 	)
 
+	previousPasswords := map[string]string{}
+	if previous, _ := d.GetChange("users"); previous != nil {
+		for _, user := range previous.(*schema.Set).List() {
+			userProfile := user.(map[string]interface{})
+			previousPasswords[userProfile["id"].(string)], _ = userProfile["password"].(string)
+		}
+	}
 	if set, ok := d.GetOk("users"); ok {
 		users = set.(*schema.Set).List()
 		userIDList = make([]string, len(users))
@@ -370,42 +798,70 @@ func handleAppUsers(ctx context.Context, id string, d *schema.ResourceData, clie
 			userIDList[i] = uID
 			// Not required
 			password, _ := userProfile["password"].(string)
+			rawProfile, _ := userProfile["profile"].(string)
+			var profile interface{}
+			// JSON is already validated
+			_ = json.Unmarshal([]byte(rawProfile), &profile)
 			if !containsAppUser(existingUsers, uID) {
 				asyncActionList = append(asyncActionList, func() error {
-					_, _, err := client.Application.AssignUserToApplication(ctx, id, okta.AppUser{
+					pass, err := resolveSecret(ctx, m, password)
+					if err != nil {
+						return fmt.Errorf("failed to resolve password of the user '%s': %v", uID, err)
+					}
+					_, _, err = client.Application.AssignUserToApplication(ctx, id, okta.AppUser{
 						Id: uID,
 						Credentials: &okta.AppUserCredentials{
 							UserName: username,
 							Password: &okta.AppUserPasswordCredential{
-								Value: password,
+								Value: pass,
 							},
 						},
+						Profile: profile,
 					})
-					return err
+					if err != nil {
+						return fmt.Errorf("failed to assign user '%s': %v", uID, err)
+					}
+					return nil
 				})
-			} else if shouldUpdateUser(existingUsers, uID, username) {
+			} else if shouldUpdateUser(existingUsers, uID, username, rawProfile, password != previousPasswords[uID]) {
 				asyncActionList = append(asyncActionList, func() error {
-					_, _, err := client.Application.UpdateApplicationUser(ctx, id, uID, okta.AppUser{
+					pass, err := resolveSecret(ctx, m, password)
+					if err != nil {
+						return fmt.Errorf("failed to resolve password of the user '%s': %v", uID, err)
+					}
+					_, _, err = client.Application.UpdateApplicationUser(ctx, id, uID, okta.AppUser{
 						Id: uID,
 						Credentials: &okta.AppUserCredentials{
 							UserName: username,
 							Password: &okta.AppUserPasswordCredential{
-								Value: password,
+								Value: pass,
 							},
 						},
+						Profile: profile,
 					})
-					return err
+					if err != nil {
+						return fmt.Errorf("failed to update user '%s': %v", uID, err)
+					}
+					return nil
 				})
 			}
 		}
 	}
 
+	if d.Get("retain_assignment").(bool) {
+		// The removed assignments should be retained, so downstream provisioning does not deactivate them
+		return asyncActionList
+	}
+
 	for _, user := range existingUsers {
 		if user.Scope == userScope {
 			if !contains(userIDList, user.Id) {
 				userID := user.Id
 				asyncActionList = append(asyncActionList, func() error {
-					return suppressErrorOn404(client.Application.DeleteApplicationUser(ctx, id, userID, nil))
+					if err := suppressErrorOn404(client.Application.DeleteApplicationUser(ctx, id, userID, nil)); err != nil {
+						return fmt.Errorf("failed to unassign user '%s': %v", userID, err)
+					}
+					return nil
 				})
 			}
 		}
@@ -423,6 +879,8 @@ func listApplicationUsers(ctx context.Context, client *okta.Client, id string) (
 	for {
 		resUsers = append(resUsers, users...)
 		if resp.HasNextPage() {
+			// every page is decoded into the new slice, since the decoder reuses the elements of the existing one
+			users = nil
 			resp, err = resp.Next(ctx, &users)
 			if err != nil {
 				return nil, err
@@ -436,58 +894,89 @@ func listApplicationUsers(ctx context.Context, client *okta.Client, id string) (
 }
 
 func setAppStatus(ctx context.Context, d *schema.ResourceData, client *okta.Client, status string) error {
-	desiredStatus := d.Get("status").(string)
-	if status == desiredStatus {
-		return nil
-	}
-	if desiredStatus == statusInactive {
+	return changeStatus(status, d.Get("status").(string), func() error {
+		return responseErr(client.Application.ActivateApplication(ctx, d.Id()))
+	}, func() error {
 		return responseErr(client.Application.DeactivateApplication(ctx, d.Id()))
-	}
-	return responseErr(client.Application.ActivateApplication(ctx, d.Id()))
+	})
 }
 
 func syncGroupsAndUsers(ctx context.Context, id string, d *schema.ResourceData, m interface{}) error {
 	ctx = context.WithValue(ctx, retryOnStatusCodes, []int{http.StatusNotFound})
 	client := getOktaClientFromMetadata(m)
-	// Temporary high limit to avoid issues short term. Need to support pagination here
-	userList, _, err := client.Application.ListApplicationUsers(ctx, id, &query.Params{Limit: defaultPaginationLimit})
-	if err != nil {
-		return fmt.Errorf("failed to list application users: %v", err)
+	var (
+		userList  []*okta.AppUser
+		groupList []*okta.ApplicationGroupAssignment
+		err       error
+	)
+	// All the pages are fetched, otherwise the assignments on the other pages would be removed on the next apply.
+	// The skipped assignments are managed outside of the resource, so they are not synced at all.
+	if !d.Get("skip_users").(bool) {
+		userList, err = listApplicationUsers(ctx, client, id)
+		if err != nil {
+			return fmt.Errorf("failed to list application users: %v", err)
+		}
 	}
-	// Temporary high limit to avoid issues short term. Need to support pagination here
-	groupList, _, err := client.Application.ListApplicationGroupAssignments(ctx, id, &query.Params{Limit: defaultPaginationLimit})
-	if err != nil {
-		return fmt.Errorf("failed to list application group assignments: %v", err)
+	if !d.Get("skip_groups").(bool) {
+		groupList, err = listApplicationGroupAssignments(ctx, client, id)
+		if err != nil {
+			return fmt.Errorf("failed to list application group assignments: %v", err)
+		}
 	}
-	flatGroupList := make([]interface{}, len(groupList))
+	// The retained assignments are not managed anymore, so only the configured ones are synced
+	retain := d.Get("retain_assignment").(bool)
+	configuredGroups := convertInterfaceToStringSetNullable(d.Get("groups"))
+
+	var flatGroupList []interface{}
 
-	for i, g := range groupList {
-		flatGroupList[i] = g.Id
+	for _, g := range groupList {
+		if retain && !contains(configuredGroups, g.Id) {
+			continue
+		}
+		flatGroupList = append(flatGroupList, g.Id)
+	}
+
+	configuredProfiles := map[string]string{}
+	configuredPasswords := map[string]string{}
+	if set, ok := d.GetOk("users"); ok {
+		for _, user := range set.(*schema.Set).List() {
+			userProfile := user.(map[string]interface{})
+			configuredProfiles[userProfile["id"].(string)], _ = userProfile["profile"].(string)
+			configuredPasswords[userProfile["id"].(string)], _ = userProfile["password"].(string)
+		}
 	}
 
 	var flattenedUserList []interface{}
 
 	for _, user := range userList {
 		if user.Scope == userScope {
-			var un, up string
+			if _, ok := configuredProfiles[user.Id]; retain && !ok {
+				continue
+			}
+			var un string
 			if user.Credentials != nil {
 				un = user.Credentials.UserName
-				if user.Credentials.Password != nil {
-					up = user.Credentials.Password.Value
-				}
+			}
+			// Password is never returned by the API, so it's kept from the state, and it's empty after the import.
+			up := configuredPasswords[user.Id]
+			// Profile is synced only when it's configured, since every app user has one.
+			var profile string
+			if configured := configuredProfiles[user.Id]; configured != "" {
+				profile = assignmentProfileJSON(user.Profile, configured)
 			}
 			flattenedUserList = append(flattenedUserList, map[string]interface{}{
 				"id":       user.Id,
 				"username": un,
 				"scope":    user.Scope,
 				"password": up,
+				"profile":  profile,
 			})
 		}
 	}
 	flatMap := map[string]interface{}{}
 
 	if len(flattenedUserList) > 0 {
-		flatMap["users"] = schema.NewSet(schema.HashResource(appUserResource), flattenedUserList)
+		flatMap["users"] = schema.NewSet(hashAppUser, flattenedUserList)
 	}
 
 	if len(flatGroupList) > 0 {
@@ -497,6 +986,80 @@ func syncGroupsAndUsers(ctx context.Context, id string, d *schema.ResourceData,
 	return setNonPrimitives(d, flatMap)
 }
 
+// hashAppUser identifies the user assignment by all of its attributes except the password. The API never returns the
+// password, so it's unknown after the import, and the assignments of the imported application would never match the
+// configuration otherwise.
+func hashAppUser(v interface{}) int {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	id, _ := m["id"].(string)
+	username, _ := m["username"].(string)
+	profile := normalizeAppUserProfile(m["profile"])
+	return schema.HashString(strings.Join([]string{id, username, profile}, "-"))
+}
+
+// normalizeAppUserProfile normalizes the configured profile of the app user the same way as it's stored in the state,
+// so the profiles, which differ only in the formatting or the order of the keys, are the same. The profile, which is
+// not set, stays empty, since it's not managed then.
+func normalizeAppUserProfile(val interface{}) string {
+	profile, _ := val.(string)
+	if profile == "" {
+		return ""
+	}
+	return normalizeDataJSON(profile)
+}
+
+func buildAppSettingsJSONSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Application settings in JSON format",
+		ValidateDiagFunc: stringIsJSON,
+		StateFunc:        normalizeDataJSON,
+		DiffSuppressFunc: suppressAppSettingsJSONDiff,
+	}
+}
+
+// suppressAppSettingsJSONDiff compares the settings semantically, and only the ones which are set in the configuration,
+// since Okta returns all the settings of the application, including the defaults.
+func suppressAppSettingsJSONDiff(k, old, new string, d *schema.ResourceData) bool {
+	if new == "" {
+		return true
+	}
+	var oldSettings, newSettings map[string]interface{}
+	if json.Unmarshal([]byte(old), &oldSettings) != nil || json.Unmarshal([]byte(new), &newSettings) != nil {
+		return false
+	}
+	for key, val := range newSettings {
+		if !reflect.DeepEqual(val, oldSettings[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+// buildAppSettings returns nil, if the settings are not configured.
+func buildAppSettings(d *schema.ResourceData) *okta.ApplicationSettingsApplication {
+	appSettings, ok := d.GetOk("app_settings_json")
+	if !ok {
+		return nil
+	}
+	payload := map[string]interface{}{}
+	_ = json.Unmarshal([]byte(appSettings.(string)), &payload)
+	settings := okta.ApplicationSettingsApplication(payload)
+	return &settings
+}
+
+// setAppSettingsFromStruct sets the settings of the applications, which are typed in Okta SDK
+func setAppSettingsFromStruct(d *schema.ResourceData, settings interface{}) error {
+	payload, _ := json.Marshal(settings)
+	appSettings := okta.ApplicationSettingsApplication{}
+	_ = json.Unmarshal(payload, &appSettings)
+	return setAppSettings(d, &appSettings)
+}
+
 // setAppSettings available preconfigured SAML and OAuth applications vary wildly on potential app settings, thus
 // it is a generic map. This logic simply weeds out any empty string values.
 func setAppSettings(d *schema.ResourceData, settings *okta.ApplicationSettingsApplication) error {
@@ -550,25 +1113,26 @@ func setSamlSettings(d *schema.ResourceData, signOn *okta.SamlApplicationSetting
 	for i, st := range attrStatements {
 		arr[i] = map[string]interface{}{
 			"name":         st.Name,
-			"namespace":    st.Namespace,
+			"namespace":    normalizeSamlNamespace(st.Namespace),
 			"type":         st.Type,
 			"values":       st.Values,
 			"filter_type":  st.FilterType,
 			"filter_value": st.FilterValue,
 		}
 	}
-	if signOn.Slo != nil && signOn.Slo.Enabled != nil && *signOn.Slo.Enabled {
-		_ = d.Set("single_logout_issuer", signOn.Slo.Issuer)
-		_ = d.Set("single_logout_url", signOn.Slo.LogoutUrl)
-		if len(signOn.SpCertificate.X5c) > 0 {
-			_ = d.Set("single_logout_certificate", signOn.SpCertificate.X5c[0])
-		}
-	}
 	return setNonPrimitives(d, map[string]interface{}{
 		"attribute_statements": arr,
 	})
 }
 
+// normalizeSamlNamespace returns the name format of the attribute statement, Okta omits the default one in some cases.
+func normalizeSamlNamespace(namespace string) string {
+	if namespace == "" {
+		return samlNamespaceUnspecified
+	}
+	return namespace
+}
+
 func deleteApplication(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	client := getOktaClientFromMetadata(m)
 	if d.Get("status").(string) == statusActive {
@@ -15,6 +15,7 @@ type appFilters struct {
 	ID          string
 	Label       string
 	LabelPrefix string
+	ClientID    string
 }
 
 // Grabs application q query param
@@ -26,7 +27,7 @@ func (f *appFilters) getQ() string {
 }
 
 func (f *appFilters) String() string {
-	return fmt.Sprintf(`id: "%s", label: "%s", label_prefix: "%s"`, f.ID, f.Label, f.LabelPrefix)
+	return fmt.Sprintf(`id: "%s", label: "%s", label_prefix: "%s", client_id: "%s"`, f.ID, f.Label, f.LabelPrefix, f.ClientID)
 }
 
 func listApps(ctx context.Context, m interface{}, filters *appFilters, limit int64) ([]*okta.Application, error) {
@@ -52,16 +53,63 @@ func listApps(ctx context.Context, m interface{}, filters *appFilters, limit int
 	return resultingApps, nil
 }
 
+// findOAuthAppByClientID returns nil, if there is no OAuth application with the given client ID. The client ID is the
+// ID of the application, unless the custom one was set during the creation, so the application is fetched by ID first.
+func findOAuthAppByClientID(ctx context.Context, m interface{}, filters *appFilters) (*okta.OpenIdConnectApplication, error) {
+	matches := func(app *okta.OpenIdConnectApplication) bool {
+		if app.Credentials == nil || app.Credentials.OauthClient == nil || app.Credentials.OauthClient.ClientId != filters.ClientID {
+			return false
+		}
+		return filters.Status == "" || app.Status == statusActive
+	}
+	client := getOktaClientFromMetadata(m)
+	respApp, resp, err := client.Application.GetApplication(ctx, filters.ClientID, okta.NewOpenIdConnectApplication(), nil)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return nil, err
+	}
+	if app, ok := respApp.(*okta.OpenIdConnectApplication); ok && matches(app) {
+		return app, nil
+	}
+	re := client.GetRequestExecutor()
+	qp := &query.Params{Limit: defaultPaginationLimit, Filter: `name eq "oidc_client"`}
+	req, err := re.NewRequest("GET", fmt.Sprintf("/api/v1/apps%s", qp.String()), nil)
+	if err != nil {
+		return nil, err
+	}
+	var apps []*okta.OpenIdConnectApplication
+	resp, err = re.Do(ctx, req, &apps)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		for _, app := range apps {
+			if matches(app) {
+				return app, nil
+			}
+		}
+		if !resp.HasNextPage() {
+			return nil, nil
+		}
+		apps = nil
+		resp, err = resp.Next(ctx, &apps)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
 func getAppFilters(d *schema.ResourceData) (*appFilters, error) {
 	id := d.Get("id").(string)
 	label := d.Get("label").(string)
 	labelPrefix := d.Get("label_prefix").(string)
-	filters := &appFilters{ID: id, Label: label, LabelPrefix: labelPrefix}
+	// 'client_id' is available only in the data sources of the OAuth applications
+	clientID, _ := d.Get("client_id").(string)
+	filters := &appFilters{ID: id, Label: label, LabelPrefix: labelPrefix, ClientID: clientID}
 	if d.Get("active_only").(bool) {
 		filters.Status = fmt.Sprintf(`status eq "%s"`, statusActive)
 	}
-	if id == "" && label == "" && labelPrefix == "" {
-		return nil, errors.New("you must provide either a 'label_prefix', 'id', or 'label' for application search")
+	if id == "" && label == "" && labelPrefix == "" && clientID == "" {
+		return nil, errors.New("you must provide either a 'label_prefix', 'id', 'label' or 'client_id' for application search")
 	}
 	return filters, nil
 }
@@ -0,0 +1,252 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// preconfiguredAppSetting is a typed setting of the preconfigured application, which is stored under the key in the
+// application settings.
+type preconfiguredAppSetting struct {
+	key    string
+	schema *schema.Schema
+}
+
+// preconfiguredAppSettings contains the typed settings of the most used applications from the Okta Integration Network,
+// keyed by the name of the application. Settings of the other applications can be set with 'app_settings_json'.
+var preconfiguredAppSettings = map[string]map[string]preconfiguredAppSetting{
+	"amazon_aws": {
+		"aws_environment_type": {key: "awsEnvironmentType", schema: &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "aws.amazon",
+			ValidateDiagFunc: stringInSlice([]string{"aws.amazon", "aws.cn", "aws.us-gov"}),
+			Description:      "AWS environment type",
+		}},
+		"identity_provider_arn": {key: "identityProviderArn", schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "ARN of the identity provider in AWS",
+		}},
+		"login_url": {key: "loginURL", schema: &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: stringIsURL(validURLSchemes...),
+			Description:      "Login URL of the AWS console",
+		}},
+		"session_duration": {key: "sessionDuration", schema: &schema.Schema{
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          3600,
+			ValidateDiagFunc: intBetween(900, 43200),
+			Description:      "Session duration in seconds",
+		}},
+		"join_all_roles": {key: "joinAllRoles", schema: &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Join all the roles of the user from all the groups",
+		}},
+		"use_group_mapping": {key: "useGroupMapping", schema: &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Use the group mapping to assign the roles",
+		}},
+		"group_filter": {key: "groupFilter", schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Regular expression to filter the groups, which are mapped to the roles",
+		}},
+		"role_value_pattern": {key: "roleValuePattern", schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Pattern to build the role value from the group name",
+		}},
+	},
+	"github": {
+		"github_org": {key: "githubOrg", schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the GitHub organization",
+		}},
+	},
+	"google": {
+		"domain": {key: "domain", schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Google Workspace domain",
+		}},
+		"afw_only": {key: "afwOnly", schema: &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Use the app for Android for Work only",
+		}},
+	},
+	"office365": {
+		"domain": {key: "domain", schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Microsoft Office 365 domain",
+		}},
+		"msft_tenant": {key: "msftTenant", schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Microsoft tenant name",
+		}},
+		"ws_fed_configure_type": {key: "wsFedConfigureType", schema: &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "AUTO",
+			ValidateDiagFunc: stringInSlice([]string{"AUTO", "MANUAL"}),
+			Description:      "Whether WS-Federation is configured by Okta or manually",
+		}},
+		"windows_transport_enabled": {key: "windowsTransportEnabled", schema: &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enable the Windows transport for the rich clients",
+		}},
+	},
+	"salesforce": {
+		"instance_type": {key: "instanceType", schema: &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "PRODUCTION",
+			ValidateDiagFunc: stringInSlice([]string{"PRODUCTION", "SANDBOX", "MY_DOMAIN"}),
+			Description:      "Type of the Salesforce instance",
+		}},
+		"integration_type": {key: "integrationType", schema: &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "STANDARD",
+			ValidateDiagFunc: stringInSlice([]string{"STANDARD", "PORTAL", "COMMUNITY"}),
+			Description:      "Type of the Salesforce integration",
+		}},
+		"login_url": {key: "loginUrl", schema: &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: stringIsURL(validURLSchemes...),
+			Description:      "Login URL of the Salesforce 'My Domain'",
+		}},
+	},
+	"slack": {
+		"domain": {key: "domain", schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Slack workspace domain, e.g. 'example' for 'example.slack.com'",
+		}},
+	},
+	"zendesk": {
+		"company_subdomain": {key: "companySubdomain", schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Zendesk subdomain, e.g. 'example' for 'example.zendesk.com'",
+		}},
+	},
+	"zoomus": {
+		"sub_domain": {key: "subDomain", schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Zoom subdomain, e.g. 'example' for 'example.zoom.us'",
+		}},
+	},
+}
+
+func preconfiguredAppSettingsSchema() *schema.Schema {
+	apps := make(map[string]*schema.Schema, len(preconfiguredAppSettings))
+	for name, settings := range preconfiguredAppSettings {
+		s := make(map[string]*schema.Schema, len(settings))
+		for attr, setting := range settings {
+			s[attr] = setting.schema
+		}
+		apps[name] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        &schema.Resource{Schema: s},
+			Description: fmt.Sprintf("Settings of the '%s' preconfigured application", name),
+		}
+	}
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Elem:          &schema.Resource{Schema: apps},
+		ConflictsWith: []string{"app_settings_json"},
+		Description:   "Typed settings of the preconfigured application, which are validated during plan",
+	}
+}
+
+// validatePreconfiguredAppSettings verifies that the typed settings are set only for the preconfigured application.
+func validatePreconfiguredAppSettings(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if _, ok := d.GetOk("preconfigured_app_settings"); !ok || !d.NewValueKnown("preconfigured_app") {
+		return nil
+	}
+	appName := d.Get("preconfigured_app").(string)
+	var configured []string
+	for name := range preconfiguredAppSettings {
+		if v, ok := d.GetOk(fmt.Sprintf("preconfigured_app_settings.0.%s", name)); ok && len(v.([]interface{})) > 0 {
+			configured = append(configured, name)
+		}
+	}
+	sort.Strings(configured)
+	if len(configured) != 1 || configured[0] != appName {
+		return fmt.Errorf("'preconfigured_app_settings' should contain only the '%s' block, which matches 'preconfigured_app', got: %s",
+			appName, strings.Join(configured, ", "))
+	}
+	return nil
+}
+
+// buildPreconfiguredAppSettings returns nil, if the typed settings are not configured.
+func buildPreconfiguredAppSettings(d *schema.ResourceData) *okta.ApplicationSettingsApplication {
+	appName := d.Get("preconfigured_app").(string)
+	settings, ok := preconfiguredAppSettings[appName]
+	if !ok {
+		return nil
+	}
+	raw, ok := d.GetOk(fmt.Sprintf("preconfigured_app_settings.0.%s.0", appName))
+	if !ok {
+		return nil
+	}
+	values := raw.(map[string]interface{})
+	appSettings := okta.ApplicationSettingsApplication{}
+	for attr, setting := range settings {
+		if str, ok := values[attr].(string); ok && str == "" {
+			continue
+		}
+		appSettings[setting.key] = values[attr]
+	}
+	return &appSettings
+}
+
+// setPreconfiguredAppSettings syncs the typed settings only when they are configured, since the application settings
+// are always available in 'app_settings_json'.
+func setPreconfiguredAppSettings(d *schema.ResourceData, appName string, appSettings *okta.ApplicationSettingsApplication) error {
+	settings, ok := preconfiguredAppSettings[appName]
+	if _, configured := d.GetOk("preconfigured_app_settings"); !ok || !configured || appSettings == nil {
+		return nil
+	}
+	values := make(map[string]interface{}, len(settings))
+	for attr, setting := range settings {
+		v, ok := (*appSettings)[setting.key]
+		if !ok || v == nil {
+			continue
+		}
+		if f, ok := v.(float64); ok && setting.schema.Type == schema.TypeInt {
+			v = int(f)
+		}
+		values[attr] = v
+	}
+	return setNonPrimitives(d, map[string]interface{}{
+		"preconfigured_app_settings": []interface{}{
+			map[string]interface{}{appName: []interface{}{values}},
+		},
+	})
+}
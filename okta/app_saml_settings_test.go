@@ -0,0 +1,63 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func TestPreconfiguredAppSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAppSaml().Schema, map[string]interface{}{
+		"label":             "AWS",
+		"preconfigured_app": "amazon_aws",
+		"preconfigured_app_settings": []interface{}{
+			map[string]interface{}{
+				"amazon_aws": []interface{}{
+					map[string]interface{}{
+						"identity_provider_arn": "arn:aws:iam::123456789012:saml-provider/okta",
+						"session_duration":      7200,
+						"join_all_roles":        true,
+					},
+				},
+			},
+		},
+	})
+	settings := buildPreconfiguredAppSettings(d)
+	if settings == nil {
+		t.Fatal("expected app settings to be built")
+	}
+	expected := okta.ApplicationSettingsApplication{
+		"awsEnvironmentType":  "aws.amazon",
+		"identityProviderArn": "arn:aws:iam::123456789012:saml-provider/okta",
+		"sessionDuration":     7200,
+		"joinAllRoles":        true,
+		"useGroupMapping":     false,
+	}
+	if len(*settings) != len(expected) {
+		t.Fatalf("expected settings %v, actual: %v", expected, *settings)
+	}
+	for k, v := range expected {
+		if (*settings)[k] != v {
+			t.Errorf("expected setting %s to be %v, actual: %v", k, v, (*settings)[k])
+		}
+	}
+
+	// settings are decoded from JSON, so the numbers are float64
+	err := setPreconfiguredAppSettings(d, "amazon_aws", &okta.ApplicationSettingsApplication{
+		"awsEnvironmentType":  "aws.amazon",
+		"identityProviderArn": "arn:aws:iam::123456789012:saml-provider/okta",
+		"sessionDuration":     float64(3600),
+		"joinAllRoles":        false,
+		"unknownSetting":      "value",
+	})
+	if err != nil {
+		t.Fatalf("failed to set app settings: %v", err)
+	}
+	if v := d.Get("preconfigured_app_settings.0.amazon_aws.0.session_duration").(int); v != 3600 {
+		t.Errorf("expected session duration 3600, actual: %d", v)
+	}
+	if v := d.Get("preconfigured_app_settings.0.amazon_aws.0.join_all_roles").(bool); v {
+		t.Errorf("expected join_all_roles to be false")
+	}
+}
@@ -2,8 +2,17 @@ package okta
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/okta-sdk-golang/v2/okta"
 )
 
 func deleteTestApps(client *testClient) error {
@@ -34,3 +43,386 @@ func deleteTestApps(client *testClient) error {
 	}
 	return nil
 }
+
+func TestAppUserProfileJSON(t *testing.T) {
+	profile := map[string]interface{}{
+		"email": "test@example.com",
+		"role":  "admin",
+		"saml_roles": []interface{}{
+			"reader",
+		},
+	}
+	tests := []struct {
+		configured string
+		expected   string
+	}{
+		{"", `{"email":"test@example.com","role":"admin","saml_roles":["reader"]}`},
+		{`{"role": "reader"}`, `{"role":"admin"}`},
+		{`{"saml_roles":["reader"],"missing":"value"}`, `{"saml_roles":["reader"]}`},
+	}
+	for _, test := range tests {
+		actual := assignmentProfileJSON(profile, test.configured)
+		if actual != test.expected {
+			t.Errorf("expected profile %s for configured profile %q, actual: %s", test.expected, test.configured, actual)
+		}
+	}
+	if actual := assignmentProfileJSON(nil, `{"role":"admin"}`); actual != "" {
+		t.Errorf("expected empty profile, actual: %s", actual)
+	}
+}
+
+func TestGroupAssignmentProfileJSON(t *testing.T) {
+	profile := map[string]interface{}{
+		"role": "admin",
+		"settings": map[string]interface{}{
+			"region":  "us",
+			"default": true,
+		},
+		"saml_roles": []interface{}{"reader"},
+	}
+	configured := `{"settings":{"region":"eu"},"saml_roles":["reader"]}`
+	tests := []struct {
+		strategy string
+		expected string
+	}{
+		{profileStrategyReplace, `{"saml_roles":["reader"],"settings":{"default":true,"region":"us"}}`},
+		{profileStrategyIgnoreAddedKeys, `{"saml_roles":["reader"],"settings":{"region":"us"}}`},
+		{profileStrategyDeclaredKeys, `{"saml_roles":["reader"],"settings":{"region":"us"}}`},
+	}
+	for _, test := range tests {
+		actual := groupAssignmentProfileJSON(profile, configured, test.strategy)
+		if actual != test.expected {
+			t.Errorf("expected profile %s with %s strategy, actual: %s", test.expected, test.strategy, actual)
+		}
+	}
+}
+
+func TestMergeProfile(t *testing.T) {
+	profile := map[string]interface{}{
+		"role": "admin",
+		"settings": map[string]interface{}{
+			"region":  "us",
+			"default": true,
+		},
+	}
+	configured := map[string]interface{}{
+		"settings": map[string]interface{}{
+			"region": "eu",
+		},
+		"saml_roles": []interface{}{"reader"},
+	}
+	b, _ := json.Marshal(mergeProfile(profile, configured))
+	expected := `{"role":"admin","saml_roles":["reader"],"settings":{"default":true,"region":"eu"}}`
+	if string(b) != expected {
+		t.Errorf("expected merged profile %s, actual: %s", expected, string(b))
+	}
+	if profile["settings"].(map[string]interface{})["region"] != "us" {
+		t.Error("expected the profile not to be modified")
+	}
+}
+
+func TestBuildUserNameTemplate(t *testing.T) {
+	tests := []struct {
+		raw      map[string]interface{}
+		config   *Config
+		expected okta.ApplicationCredentialsUsernameTemplate
+	}{
+		{
+			raw:      map[string]interface{}{},
+			config:   &Config{},
+			expected: okta.ApplicationCredentialsUsernameTemplate{Template: "${source.login}", Type: "BUILT_IN"},
+		},
+		{
+			raw:      map[string]interface{}{},
+			config:   &Config{userNameTemplate: "${source.email}", userNameTemplateType: "CUSTOM", userNameSuffix: "example.com"},
+			expected: okta.ApplicationCredentialsUsernameTemplate{Template: "${source.email}", Type: "CUSTOM", Suffix: "example.com"},
+		},
+		{
+			raw:      map[string]interface{}{"user_name_template": "user.firstName", "user_name_template_suffix": "example.org"},
+			config:   &Config{userNameTemplate: "${source.email}", userNameTemplateType: "CUSTOM", userNameSuffix: "example.com"},
+			expected: okta.ApplicationCredentialsUsernameTemplate{Template: "user.firstName", Type: "CUSTOM", Suffix: "example.org"},
+		},
+	}
+	for i, test := range tests {
+		d := schema.TestResourceDataRaw(t, resourceAppSwa().Schema, test.raw)
+		actual := buildUserNameTemplate(d, test.config)
+		if *actual != test.expected {
+			t.Errorf("case %d: expected %+v, got %+v", i, test.expected, *actual)
+		}
+	}
+}
+
+func TestSetUserNameTemplate(t *testing.T) {
+	config := &Config{userNameTemplate: "${source.email}"}
+	d := schema.TestResourceDataRaw(t, resourceAppSwa().Schema, map[string]interface{}{})
+	setUserNameTemplate(d, config, &okta.ApplicationCredentialsUsernameTemplate{Template: "${source.email}", Type: "BUILT_IN"})
+	if v := d.Get("user_name_template").(string); v != "" {
+		t.Errorf("expected the default template to stay empty, got '%s'", v)
+	}
+	setUserNameTemplate(d, config, &okta.ApplicationCredentialsUsernameTemplate{Template: "${source.login}", Type: "BUILT_IN", Suffix: "example.com"})
+	if v := d.Get("user_name_template").(string); v != "${source.login}" {
+		t.Errorf("expected the template that differs from the default to be set, got '%s'", v)
+	}
+	if v := d.Get("user_name_template_suffix").(string); v != "example.com" {
+		t.Errorf("expected the suffix that differs from the default to be set, got '%s'", v)
+	}
+}
+
+func TestSuppressAppSettingsJSONDiff(t *testing.T) {
+	old := `{"domain":"example","instanceType":"PRODUCTION","nested":{"a":1,"b":[1,2]}}`
+	tests := []struct {
+		new      string
+		expected bool
+	}{
+		{"", true},
+		{`{"nested":{"b":[1,2],"a":1},"domain":"example"}`, true},
+		{`{"domain":"other"}`, false},
+		{`{"nested":{"a":1}}`, false},
+		{`{"missing":"value"}`, false},
+	}
+	for _, test := range tests {
+		if actual := suppressAppSettingsJSONDiff("app_settings_json", old, test.new, nil); actual != test.expected {
+			t.Errorf("expected diff suppression to be %v for %s, actual: %v", test.expected, test.new, actual)
+		}
+	}
+}
+
+func TestSuppressAppLinksDiff(t *testing.T) {
+	suppress := baseAppSchema["app_links_json"].DiffSuppressFunc
+	tests := []struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		{`{"login":true}`, "", true},
+		{`{"portal":true,"mail":true}`, "", true},
+		{`{"login":false}`, "", false},
+		{`{"login":true}`, `{"login":false}`, false},
+	}
+	for _, test := range tests {
+		if actual := suppress("app_links_json", test.old, test.new, nil); actual != test.expected {
+			t.Errorf("expected diff suppression to be %v for %s -> %s, actual: %v", test.expected, test.old, test.new, actual)
+		}
+	}
+	if links := defaultAppLinks(`{"portal":false,"mail":true}`); len(links) != 2 || links["portal"] != true || links["mail"] != true {
+		t.Errorf("expected all the links to be shown, actual: %v", links)
+	}
+}
+
+// TestSyncGroupsAndUsersPagination verifies that the assignments on all the pages are synced, since the missing ones
+// would be removed on the next apply.
+func TestSyncGroupsAndUsersPagination(t *testing.T) {
+	const (
+		totalUsers  = 450
+		totalGroups = 3
+		pageSize    = 200
+	)
+	page := func(w http.ResponseWriter, r *http.Request, total int, item func(i int) interface{}) {
+		start, _ := strconv.Atoi(r.URL.Query().Get("after"))
+		end := start + pageSize
+		if end > total {
+			end = total
+		}
+		if end < total {
+			w.Header().Set("Link", fmt.Sprintf("<http://%s%s?after=%d&limit=%d>; rel=\"next\"", r.Host, r.URL.Path, end, pageSize))
+		}
+		items := make([]interface{}, 0, end-start)
+		for i := start; i < end; i++ {
+			items = append(items, item(i))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(items)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/apps/app1/users":
+			page(w, r, totalUsers, func(i int) interface{} {
+				return map[string]interface{}{
+					"id":          fmt.Sprintf("user%d", i),
+					"scope":       userScope,
+					"credentials": map[string]interface{}{"userName": fmt.Sprintf("user%d@example.com", i)},
+				}
+			})
+		case "/api/v1/apps/app1/groups":
+			page(w, r, totalGroups, func(i int) interface{} {
+				return map[string]interface{}{"id": fmt.Sprintf("group%d", i)}
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	_, client, err := okta.NewClient(context.Background(),
+		okta.WithOrgUrl(server.URL),
+		okta.WithToken("token"),
+		okta.WithTestingDisableHttpsCheck(true),
+		okta.WithCache(false),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	d := schema.TestResourceDataRaw(t, baseAppSchema, map[string]interface{}{
+		"users": []interface{}{map[string]interface{}{"id": "user0", "password": "secret"}},
+	})
+	err = syncGroupsAndUsers(context.Background(), "app1", d, &Config{oktaClient: client})
+	if err != nil {
+		t.Fatalf("failed to sync groups and users: %v", err)
+	}
+
+	users := d.Get("users").(*schema.Set).List()
+	if len(users) != totalUsers {
+		t.Fatalf("expected %d users, actual: %d", totalUsers, len(users))
+	}
+	ids := map[string]bool{}
+	for _, u := range users {
+		user := u.(map[string]interface{})
+		ids[user["id"].(string)] = true
+		if password := user["password"].(string); user["id"] == "user0" && password != "secret" {
+			t.Errorf("expected password of 'user0' to be kept from the state, actual: '%s'", password)
+		}
+	}
+	for i := 0; i < totalUsers; i++ {
+		if id := fmt.Sprintf("user%d", i); !ids[id] {
+			t.Errorf("expected user '%s' to be synced", id)
+		}
+	}
+	if groups := d.Get("groups").(*schema.Set); groups.Len() != totalGroups {
+		t.Errorf("expected %d groups, actual: %d", totalGroups, groups.Len())
+	}
+}
+
+func TestHashAppUser(t *testing.T) {
+	imported := map[string]interface{}{"id": "user1", "username": "user1@example.com", "password": "", "profile": ""}
+	configured := map[string]interface{}{"id": "user1", "username": "user1@example.com", "password": "secret", "profile": ""}
+	if hashAppUser(imported) != hashAppUser(configured) {
+		t.Error("expected the assignments, which differ only in password, to have the same hash")
+	}
+	configured["profile"] = `{"role":"admin"}`
+	if hashAppUser(imported) == hashAppUser(configured) {
+		t.Error("expected the assignments with different profiles to have different hashes")
+	}
+	formatted := map[string]interface{}{"id": "user1", "username": "user1@example.com", "profile": "{\n  \"role\": \"admin\"\n}\n"}
+	if hashAppUser(formatted) != hashAppUser(configured) {
+		t.Error("expected the assignments, which profiles differ only in the formatting, to have the same hash")
+	}
+	if hashAppUser(imported) == hashAppUser(map[string]interface{}{"id": "user2", "username": "user1@example.com"}) {
+		t.Error("expected the assignments of different users to have different hashes")
+	}
+}
+
+func TestAppImporter(t *testing.T) {
+	tests := []struct {
+		id         string
+		skipUsers  bool
+		skipGroups bool
+		err        bool
+	}{
+		{id: "app1"},
+		{id: "app1/skip_users", skipUsers: true},
+		{id: "app1/skip_groups", skipGroups: true},
+		{id: "app1/skip_users/skip_groups", skipUsers: true, skipGroups: true},
+		{id: "app1/skip_everything", err: true},
+	}
+	for _, test := range tests {
+		d := schema.TestResourceDataRaw(t, baseAppSchema, map[string]interface{}{})
+		d.SetId(test.id)
+		_, err := appImporter(context.Background(), d, nil)
+		if test.err {
+			if err == nil {
+				t.Errorf("expected import of '%s' to fail", test.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("failed to import '%s': %v", test.id, err)
+			continue
+		}
+		if d.Id() != "app1" {
+			t.Errorf("expected ID of '%s' to be 'app1', actual: '%s'", test.id, d.Id())
+		}
+		if d.Get("skip_users").(bool) != test.skipUsers || d.Get("skip_groups").(bool) != test.skipGroups {
+			t.Errorf("unexpected skip_users (%v) or skip_groups (%v) for '%s'", d.Get("skip_users"), d.Get("skip_groups"), test.id)
+		}
+	}
+}
+
+// TestAppRecreationGuard verifies that the replacements forced by the nested keys and by the CustomizeDiff of the
+// resource are caught.
+func TestAppRecreationGuard(t *testing.T) {
+	p := &schema.Provider{ResourcesMap: map[string]*schema.Resource{
+		"okta_app_test": {
+			Schema: map[string]*schema.Schema{
+				"allow_recreate": {Type: schema.TypeBool, Optional: true},
+				"mode":           {Type: schema.TypeString, Optional: true},
+				"settings": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {Type: schema.TypeString, Optional: true, ForceNew: true},
+						},
+					},
+				},
+			},
+			CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+				if d.HasChange("mode") {
+					return d.ForceNew("mode")
+				}
+				return nil
+			},
+		},
+	}}
+	addAppRecreationGuard(p)
+	r := p.ResourcesMap["okta_app_test"]
+	state := &terraform.InstanceState{
+		ID: "0oa1",
+		Attributes: map[string]string{
+			"id":              "0oa1",
+			"mode":            "a",
+			"settings.#":      "1",
+			"settings.0.type": "a",
+		},
+	}
+	m := &Config{preventAppRecreation: true}
+	for _, tc := range []struct {
+		config map[string]interface{}
+		key    string
+	}{
+		{map[string]interface{}{"mode": "a", "settings": []interface{}{map[string]interface{}{"type": "a"}}}, ""},
+		{map[string]interface{}{"mode": "a", "settings": []interface{}{map[string]interface{}{"type": "b"}}}, "settings.0.type"},
+		{map[string]interface{}{"mode": "b", "settings": []interface{}{map[string]interface{}{"type": "a"}}}, "mode"},
+		{map[string]interface{}{"mode": "b", "allow_recreate": true, "settings": []interface{}{map[string]interface{}{"type": "a"}}}, ""},
+	} {
+		_, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(tc.config), m)
+		if tc.key == "" && err != nil {
+			t.Errorf("expected no error for %v, got: %v", tc.config, err)
+		}
+		if tc.key != "" && (err == nil || !strings.Contains(err.Error(), fmt.Sprintf("'%s'", tc.key))) {
+			t.Errorf("expected the replacement forced by '%s' to be rejected, got: %v", tc.key, err)
+		}
+	}
+}
+
+func TestShouldUpdateUser(t *testing.T) {
+	users := []*okta.AppUser{{
+		Id:          "user1",
+		Scope:       userScope,
+		Credentials: &okta.AppUserCredentials{UserName: "user1@example.com"},
+		Profile:     map[string]interface{}{"role": "admin"},
+	}}
+	for _, tc := range []struct {
+		username, profile string
+		passwordChanged   bool
+		expected          bool
+	}{
+		{"user1@example.com", `{"role":"admin"}`, false, false},
+		{"user1@example.com", `{"role":"admin"}`, true, true},
+		{"user2@example.com", "", false, true},
+		{"user1@example.com", `{"role":"user"}`, false, true},
+	} {
+		if actual := shouldUpdateUser(users, "user1", tc.username, tc.profile, tc.passwordChanged); actual != tc.expected {
+			t.Errorf("expected %t for %+v, got %t", tc.expected, tc, actual)
+		}
+	}
+}
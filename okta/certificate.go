@@ -0,0 +1,69 @@
+package okta
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// parseCertificate accepts either PEM encoded certificate, or base64 encoded DER, which is used in the JSON Web Keys.
+func parseCertificate(raw string) (*x509.Certificate, error) {
+	raw = strings.TrimSpace(raw)
+	if block, _ := pem.Decode([]byte(raw)); block != nil {
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("expected PEM block of 'CERTIFICATE' type, got '%s'", block.Type)
+		}
+		return x509.ParseCertificate(block.Bytes)
+	}
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(raw), ""))
+	if err != nil {
+		return nil, errors.New("certificate should be either in PEM format or base64 encoded DER")
+	}
+	return x509.ParseCertificate(der)
+}
+
+// certificateFingerprint returns the fingerprint in the same format as in the Okta Admin Console, e.g. 'AB:CD:...'
+func certificateFingerprint(sum []byte) string {
+	parts := make([]string, len(sum))
+	for i := range sum {
+		parts[i] = fmt.Sprintf("%02X", sum[i])
+	}
+	return strings.Join(parts, ":")
+}
+
+// certificateExpiryWarning returns the warning, when the certificate expires within the number of days configured with
+// 'certificate_expiry_warning_days' of the provider. Invalid certificates are only logged, since they are validated
+// by Okta.
+func certificateExpiryWarning(m interface{}, owner, rawCert string) diag.Diagnostics {
+	c, ok := m.(*Config)
+	if !ok || c.certWarningDays <= 0 || rawCert == "" {
+		return nil
+	}
+	cert, err := parseCertificate(rawCert)
+	if err != nil {
+		logger(m).Warn("failed to parse signing certificate", "owner", owner, "error", err)
+		return nil
+	}
+	left := time.Until(cert.NotAfter)
+	if left > time.Duration(c.certWarningDays)*24*time.Hour {
+		return nil
+	}
+	summary := fmt.Sprintf("Signing certificate of %s expires in %d days", owner, int(left.Hours()/24))
+	if left <= 0 {
+		summary = fmt.Sprintf("Signing certificate of %s has expired", owner)
+	}
+	sum := sha256.Sum256(cert.Raw)
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail: fmt.Sprintf("The certificate '%s' with SHA-256 fingerprint %s expires at %s. Rotate it before it expires to avoid SAML outages.",
+			cert.Subject, certificateFingerprint(sum[:]), cert.NotAfter.UTC().Format(time.RFC3339)),
+	}}
+}
@@ -0,0 +1,58 @@
+package okta
+
+import (
+	"strings"
+	"testing"
+)
+
+const testCertificate = `-----BEGIN CERTIFICATE-----
+MIIDMTCCAhmgAwIBAgIUWT2LBK084Qxg+tsGFxsXDotCmWgwDQYJKoZIhvcNAQEL
+BQAwKDEUMBIGA1UEAwwLZXhhbXBsZS5jb20xEDAOBgNVBAoMB0V4YW1wbGUwHhcN
+MjYxMDE3MDIxODQyWhcNMzYxMDE0MDIxODQyWjAoMRQwEgYDVQQDDAtleGFtcGxl
+LmNvbTEQMA4GA1UECgwHRXhhbXBsZTCCASIwDQYJKoZIhvcNAQEBBQADggEPADCC
+AQoCggEBAKErPn4vjULjAOqNFnfqQlhLKNwX9RB82zKKzLMqX2dR9/CY+0FGJnAm
+5id8fUcnsAx/9/S/M725uH/PNd2yt7azR/PtVmropWMdhJDmD1dEcFNg9gNnt2/u
+pW23uNh0KB/f1Igmf3ZPEOAV1++PSwUmlNX4G8SfZUh3h8B8vEeI7PmjSakWEmi1
+Fday33CKUgLw8Gr/8Jg5QThoxY6AD+WHM6/mHff9+5jRSFmHJbbn9OeG7rCjaDrj
+LuVDpN9O9JmRs4zq7USegxOF3uoZ/GZyIq00PPBZ+sdL+ogeP0uOT8ExCHSy0Va8
+pk1vN92Guzeuh4aFerrsaH07UvWCNkMCAwEAAaNTMFEwHQYDVR0OBBYEFDQbk2hN
+Luf0B81nhgame07b0Z3IMB8GA1UdIwQYMBaAFDQbk2hNLuf0B81nhgame07b0Z3I
+MA8GA1UdEwEB/wQFMAMBAf8wDQYJKoZIhvcNAQELBQADggEBAJenlXr0zElEiwqN
+FZb9BqavpWzeZdboOSB74mMJqBgiBBDyKnvxMiR3CFnsEboaMKDBouPHQT+E0qGk
+f9VR5oQNYk6tPjhP412Q2mGYUqwVY8Uzc29Ca11w3YIKXqAzMRmHE/7CHqGlaWq6
+nZML85aaTQ7B+OzDuw+eiFgNeGa4j7FEJRR0Wr/sPD5/c40UUrZN9axTqy6VQk8G
+mXtACHZJVt8ZIWHcHAweLs9YT97GvgFUuZmGK0N/AU73HRFGsXA8fin22UW4Jtc3
+nGY8yYNL/LJD1MV5TFADAC/yR1s+4O6qTgtGBiUme5d1wB/ecqF/uubix/LJmsXC
+I2MSzxo=
+-----END CERTIFICATE-----`
+
+func TestParseCertificate(t *testing.T) {
+	for _, raw := range []string{testCertificate, "MIIDMTCCAhmgAwIBAgIUWT2LBK084Qxg+tsGFxsXDotCmWgwDQYJKoZIhvcNAQELBQAwKDEUMBIGA1UEAwwLZXhhbXBsZS5jb20xEDAOBgNVBAoMB0V4YW1wbGUwHhcNMjYxMDE3MDIxODQyWhcNMzYxMDE0MDIxODQyWjAoMRQwEgYDVQQDDAtleGFtcGxlLmNvbTEQMA4GA1UECgwHRXhhbXBsZTCCASIwDQYJKoZIhvcNAQEBBQADggEPADCCAQoCggEBAKErPn4vjULjAOqNFnfqQlhLKNwX9RB82zKKzLMqX2dR9/CY+0FGJnAm5id8fUcnsAx/9/S/M725uH/PNd2yt7azR/PtVmropWMdhJDmD1dEcFNg9gNnt2/upW23uNh0KB/f1Igmf3ZPEOAV1++PSwUmlNX4G8SfZUh3h8B8vEeI7PmjSakWEmi1Fday33CKUgLw8Gr/8Jg5QThoxY6AD+WHM6/mHff9+5jRSFmHJbbn9OeG7rCjaDrjLuVDpN9O9JmRs4zq7USegxOF3uoZ/GZyIq00PPBZ+sdL+ogeP0uOT8ExCHSy0Va8pk1vN92Guzeuh4aFerrsaH07UvWCNkMCAwEAAaNTMFEwHQYDVR0OBBYEFDQbk2hNLuf0B81nhgame07b0Z3IMB8GA1UdIwQYMBaAFDQbk2hNLuf0B81nhgame07b0Z3IMA8GA1UdEwEB/wQFMAMBAf8wDQYJKoZIhvcNAQELBQADggEBAJenlXr0zElEiwqNFZb9BqavpWzeZdboOSB74mMJqBgiBBDyKnvxMiR3CFnsEboaMKDBouPHQT+E0qGkf9VR5oQNYk6tPjhP412Q2mGYUqwVY8Uzc29Ca11w3YIKXqAzMRmHE/7CHqGlaWq6nZML85aaTQ7B+OzDuw+eiFgNeGa4j7FEJRR0Wr/sPD5/c40UUrZN9axTqy6VQk8GmXtACHZJVt8ZIWHcHAweLs9YT97GvgFUuZmGK0N/AU73HRFGsXA8fin22UW4Jtc3nGY8yYNL/LJD1MV5TFADAC/yR1s+4O6qTgtGBiUme5d1wB/ecqF/uubix/LJmsXCI2MSzxo="} {
+		cert, err := parseCertificate(raw)
+		if err != nil {
+			t.Fatalf("failed to parse certificate: %v", err)
+		}
+		if cert.Subject.CommonName != "example.com" {
+			t.Errorf("expected certificate of 'example.com', actual: %s", cert.Subject.CommonName)
+		}
+	}
+	if _, err := parseCertificate("invalid"); err == nil {
+		t.Errorf("expected invalid certificate to fail")
+	}
+}
+
+func TestCertificateExpiryWarning(t *testing.T) {
+	// the test certificate expires in 2036
+	diags := certificateExpiryWarning(&Config{certWarningDays: 30}, "test app", testCertificate)
+	if len(diags) != 0 {
+		t.Errorf("expected no warnings, actual: %v", diags)
+	}
+	diags = certificateExpiryWarning(&Config{certWarningDays: 365 * 20}, "test app", testCertificate)
+	if len(diags) != 1 || !strings.Contains(diags[0].Summary, "test app") {
+		t.Errorf("expected warning about the certificate of test app, actual: %v", diags)
+	}
+	diags = certificateExpiryWarning(&Config{}, "test app", testCertificate)
+	if len(diags) != 0 {
+		t.Errorf("expected no warnings when disabled, actual: %v", diags)
+	}
+}
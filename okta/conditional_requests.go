@@ -0,0 +1,150 @@
+package okta
+
+import (
+	"bytes"
+	"container/list"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+type (
+	// conditionalCache keeps the bodies of the GET responses, which have the 'ETag' or 'Last-Modified' header, and
+	// revalidates them with the conditional requests. When Okta responds with '304 Not Modified', the cached response is
+	// returned instead, so the unchanged objects are not transferred again, e.g. when the same object is read by several
+	// resources and data sources during the refresh. The server always decides if the object has changed, so the cache
+	// never returns the stale objects.
+	//
+	// The benefit is limited to the transferred bytes. The JSON decoding and the state updates are not skipped: the Okta
+	// SDK decodes every response it receives, and the transport can't tell it that the body hasn't changed, so the cached
+	// body is still decoded on every '304 Not Modified' response. Each request still counts against the rate limits. Only the maxEntries most recently used responses
+	// are kept, so the memory used by the cache doesn't grow with the number of the managed objects.
+	conditionalCache struct {
+		lock       sync.Mutex
+		maxEntries int
+		entries    map[string]*list.Element
+		recent     *list.List
+	}
+
+	cachedResponse struct {
+		key    string
+		status string
+		header http.Header
+		body   []byte
+	}
+)
+
+// defaultConditionalCacheEntries is the number of the responses kept by the conditional requests cache.
+const defaultConditionalCacheEntries = 1000
+
+func newConditionalCache(maxEntries int) *conditionalCache {
+	return &conditionalCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		recent:     list.New(),
+	}
+}
+
+// transport returns the http.RoundTripper, which sends the conditional GET requests for the cached responses.
+func (c *conditionalCache) transport(next http.RoundTripper) http.RoundTripper {
+	return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			return next.RoundTrip(req)
+		}
+		key := conditionalCacheKey(req)
+		cached := c.get(key)
+		if cached != nil {
+			req = req.Clone(req.Context())
+			if etag := cached.header.Get("ETag"); etag != "" {
+				req.Header.Set("If-None-Match", etag)
+			}
+			if lastModified := cached.header.Get("Last-Modified"); lastModified != "" {
+				req.Header.Set("If-Modified-Since", lastModified)
+			}
+		}
+		resp, err := next.RoundTrip(req)
+		if err != nil {
+			return resp, err
+		}
+		if resp.StatusCode == http.StatusNotModified && cached != nil {
+			resp.Body.Close()
+			return cached.response(req, resp.Header), nil
+		}
+		if resp.StatusCode != http.StatusOK || (resp.Header.Get("ETag") == "" && resp.Header.Get("Last-Modified") == "") {
+			if cached != nil {
+				c.delete(key)
+			}
+			return resp, nil
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		c.put(&cachedResponse{key: key, status: resp.Status, header: resp.Header.Clone(), body: body})
+		return resp, nil
+	})
+}
+
+func (c *conditionalCache) get(key string) *cachedResponse {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	c.recent.MoveToFront(e)
+	return e.Value.(*cachedResponse)
+}
+
+// put caches the response and evicts the least recently used ones above the maxEntries.
+func (c *conditionalCache) put(resp *cachedResponse) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if e, ok := c.entries[resp.key]; ok {
+		e.Value = resp
+		c.recent.MoveToFront(e)
+		return
+	}
+	c.entries[resp.key] = c.recent.PushFront(resp)
+	for c.recent.Len() > c.maxEntries {
+		oldest := c.recent.Back()
+		c.recent.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedResponse).key)
+	}
+}
+
+func (c *conditionalCache) delete(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if e, ok := c.entries[key]; ok {
+		c.recent.Remove(e)
+		delete(c.entries, key)
+	}
+}
+
+// response rebuilds the cached response. The headers of the '304 Not Modified' response (e.g. the rate limit ones)
+// take precedence over the cached ones.
+func (r *cachedResponse) response(req *http.Request, header http.Header) *http.Response {
+	h := r.header.Clone()
+	for k, v := range header {
+		h[k] = v
+	}
+	return &http.Response{
+		Status:        r.status,
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        h,
+		Body:          ioutil.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
+
+// conditionalCacheKey identifies the response by the URL and the requested representation of it.
+func conditionalCacheKey(req *http.Request) string {
+	return req.URL.String() + " " + req.Header.Get("Accept")
+}
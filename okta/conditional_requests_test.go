@@ -0,0 +1,84 @@
+package okta
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestConditionalCache(t *testing.T) {
+	var requests, notModified int
+	etag := `W/"1"`
+	body := `{"id":"00g1a2b3c4d5e6f7g8h9"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-Rate-Limit-Remaining", "10")
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: newConditionalCache(defaultConditionalCacheEntries).transport(http.DefaultTransport)}
+
+	get := func() string {
+		resp, err := client.Get(server.URL + "/api/v1/groups/00g1a2b3c4d5e6f7g8h9")
+		if err != nil {
+			t.Fatalf("failed to get group: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", resp.StatusCode)
+		}
+		b, _ := ioutil.ReadAll(resp.Body)
+		return string(b)
+	}
+	if actual := get(); actual != body {
+		t.Errorf("expected body '%s', got '%s'", body, actual)
+	}
+	if actual := get(); actual != body {
+		t.Errorf("expected cached body '%s', got '%s'", body, actual)
+	}
+	if requests != 2 || notModified != 1 {
+		t.Errorf("expected the second request to be conditional, got %d requests, %d not modified", requests, notModified)
+	}
+
+	// the changed object is returned and cached again
+	etag = `W/"2"`
+	body = `{"id":"00g1a2b3c4d5e6f7g8h9","profile":{}}`
+	if actual := get(); actual != body {
+		t.Errorf("expected changed body '%s', got '%s'", body, actual)
+	}
+	if actual := get(); actual != body {
+		t.Errorf("expected cached body '%s', got '%s'", body, actual)
+	}
+	if requests != 4 || notModified != 2 {
+		t.Errorf("expected %d requests, %d not modified, got %d, %d", 4, 2, requests, notModified)
+	}
+}
+
+func TestConditionalCacheEviction(t *testing.T) {
+	c := newConditionalCache(2)
+	for _, key := range []string{"a", "b"} {
+		c.put(&cachedResponse{key: key})
+	}
+	// "a" becomes the most recently used one, so "b" is evicted
+	if c.get("a") == nil {
+		t.Fatal("expected 'a' to be cached")
+	}
+	c.put(&cachedResponse{key: "c"})
+	if c.get("b") != nil {
+		t.Error("expected the least recently used 'b' to be evicted")
+	}
+	if c.get("a") == nil || c.get("c") == nil {
+		t.Error("expected 'a' and 'c' to be cached")
+	}
+	c.delete("a")
+	if c.get("a") != nil || len(c.entries) != 1 || c.recent.Len() != 1 {
+		t.Errorf("expected only 'c' to be cached, got %d entries", len(c.entries))
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/okta/okta-sdk-golang/v2/okta"
 	"github.com/okta/terraform-provider-okta/sdk"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (adt *AddHeaderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -27,22 +28,40 @@ type (
 
 	// Config contains our provider schema values and Okta clients
 	Config struct {
-		orgName          string
-		domain           string
-		apiToken         string
-		clientID         string
-		privateKey       string
-		scopes           []string
-		retryCount       int
-		parallelism      int
-		backoff          bool
-		minWait          int
-		maxWait          int
-		logLevel         int
-		requestTimeout   int
-		oktaClient       *okta.Client
-		supplementClient *sdk.ApiSupplement
-		logger           hclog.Logger
+		orgName              string
+		domain               string
+		apiToken             string
+		clientID             string
+		privateKey           string
+		scopes               []string
+		scopeAccess          map[string]bool
+		retryCount           int
+		parallelism          int
+		backoff              bool
+		minWait              int
+		maxWait              int
+		maxAPICapacity       int
+		logLevel             int
+		requestTimeout       int
+		preventAppRecreation bool
+		checkAppLabels       bool
+		logUnknownAttributes bool
+		certWarningDays      int
+		apiMetricsSummary    bool
+		conditionalRequests  bool
+		managedMarkerAttr    string
+		managedMarkerValue   string
+		userNameTemplate     string
+		userNameTemplateType string
+		userNameSuffix       string
+		resolveSecretRefs    bool
+		appLabels            *appLabels
+		operations           *operationQueue
+		tracer               trace.Tracer
+		oktaClient           *okta.Client
+		supplementClient     *sdk.ApiSupplement
+		metrics              *apiMetrics
+		logger               hclog.Logger
 	}
 )
 
@@ -51,6 +70,19 @@ func (c *Config) loadAndValidate() error {
 		Level:      hclog.Level(c.logLevel),
 		TimeFormat: "2006/01/02 03:04:05",
 	})
+	c.appLabels = &appLabels{}
+	c.operations = newOperationQueue()
+	tracer, err := newTracer()
+	if err != nil {
+		return fmt.Errorf("failed to configure tracing: %v", err)
+	}
+	c.tracer = tracer
+	if c.apiMetricsSummary {
+		c.metrics = newAPIMetrics(hclog.New(&hclog.LoggerOptions{
+			Level:      hclog.Info,
+			TimeFormat: "2006/01/02 03:04:05",
+		}))
+	}
 	var httpClient *http.Client
 	if c.backoff {
 		retryableClient := retryablehttp.NewClient()
@@ -58,13 +90,19 @@ func (c *Config) loadAndValidate() error {
 		retryableClient.RetryWaitMax = time.Second * time.Duration(c.maxWait)
 		retryableClient.RetryMax = c.retryCount
 		retryableClient.Logger = c.logger
-		retryableClient.HTTPClient.Transport = logging.NewTransport("Okta", retryableClient.HTTPClient.Transport)
+		retryableClient.HTTPClient.Transport = c.buildTransport(retryableClient.HTTPClient.Transport)
 		retryableClient.ErrorHandler = errHandler
-		retryableClient.CheckRetry = checkRetry
+		retryableClient.CheckRetry = applyRetryMiddlewares(checkRetry)
+		if c.tracer != nil {
+			retryableClient.CheckRetry = tracingRetryPolicy(retryableClient.CheckRetry)
+		}
+		if c.metrics != nil {
+			retryableClient.CheckRetry = c.metrics.retryPolicy(retryableClient.CheckRetry)
+		}
 		httpClient = retryableClient.StandardClient()
 	} else {
 		httpClient = cleanhttp.DefaultClient()
-		httpClient.Transport = logging.NewTransport("Okta", httpClient.Transport)
+		httpClient.Transport = c.buildTransport(httpClient.Transport)
 	}
 	setters := []okta.ConfigSetter{
 		okta.WithOrgUrl(fmt.Sprintf("https://%v.%v", c.orgName, c.domain)),
@@ -96,6 +134,25 @@ func (c *Config) loadAndValidate() error {
 	return nil
 }
 
+// buildTransport wraps the base transport with the conditional requests, logging, tracing, metrics, rate limiting and
+// the registered request middlewares.
+func (c *Config) buildTransport(base http.RoundTripper) http.RoundTripper {
+	if c.conditionalRequests {
+		base = newConditionalCache(defaultConditionalCacheEntries).transport(base)
+	}
+	var t http.RoundTripper = logging.NewTransport("Okta", base)
+	if c.tracer != nil {
+		t = tracingTransport(c.tracer, t)
+	}
+	if c.metrics != nil {
+		t = c.metrics.transport(t)
+	}
+	if c.maxAPICapacity > 0 {
+		t = newRateLimiter(c.maxAPICapacity, c.logger).transport(t)
+	}
+	return applyRequestMiddlewares(t)
+}
+
 func errHandler(resp *http.Response, err error, numTries int) (*http.Response, error) {
 	if err != nil {
 		return resp, err
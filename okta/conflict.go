@@ -0,0 +1,62 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// conflictRetryTimeout limits the time spent on retrying the update, which is rejected because of the concurrent
+// modification of the object, e.g. by the parallel apply of another module.
+const conflictRetryTimeout = time.Minute
+
+func isConflict(resp *okta.Response) bool {
+	return resp != nil && (resp.StatusCode == http.StatusConflict || resp.StatusCode == http.StatusPreconditionFailed)
+}
+
+// retryOnConflict runs the update again, when Okta rejects it because of the concurrent modification of the object.
+// The update is called with merge set to true on retries, so it can re-read the object and merge the changes into its
+// latest version, instead of overwriting the concurrent changes.
+func retryOnConflict(ctx context.Context, update func(merge bool) (*okta.Response, error)) error {
+	bOff := backoff.NewExponentialBackOff()
+	bOff.MaxElapsedTime = conflictRetryTimeout
+	merge := false
+	return backoff.Retry(func() error {
+		resp, err := update(merge)
+		if err == nil {
+			return nil
+		}
+		if !isConflict(resp) {
+			return backoff.Permanent(err)
+		}
+		merge = true
+		return err
+	}, backoff.WithContext(bOff, ctx))
+}
+
+// mergeStringSet applies the changes made to the set attribute in the configuration to the latest values of the
+// object, so the values added or removed concurrently by others are kept.
+func mergeStringSet(d *schema.ResourceData, key string, latest []string) []string {
+	o, n := d.GetChange(key)
+	return mergeStrings(latest, convertInterfaceToStringSet(o), convertInterfaceToStringSet(n))
+}
+
+func mergeStrings(latest, old, new []string) []string {
+	toAdd, toRemove := splitTargets(new, old)
+	var merged []string
+	for _, v := range latest {
+		if !contains(toRemove, v) {
+			merged = append(merged, v)
+		}
+	}
+	for _, v := range toAdd {
+		if !contains(merged, v) {
+			merged = append(merged, v)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,50 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func TestMergeStrings(t *testing.T) {
+	latest := []string{"a", "b", "c"}
+	old := []string{"a", "b"}
+	new := []string{"a", "d"}
+	expected := []string{"a", "c", "d"}
+	if actual := mergeStrings(latest, old, new); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestRetryOnConflict(t *testing.T) {
+	conflict := &okta.Response{Response: &http.Response{StatusCode: http.StatusConflict}}
+	badRequest := &okta.Response{Response: &http.Response{StatusCode: http.StatusBadRequest}}
+
+	var calls []bool
+	err := retryOnConflict(context.Background(), func(merge bool) (*okta.Response, error) {
+		calls = append(calls, merge)
+		if len(calls) < 3 {
+			return conflict, errors.New("conflict")
+		}
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if expected := []bool{false, true, true}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("expected calls %v, got %v", expected, calls)
+	}
+
+	calls = nil
+	err = retryOnConflict(context.Background(), func(merge bool) (*okta.Response, error) {
+		calls = append(calls, merge)
+		return badRequest, errors.New("bad request")
+	})
+	if err == nil || len(calls) != 1 {
+		t.Errorf("expected single call with error, got %d calls and error %v", len(calls), err)
+	}
+}
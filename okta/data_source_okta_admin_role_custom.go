@@ -0,0 +1,59 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAdminRoleCustom() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAdminRoleCustomRead,
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique label of the role",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the role",
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of permissions that the role grants in Okta",
+			},
+		},
+	}
+}
+
+func dataSourceAdminRoleCustomRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	label := d.Get("label").(string)
+	roles, _, err := getSupplementFromMetadata(m).ListCustomRoles(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list custom admin roles: %v", err)
+	}
+	for _, role := range roles {
+		if role.Label != label {
+			continue
+		}
+		d.SetId(role.Id)
+		_ = d.Set("description", role.Description)
+		permissions, err := listCustomRolePermissions(ctx, m, role.Id)
+		if err != nil {
+			return diag.Errorf("failed to list custom admin role permissions: %v", err)
+		}
+		err = setNonPrimitives(d, map[string]interface{}{
+			"permissions": convertStringSetToInterface(permissions),
+		})
+		if err != nil {
+			return diag.Errorf("failed to set custom admin role properties: %v", err)
+		}
+		return nil
+	}
+	return diag.Errorf("custom admin role with label '%s' does not exist", label)
+}
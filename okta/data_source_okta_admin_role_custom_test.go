@@ -0,0 +1,34 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceAdminRoleCustom_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(adminRoleCustom)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", adminRoleCustom)
+	dataSourceName := fmt.Sprintf("data.%s.test", adminRoleCustom)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(adminRoleCustom, doesAdminRoleCustomExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "description", "testing, testing"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "permissions.*", "okta.users.read"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "permissions.*", "okta.groups.read"),
+				),
+			},
+		},
+	})
+}
@@ -16,17 +16,23 @@ func dataSourceApp() *schema.Resource {
 			"id": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"label", "label_prefix"},
+				ConflictsWith: []string{"label", "label_prefix", "client_id"},
 			},
 			"label": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"id", "label_prefix"},
+				ConflictsWith: []string{"id", "label_prefix", "client_id"},
 			},
 			"label_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"id", "label"},
+				ConflictsWith: []string{"id", "label", "client_id"},
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"id", "label", "label_prefix"},
+				Description:   "Client ID of the OAuth application.",
 			},
 			"active_only": {
 				Type:        schema.TypeBool,
@@ -69,7 +75,22 @@ func dataSourceAppRead(ctx context.Context, d *schema.ResourceData, m interface{
 		return diag.Errorf("invalid app filters: %v", err)
 	}
 	var app *okta.Application
-	if filters.ID != "" {
+	if filters.ClientID != "" {
+		oauthApp, err := findOAuthAppByClientID(ctx, m, filters)
+		if err != nil {
+			return diag.Errorf("failed to get app by client ID: %v", err)
+		}
+		if oauthApp == nil {
+			return diag.Errorf("no application found with the provided client ID: %s", filters.ClientID)
+		}
+		app = &okta.Application{
+			Id:     oauthApp.Id,
+			Label:  oauthApp.Label,
+			Name:   oauthApp.Name,
+			Status: oauthApp.Status,
+			Links:  oauthApp.Links,
+		}
+	} else if filters.ID != "" {
 		respApp, _, err := getOktaClientFromMetadata(m).Application.GetApplication(ctx, filters.ID, okta.NewApplication(), nil)
 		if err != nil {
 			return diag.Errorf("failed get app by ID: %v", err)
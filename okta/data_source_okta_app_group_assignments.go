@@ -0,0 +1,64 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAppGroupAssignments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAppGroupAssignmentsRead,
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the Okta App being queried for groups",
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of groups, which are assigned to the application",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"profile": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Group-scoped app profile in JSON format",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAppGroupAssignmentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	appID := d.Get("app_id").(string)
+	assignments, err := listApplicationGroupAssignments(ctx, getOktaClientFromMetadata(m), appID)
+	if err != nil {
+		return diag.Errorf("failed to list group assignments of the application '%s': %v", appID, err)
+	}
+	groups := make([]map[string]interface{}, len(assignments))
+	for i := range assignments {
+		groups[i], err = groupAssignmentToTFGroup(assignments[i])
+		if err != nil {
+			return diag.Errorf("failed to set group assignments of the application '%s': %v", appID, err)
+		}
+	}
+	d.SetId(appID)
+	err = setNonPrimitives(d, map[string]interface{}{"groups": groups})
+	if err != nil {
+		return diag.Errorf("failed to set group assignments of the application '%s': %v", appID, err)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceAppGroupAssignments_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appGroupAssignments)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	dataSourceName := "data.okta_app_group_assignments.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "okta_app_oauth.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "3"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "groups.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "groups.0.profile"),
+				),
+			},
+		},
+	})
+}
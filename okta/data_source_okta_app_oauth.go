@@ -18,17 +18,17 @@ func dataSourceAppOauth() *schema.Resource {
 			"id": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"label", "label_prefix"},
+				ConflictsWith: []string{"label", "label_prefix", "client_id"},
 			},
 			"label": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"id", "label_prefix"},
+				ConflictsWith: []string{"id", "label_prefix", "client_id"},
 			},
 			"label_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"id", "label"},
+				ConflictsWith: []string{"id", "label", "client_id"},
 			},
 			"active_only": {
 				Type:        schema.TypeBool,
@@ -63,6 +63,21 @@ func dataSourceAppOauth() *schema.Resource {
 				Computed:    true,
 				Description: "Do not display application icon to users",
 			},
+			"app_links_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Displays specific appLinks for the app",
+			},
+			"consent_method": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates whether user consent is required or implicit",
+			},
+			"issuer_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates whether the Okta Authorization Server uses the original Okta org domain URL or a custom domain URL as the issuer of ID token for this client",
+			},
 			"grant_types": {
 				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -114,9 +129,11 @@ func dataSourceAppOauth() *schema.Resource {
 				Description: "URI to a web page providing information about the client.",
 			},
 			"client_id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "OAuth client ID",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"id", "label", "label_prefix"},
+				Description:   "OAuth client ID",
 			},
 			"policy_uri": {
 				Type:        schema.TypeString,
@@ -150,7 +167,15 @@ func dataSourceAppOauthRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.Errorf("invalid OAuth app filters: %v", err)
 	}
 	var app *okta.OpenIdConnectApplication
-	if filters.ID != "" {
+	if filters.ClientID != "" {
+		app, err = findOAuthAppByClientID(ctx, m, filters)
+		if err != nil {
+			return diag.Errorf("failed to get OAuth app by client ID: %v", err)
+		}
+		if app == nil {
+			return diag.Errorf("no OAuth application found with the provided client ID: %s", filters.ClientID)
+		}
+	} else if filters.ID != "" {
 		respApp, _, err := getOktaClientFromMetadata(m).Application.GetApplication(ctx, filters.ID, okta.NewOpenIdConnectApplication(), nil)
 		if err != nil {
 			return diag.Errorf("failed get app by ID: %v", err)
@@ -191,6 +216,9 @@ func dataSourceAppOauthRead(ctx context.Context, d *schema.ResourceData, m inter
 	_ = d.Set("auto_submit_toolbar", app.Visibility.AutoSubmitToolbar)
 	_ = d.Set("hide_ios", app.Visibility.Hide.IOS)
 	_ = d.Set("hide_web", app.Visibility.Hide.Web)
+	setAppLinks(d, app.Visibility.AppLinks)
+	_ = d.Set("consent_method", app.Settings.OauthClient.ConsentMethod)
+	_ = d.Set("issuer_mode", app.Settings.OauthClient.IssuerMode)
 	_ = d.Set("client_uri", app.Settings.OauthClient.ClientUri)
 	_ = d.Set("logo_uri", app.Settings.OauthClient.LogoUri)
 	_ = d.Set("login_uri", app.Settings.OauthClient.InitiateLoginUri)
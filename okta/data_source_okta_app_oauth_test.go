@@ -31,7 +31,11 @@ func TestAccOktaDataSourceAppOauth_read(t *testing.T) {
 					resource.TestCheckResourceAttr("data.okta_app_oauth.test", "label", buildResourceName(ri)),
 					resource.TestCheckResourceAttr("data.okta_app_oauth.test_label", "label", buildResourceName(ri)),
 					resource.TestCheckResourceAttr("data.okta_app_oauth.test", "status", statusActive),
+					resource.TestCheckResourceAttr("data.okta_app_oauth.test", "consent_method", "TRUSTED"),
+					resource.TestCheckResourceAttr("data.okta_app_oauth.test", "issuer_mode", "ORG_URL"),
 					resource.TestCheckResourceAttr("data.okta_app_oauth.test_label", "status", statusActive),
+					resource.TestCheckResourceAttrPair("data.okta_app_oauth.test_client_id", "id", "okta_app_oauth.test", "id"),
+					resource.TestCheckResourceAttr("data.okta_app_oauth.test_client_id", "client_id", "something_from_somewhere"),
 				),
 			},
 		},
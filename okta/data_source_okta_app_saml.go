@@ -49,6 +49,41 @@ func dataSourceAppSaml() *schema.Resource {
 				Description: "Certificate ID",
 				Computed:    true,
 			},
+			"metadata": {
+				Type:        schema.TypeString,
+				Description: "SAML xml metadata payload",
+				Computed:    true,
+			},
+			"metadata_url": {
+				Type:        schema.TypeString,
+				Description: "SAML xml metadata URL",
+				Computed:    true,
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Description: "cert from SAML XML metadata payload",
+				Computed:    true,
+			},
+			"http_post_binding": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Post location from the SAML metadata.",
+			},
+			"http_redirect_binding": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect location from the SAML metadata.",
+			},
+			"entity_key": {
+				Type:        schema.TypeString,
+				Description: "Entity ID, the ID portion of the entity_url",
+				Computed:    true,
+			},
+			"entity_url": {
+				Type:        schema.TypeString,
+				Description: "Entity URL for instance http://www.okta.com/exk1fcia6d6EMsf331d8",
+				Computed:    true,
+			},
 			"auto_submit_toolbar": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -338,12 +373,24 @@ func dataSourceAppSamlRead(ctx context.Context, d *schema.ResourceData, m interf
 	_ = d.Set("name", app.Name)
 	_ = d.Set("status", app.Status)
 	_ = d.Set("key_id", app.Credentials.Signing.Kid)
+	err = setSamlMetadata(ctx, d, m, app)
+	if err != nil {
+		return diag.Errorf("failed to read SAML app: failed to get SAML metadata: %v", err)
+	}
 	if app.Settings != nil {
 		if app.Settings.SignOn != nil {
 			err = setSamlSettings(d, app.Settings.SignOn)
 			if err != nil {
 				return diag.Errorf("failed to read SAML app: error setting SAML sign-on settings: %v", err)
 			}
+			signOn := app.Settings.SignOn
+			if signOn.Slo != nil && signOn.Slo.Enabled != nil && *signOn.Slo.Enabled {
+				_ = d.Set("single_logout_issuer", signOn.Slo.Issuer)
+				_ = d.Set("single_logout_url", signOn.Slo.LogoutUrl)
+				if signOn.SpCertificate != nil && len(signOn.SpCertificate.X5c) > 0 {
+					_ = d.Set("single_logout_certificate", signOn.SpCertificate.X5c[0])
+				}
+			}
 		}
 		err = setAppSettings(d, app.Settings.App)
 		if err != nil {
@@ -0,0 +1,108 @@
+package okta
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func dataSourceAppSamlCertificate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAppSamlCertificateRead,
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the SAML application.",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the key credential. Defaults to the key, which the application currently signs with.",
+			},
+			"x5c": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "X.509 certificate chain of the key credential, in base64 encoded DER.",
+			},
+			"x5t_s256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Base64url encoded SHA-256 thumbprint of the certificate.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Certificate of the key credential in PEM format.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time in RFC3339 format, when the key credential was created.",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time in RFC3339 format, when the key credential expires.",
+			},
+			"kty": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cryptographic algorithm family for the certificate's key pair.",
+			},
+			"use": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Intended use of the key.",
+			},
+		},
+	}
+}
+
+func dataSourceAppSamlCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	appID := d.Get("app_id").(string)
+	kid := d.Get("key_id").(string)
+	client := getOktaClientFromMetadata(m)
+	if kid == "" {
+		app := okta.NewSamlApplication()
+		_, _, err := client.Application.GetApplication(ctx, appID, app, nil)
+		if err != nil {
+			return diag.Errorf("failed to get SAML application: %v", err)
+		}
+		if app.Credentials == nil || app.Credentials.Signing == nil || app.Credentials.Signing.Kid == "" {
+			return diag.Errorf("SAML application '%s' does not have a signing key credential", appID)
+		}
+		kid = app.Credentials.Signing.Kid
+	}
+	key, _, err := client.Application.GetApplicationKey(ctx, appID, kid)
+	if err != nil {
+		return diag.Errorf("failed to get application key credential: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", appID, key.Kid))
+	_ = d.Set("key_id", key.Kid)
+	_ = d.Set("x5c", convertStringArrToInterface(key.X5c))
+	_ = d.Set("x5t_s256", key.X5tS256)
+	_ = d.Set("kty", key.Kty)
+	_ = d.Set("use", key.Use)
+	if key.Created != nil {
+		_ = d.Set("created", key.Created.Format(time.RFC3339))
+	}
+	if key.ExpiresAt != nil {
+		_ = d.Set("expires_at", key.ExpiresAt.Format(time.RFC3339))
+	}
+	if len(key.X5c) > 0 {
+		cert, err := parseCertificate(key.X5c[0])
+		if err != nil {
+			return diag.Errorf("failed to parse application key certificate: %v", err)
+		}
+		_ = d.Set("certificate", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})))
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceAppSamlCertificate_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appSamlCertificate)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	dataSourceName := "data.okta_app_saml_certificate.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "key_id", "okta_app_saml.test", "key_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "key_id", "data.okta_app_saml_certificate.test_key_id", "key_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "x5c.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "certificate"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "expires_at"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "x5t_s256"),
+				),
+			},
+		},
+	})
+}
@@ -58,12 +58,21 @@ func dataSourceAppMetadataSamlRead(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.Errorf("failed to get app's SAML metadata: %v", err)
 	}
+	if len(metadataRoot.IDPSSODescriptors) == 0 {
+		return diag.Errorf("SAML metadata of the app '%s' doesn't contain the IdP descriptor", id)
+	}
 	d.SetId(fmt.Sprintf("%s/%s_metadata", id, kid))
 	_ = d.Set("metadata", string(metadata))
 	desc := metadataRoot.IDPSSODescriptors[0]
 	syncSamlEndpointBinding(d, desc.SingleSignOnServices)
 	_ = d.Set("entity_id", metadataRoot.EntityID)
-	_ = d.Set("want_authn_requests_signed", desc.WantAuthnRequestsSigned)
-	_ = d.Set("certificate", desc.KeyDescriptors[0].KeyInfo.Certificate)
+	_ = d.Set("want_authn_requests_signed", desc.WantAuthnRequestsSigned != nil && *desc.WantAuthnRequestsSigned)
+	// The metadata lists the signing certificate only, but it might be marked either way
+	for _, key := range desc.KeyDescriptors {
+		if key.Use == "" || key.Use == "signing" {
+			_ = d.Set("certificate", key.KeyInfo.Certificate)
+			break
+		}
+	}
 	return nil
 }
@@ -24,6 +24,8 @@ func TestAccOktaDataSourceAppMetadataSaml_read(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
 					resource.TestCheckResourceAttrSet(resourceName, "http_post_binding"),
+					resource.TestCheckResourceAttrSet(resourceName, "http_redirect_binding"),
+					resource.TestCheckResourceAttr(resourceName, "want_authn_requests_signed", "false"),
 					resource.TestCheckResourceAttrSet(resourceName, "metadata"),
 					resource.TestCheckResourceAttrSet(resourceName, "entity_id"),
 				),
@@ -27,6 +27,9 @@ func TestAccOktaDataSourceAppSaml_read(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.okta_app_saml.test", "key_id"),
+					resource.TestCheckResourceAttrPair("data.okta_app_saml.test", "certificate", "okta_app_saml.test", "certificate"),
+					resource.TestCheckResourceAttrPair("data.okta_app_saml.test", "metadata_url", "okta_app_saml.test", "metadata_url"),
+					resource.TestCheckResourceAttrPair("data.okta_app_saml.test", "entity_url", "okta_app_saml.test", "entity_url"),
 					resource.TestCheckResourceAttr("data.okta_app_saml.test", "label", buildResourceName(ri)),
 					resource.TestCheckResourceAttr("data.okta_app_saml.test_label", "label", buildResourceName(ri)),
 					resource.TestCheckResourceAttr("data.okta_app_saml.test", "status", statusActive),
@@ -33,6 +33,7 @@ func TestAccOktaDataSourceApp_read(t *testing.T) {
 					resource.TestCheckResourceAttr("data.okta_app.test", "status", statusActive),
 					resource.TestCheckResourceAttr("data.okta_app.test2", "status", statusActive),
 					resource.TestCheckResourceAttr("data.okta_app.test3", "status", statusActive),
+					resource.TestCheckResourceAttrPair("data.okta_app.test4", "id", "okta_app_oauth.test", "id"),
 				),
 			},
 		},
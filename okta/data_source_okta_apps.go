@@ -0,0 +1,98 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func dataSourceApps() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAppsRead,
+		Schema: map[string]*schema.Schema{
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Searches the name or label property of applications for matching value",
+			},
+			"active_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Search only ACTIVE applications",
+			},
+			"apps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sign_on_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"json": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Application in JSON format without org-specific attributes and secrets",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAppsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	qp := &query.Params{Limit: defaultPaginationLimit}
+	if q, ok := d.GetOk("q"); ok {
+		qp.Q = q.(string)
+	}
+	if d.Get("active_only").(bool) {
+		qp.Filter = fmt.Sprintf(`status eq "%s"`, statusActive)
+	}
+	apps, _, err := getSupplementFromMetadata(m).ListObjects(ctx, "/api/v1/apps", qp)
+	if err != nil {
+		return diag.Errorf("failed to list applications: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(qp.String()))))
+	arr := make([]map[string]interface{}, len(apps))
+	for i := range apps {
+		payload, err := normalizeExportedObject(apps[i], appSecretKeys...)
+		if err != nil {
+			return diag.Errorf("failed to export application: %v", err)
+		}
+		arr[i] = map[string]interface{}{
+			"id":           apps[i]["id"],
+			"name":         apps[i]["name"],
+			"label":        apps[i]["label"],
+			"status":       apps[i]["status"],
+			"sign_on_mode": apps[i]["signOnMode"],
+			"json":         payload,
+		}
+	}
+	err = setNonPrimitives(d, map[string]interface{}{"apps": arr})
+	if err != nil {
+		return diag.Errorf("failed to set applications: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceApps_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(oktaApps)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", oktaApps)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "apps.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "apps.0.id", "okta_app_oauth.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "apps.0.label", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "apps.0.sign_on_mode", "OPENID_CONNECT"),
+					resource.TestCheckResourceAttrSet(resourceName, "apps.0.json"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,128 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAuthServerMetadata() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAuthServerMetadataRead,
+		Schema: map[string]*schema.Schema{
+			"auth_server_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Auth server ID",
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorization_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"token_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"userinfo_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registration_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"jwks_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"introspection_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revocation_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_session_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"device_authorization_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"response_types_supported": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"grant_types_supported": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"scopes_supported": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"claims_supported": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"token_endpoint_auth_methods_supported": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"code_challenge_methods_supported": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"id_token_signing_alg_values_supported": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAuthServerMetadataRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	authServerID := d.Get("auth_server_id").(string)
+	metadata, _, err := getSupplementFromMetadata(m).GetAuthorizationServerMetadata(ctx, authServerID)
+	if err != nil {
+		return diag.Errorf("failed to get metadata of the auth server '%s': %v", authServerID, err)
+	}
+	d.SetId(authServerID)
+	_ = d.Set("issuer", metadata.Issuer)
+	_ = d.Set("authorization_endpoint", metadata.AuthorizationEndpoint)
+	_ = d.Set("token_endpoint", metadata.TokenEndpoint)
+	_ = d.Set("userinfo_endpoint", metadata.UserinfoEndpoint)
+	_ = d.Set("registration_endpoint", metadata.RegistrationEndpoint)
+	_ = d.Set("jwks_uri", metadata.JwksURI)
+	_ = d.Set("introspection_endpoint", metadata.IntrospectionEndpoint)
+	_ = d.Set("revocation_endpoint", metadata.RevocationEndpoint)
+	_ = d.Set("end_session_endpoint", metadata.EndSessionEndpoint)
+	_ = d.Set("device_authorization_endpoint", metadata.DeviceAuthorizationEndpoint)
+	err = setNonPrimitives(d, map[string]interface{}{
+		"response_types_supported":              convertStringArrToInterface(metadata.ResponseTypesSupported),
+		"grant_types_supported":                 convertStringArrToInterface(metadata.GrantTypesSupported),
+		"scopes_supported":                      convertStringArrToInterface(metadata.ScopesSupported),
+		"claims_supported":                      convertStringArrToInterface(metadata.ClaimsSupported),
+		"token_endpoint_auth_methods_supported": convertStringArrToInterface(metadata.TokenEndpointAuthMethodsSupported),
+		"code_challenge_methods_supported":      convertStringArrToInterface(metadata.CodeChallengeMethodsSupported),
+		"id_token_signing_alg_values_supported": convertStringArrToInterface(metadata.IdTokenSigningAlgValuesSupported),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set auth server metadata properties: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceAuthServerMetadata(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager("okta_auth_server_metadata")
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	dataSourceName := "data.okta_auth_server_metadata.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issuer", fmt.Sprintf("%s/oauth2/default", getOktaDomainName())),
+					resource.TestCheckResourceAttr(dataSourceName, "jwks_uri", fmt.Sprintf("%s/oauth2/default/v1/keys", getOktaDomainName())),
+					resource.TestCheckResourceAttrSet(dataSourceName, "authorization_endpoint"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "token_endpoint"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "scopes_supported.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,111 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func dataSourceAuthServers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAuthServersRead,
+		Schema: map[string]*schema.Schema{
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Searches the name and audiences of authorization servers for matching value",
+			},
+			"auth_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"audiences": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"issuer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issuer_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuthServersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	qp := &query.Params{Limit: defaultPaginationLimit}
+	q, ok := d.GetOk("q")
+	if ok {
+		qp.Q = q.(string)
+	}
+	servers, err := listAuthServers(ctx, getOktaClientFromMetadata(m), qp)
+	if err != nil {
+		return diag.Errorf("failed to list authorization servers: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(qp.String()))))
+	arr := make([]map[string]interface{}, len(servers))
+	for i := range servers {
+		arr[i] = map[string]interface{}{
+			"id":          servers[i].Id,
+			"name":        servers[i].Name,
+			"description": servers[i].Description,
+			"audiences":   convertStringArrToInterface(servers[i].Audiences),
+			"issuer":      servers[i].Issuer,
+			"issuer_mode": servers[i].IssuerMode,
+			"status":      servers[i].Status,
+		}
+	}
+	err = setNonPrimitives(d, map[string]interface{}{"auth_servers": arr})
+	if err != nil {
+		return diag.Errorf("failed to set authorization servers: %v", err)
+	}
+	return nil
+}
+
+func listAuthServers(ctx context.Context, client *okta.Client, qp *query.Params) ([]*okta.AuthorizationServer, error) {
+	var resServers []*okta.AuthorizationServer
+	servers, resp, err := client.AuthorizationServer.ListAuthorizationServers(ctx, qp)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		resServers = append(resServers, servers...)
+		if !resp.HasNextPage() {
+			break
+		}
+		resp, err = resp.Next(ctx, &servers)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resServers, nil
+}
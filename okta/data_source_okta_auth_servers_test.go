@@ -0,0 +1,34 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceAuthServers_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(authServers)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", authServers)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(authServer, authServerExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auth_servers.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "auth_servers.0.id", fmt.Sprintf("%s.test", authServer), "id"),
+					resource.TestCheckResourceAttr(resourceName, "auth_servers.0.name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "auth_servers.0.audiences.0", "whatever.rise.zone"),
+					resource.TestCheckResourceAttr(resourceName, "auth_servers.0.status", statusActive),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,72 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func dataSourceBrand() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBrandRead,
+		Schema: map[string]*schema.Schema{
+			"brand_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the brand. If not set, the default brand of the organization is used",
+			},
+			"custom_privacy_policy_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remove_powered_by_okta": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"domains": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Custom domains associated with the brand",
+			},
+		},
+	}
+}
+
+func dataSourceBrandRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getSupplementFromMetadata(m)
+	var brand *sdk.Brand
+	if id, ok := d.GetOk("brand_id"); ok {
+		var err error
+		brand, _, err = client.GetBrand(ctx, id.(string))
+		if err != nil {
+			return diag.Errorf("failed to get brand: %v", err)
+		}
+	} else {
+		var err error
+		brand, err = findDefaultBrand(ctx, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	domains, _, err := client.ListBrandDomains(ctx, brand.Id)
+	if err != nil {
+		return diag.Errorf("failed to list brand's domains: %v", err)
+	}
+	names := make([]string, len(domains))
+	for i := range domains {
+		names[i] = domains[i].Domain
+	}
+	d.SetId(brand.Id)
+	_ = d.Set("custom_privacy_policy_url", brand.CustomPrivacyPolicyUrl)
+	_ = d.Set("remove_powered_by_okta", brand.RemovePoweredByOkta)
+	err = setNonPrimitives(d, map[string]interface{}{
+		"domains": convertStringSetToInterface(names),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set brand's domains: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func TestAccOktaDataSourceBrand_read(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("data.%s.test", oktaBrand)
+	mgr := newFixtureManager(oktaBrand)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "remove_powered_by_okta"),
+				),
+			},
+		},
+	})
+}
+
+// TestFindDefaultBrand verifies that the brand marked as default is selected, or the one without custom domains, when
+// none is marked.
+func TestFindDefaultBrand(t *testing.T) {
+	cases := []struct {
+		name     string
+		brands   []map[string]interface{}
+		expected string
+	}{
+		{
+			name:     "marked",
+			brands:   []map[string]interface{}{{"id": "custom"}, {"id": "default", "isDefault": true}},
+			expected: "default",
+		},
+		{
+			name:     "without custom domains",
+			brands:   []map[string]interface{}{{"id": "custom"}, {"id": "default"}},
+			expected: "default",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body interface{}
+				switch r.URL.Path {
+				case "/api/v1/brands":
+					body = tc.brands
+				case "/api/v1/brands/custom/domains":
+					body = []map[string]interface{}{{"id": "domain", "domain": "login.example.com"}}
+				case "/api/v1/brands/default/domains":
+					body = []map[string]interface{}{}
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(body)
+			}))
+			defer server.Close()
+			_, client, err := okta.NewClient(context.Background(),
+				okta.WithOrgUrl(server.URL),
+				okta.WithToken("token"),
+				okta.WithTestingDisableHttpsCheck(true),
+				okta.WithCache(false),
+			)
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			m := &Config{supplementClient: &sdk.ApiSupplement{RequestExecutor: client.GetRequestExecutor()}}
+			brand, err := findDefaultBrand(context.Background(), m)
+			if err != nil {
+				t.Fatalf("failed to find default brand: %v", err)
+			}
+			if brand.Id != tc.expected {
+				t.Errorf("expected brand '%s', actual: '%s'", tc.expected, brand.Id)
+			}
+		})
+	}
+}
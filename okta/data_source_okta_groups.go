@@ -2,11 +2,13 @@ package okta
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"hash/crc32"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
@@ -51,6 +53,11 @@ func dataSourceGroups() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"json": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Group in JSON format without org-specific attributes",
+						},
 					},
 				},
 			},
@@ -60,10 +67,6 @@ func dataSourceGroups() *schema.Resource {
 
 func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	qp := &query.Params{Limit: defaultPaginationLimit}
-	groupType, ok := d.GetOk("type")
-	if ok {
-		qp.Filter = fmt.Sprintf("type eq \"%s\"", groupType.(string))
-	}
 	q, ok := d.GetOk("q")
 	if ok {
 		qp.Q = q.(string)
@@ -72,6 +75,15 @@ func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, m interfa
 	if ok {
 		qp.Search = search.(string)
 	}
+	if groupType, ok := d.GetOk("type"); ok {
+		typeExpr := fmt.Sprintf("type eq \"%s\"", groupType.(string))
+		// the filter can't be combined with the search, so the type becomes a part of the search expression
+		if qp.Search != "" {
+			qp.Search = fmt.Sprintf("(%s) and %s", qp.Search, typeExpr)
+		} else {
+			qp.Filter = typeExpr
+		}
+	}
 	groups, err := listGroups(ctx, getOktaClientFromMetadata(m), qp)
 	if err != nil {
 		return diag.Errorf("failed to list groups: %v", err)
@@ -79,13 +91,30 @@ func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, m interfa
 	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(qp.String()))))
 	arr := make([]map[string]interface{}, len(groups))
 	for i := range groups {
+		payload, err := exportGroup(groups[i])
+		if err != nil {
+			return diag.Errorf("failed to export group: %v", err)
+		}
 		arr[i] = map[string]interface{}{
 			"id":          groups[i].Id,
 			"name":        groups[i].Profile.Name,
 			"type":        groups[i].Type,
 			"description": groups[i].Profile.Description,
+			"json":        payload,
 		}
 	}
 	_ = d.Set("groups", arr)
 	return nil
 }
+
+func exportGroup(group *okta.Group) (string, error) {
+	payload, err := json.Marshal(group)
+	if err != nil {
+		return "", err
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(payload, &object); err != nil {
+		return "", err
+	}
+	return normalizeExportedObject(object)
+}
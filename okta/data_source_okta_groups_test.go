@@ -1,10 +1,18 @@
 package okta
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
 )
 
 func TestAccOktaDataSourceGroups_read(t *testing.T) {
@@ -28,8 +36,74 @@ func TestAccOktaDataSourceGroups_read(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.okta_groups.test", "id"),
 					resource.TestCheckResourceAttr("data.okta_groups.test", "groups.#", "2"),
+					resource.TestCheckResourceAttrSet("data.okta_groups.test", "groups.0.json"),
 				),
 			},
 		},
 	})
 }
+
+// TestDataSourceGroupsPagination verifies that the groups on all the pages are returned, and the type is combined with
+// the search expression.
+func TestDataSourceGroupsPagination(t *testing.T) {
+	const (
+		totalGroups = 450
+		pageSize    = 200
+	)
+	var searches []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/groups" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		searches = append(searches, r.URL.Query().Get("search"))
+		start, _ := strconv.Atoi(r.URL.Query().Get("after"))
+		end := start + pageSize
+		if end > totalGroups {
+			end = totalGroups
+		}
+		if end < totalGroups {
+			w.Header().Set("Link", fmt.Sprintf("<http://%s%s?after=%d&limit=%d>; rel=\"next\"", r.Host, r.URL.Path, end, pageSize))
+		}
+		groups := make([]interface{}, 0, end-start)
+		for i := start; i < end; i++ {
+			groups = append(groups, map[string]interface{}{
+				"id":      fmt.Sprintf("group%d", i),
+				"type":    "APP_GROUP",
+				"profile": map[string]interface{}{"name": fmt.Sprintf("Group %d", i)},
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(groups)
+	}))
+	defer server.Close()
+
+	_, client, err := okta.NewClient(context.Background(),
+		okta.WithOrgUrl(server.URL),
+		okta.WithToken("token"),
+		okta.WithTestingDisableHttpsCheck(true),
+		okta.WithCache(false),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	d := schema.TestResourceDataRaw(t, dataSourceGroups().Schema, map[string]interface{}{
+		"search": `profile.name sw "Group"`,
+		"type":   "APP_GROUP",
+	})
+	if diags := dataSourceGroupsRead(context.Background(), d, &Config{oktaClient: client}); diags.HasError() {
+		t.Fatalf("failed to read groups: %v", diags)
+	}
+	if expected := `(profile.name sw "Group") and type eq "APP_GROUP"`; len(searches) == 0 || searches[0] != expected {
+		t.Errorf("expected search %q, actual: %q", expected, searches)
+	}
+	groups := d.Get("groups").([]interface{})
+	if len(groups) != totalGroups {
+		t.Fatalf("expected %d groups, actual: %d", totalGroups, len(groups))
+	}
+	for i, g := range groups {
+		if id := g.(map[string]interface{})["id"].(string); id != fmt.Sprintf("group%d", i) {
+			t.Fatalf("expected group 'group%d' at position %d, actual: '%s'", i, i, id)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func dataSourceIdps() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIdpsRead,
+		Schema: map[string]*schema.Schema{
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Searches the name of identity providers for matching value",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Type of the identity providers, e.g. SAML2, OIDC or GOOGLE",
+			},
+			"idps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issuer_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIdpsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	qp := &query.Params{Limit: defaultPaginationLimit}
+	q, ok := d.GetOk("q")
+	if ok {
+		qp.Q = q.(string)
+	}
+	idpType, ok := d.GetOk("type")
+	if ok {
+		qp.Type = idpType.(string)
+	}
+	idps, err := listIdps(ctx, getOktaClientFromMetadata(m), qp)
+	if err != nil {
+		return diag.Errorf("failed to list identity providers: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(qp.String()))))
+	arr := make([]map[string]interface{}, len(idps))
+	for i := range idps {
+		arr[i] = map[string]interface{}{
+			"id":          idps[i].Id,
+			"name":        idps[i].Name,
+			"type":        idps[i].Type,
+			"issuer_mode": idps[i].IssuerMode,
+			"status":      idps[i].Status,
+		}
+		if idps[i].Protocol != nil {
+			arr[i]["protocol_type"] = idps[i].Protocol.Type
+		}
+	}
+	err = setNonPrimitives(d, map[string]interface{}{"idps": arr})
+	if err != nil {
+		return diag.Errorf("failed to set identity providers: %v", err)
+	}
+	return nil
+}
+
+func listIdps(ctx context.Context, client *okta.Client, qp *query.Params) ([]*okta.IdentityProvider, error) {
+	var resIdps []*okta.IdentityProvider
+	idps, resp, err := client.IdentityProvider.ListIdentityProviders(ctx, qp)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		resIdps = append(resIdps, idps...)
+		if !resp.HasNextPage() {
+			break
+		}
+		resp, err = resp.Next(ctx, &idps)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resIdps, nil
+}
@@ -0,0 +1,34 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceIdps_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(oktaIdps)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", oktaIdps)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(idpOidc, createDoesIdpExist()),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "idps.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "idps.0.id", fmt.Sprintf("%s.test", idpOidc), "id"),
+					resource.TestCheckResourceAttr(resourceName, "idps.0.name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "idps.0.type", "OIDC"),
+					resource.TestCheckResourceAttr(resourceName, "idps.0.protocol_type", "OIDC"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,229 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// maximum number of events the System Log API returns per page
+const logPageLimit = 1000
+
+func dataSourceLog() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLogRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter expression that filters the results, e.g. 'eventType eq \"user.session.start\"'",
+			},
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the log events results by one or more exact keywords",
+			},
+			"since": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the lower time bound of the log events in ISO 8601 format, e.g. '2021-03-01T00:00:00Z'. Defaults to 7 days prior to 'until'",
+			},
+			"until": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the upper time bound of the log events in ISO 8601 format. Defaults to the current time",
+			},
+			"sort_order": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ASCENDING",
+				ValidateDiagFunc: stringInSlice([]string{"ASCENDING", "DESCENDING"}),
+				Description:      "The order of the returned events that are sorted by 'published'",
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				ValidateDiagFunc: intBetween(1, 10000),
+				Description:      "Maximum number of events to return",
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"published": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"outcome_result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"outcome_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"actor_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"actor_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"actor_alternate_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"actor_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"targets": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"alternate_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"display_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLogRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	limit := d.Get("limit").(int)
+	qp := &query.Params{
+		Filter:    d.Get("filter").(string),
+		Q:         d.Get("q").(string),
+		Since:     d.Get("since").(string),
+		Until:     d.Get("until").(string),
+		SortOrder: d.Get("sort_order").(string),
+		Limit:     int64(limit),
+	}
+	if limit > logPageLimit {
+		qp.Limit = logPageLimit
+	}
+	events, err := collectLogEvents(ctx, getOktaClientFromMetadata(m), qp, limit)
+	if err != nil {
+		return diag.Errorf("failed to get system log events: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(fmt.Sprintf("%s&total=%d", qp.String(), limit)))))
+	err = setNonPrimitives(d, map[string]interface{}{
+		"events": flattenLogEvents(events),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set system log events: %v", err)
+	}
+	return nil
+}
+
+// collectLogEvents fetches at most 'limit' events. Without the 'until' bound the API works in the polling mode,
+// where the next page link is always present, so the pagination stops on the first empty page as well.
+func collectLogEvents(ctx context.Context, client *okta.Client, qp *query.Params, limit int) ([]*okta.LogEvent, error) {
+	events, resp, err := client.LogEvent.GetLogs(ctx, qp)
+	if err != nil {
+		return nil, err
+	}
+	for len(events) < limit && resp.HasNextPage() {
+		var nextEvents []*okta.LogEvent
+		resp, err = resp.Next(ctx, &nextEvents)
+		if err != nil {
+			return nil, err
+		}
+		if len(nextEvents) == 0 {
+			break
+		}
+		events = append(events, nextEvents...)
+	}
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+func flattenLogEvents(events []*okta.LogEvent) []interface{} {
+	arr := make([]interface{}, len(events))
+	for i, event := range events {
+		e := map[string]interface{}{
+			"uuid":            event.Uuid,
+			"event_type":      event.EventType,
+			"display_message": event.DisplayMessage,
+			"severity":        event.Severity,
+		}
+		if event.Published != nil {
+			e["published"] = event.Published.Format(time.RFC3339)
+		}
+		if event.Outcome != nil {
+			e["outcome_result"] = event.Outcome.Result
+			e["outcome_reason"] = event.Outcome.Reason
+		}
+		if event.Actor != nil {
+			e["actor_id"] = event.Actor.Id
+			e["actor_type"] = event.Actor.Type
+			e["actor_alternate_id"] = event.Actor.AlternateId
+			e["actor_display_name"] = event.Actor.DisplayName
+		}
+		if event.Client != nil {
+			e["client_ip_address"] = event.Client.IpAddress
+		}
+		targets := make([]interface{}, len(event.Target))
+		for j, target := range event.Target {
+			targets[j] = map[string]interface{}{
+				"id":           target.Id,
+				"type":         target.Type,
+				"alternate_id": target.AlternateId,
+				"display_name": target.DisplayName,
+			}
+		}
+		e["targets"] = targets
+		arr[i] = e
+	}
+	return arr
+}
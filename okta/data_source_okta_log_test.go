@@ -0,0 +1,33 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceLog_read(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("data.%s.test", oktaLog)
+	mgr := newFixtureManager(oktaLog)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "events.#"),
+					resource.TestCheckResourceAttr(resourceName, "events.0.event_type", "user.session.start"),
+				),
+			},
+		},
+	})
+}
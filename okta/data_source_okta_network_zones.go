@@ -0,0 +1,116 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func dataSourceNetworkZones() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkZonesRead,
+		Schema: map[string]*schema.Schema{
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Searches the name of network zones for matching value, the names starting with the value match",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice([]string{"IP", "DYNAMIC", sdk.NetworkZoneTypeDynamicV2}),
+				Description:      "Type of the network zones: IP, DYNAMIC or DYNAMIC_V2",
+			},
+			"usage": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice([]string{"POLICY", "BLOCKLIST"}),
+				Description:      "Usage of the network zones: POLICY or BLOCKLIST",
+			},
+			"network_zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"usage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"system": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the network zone is managed by Okta, e.g. LegacyIpZone",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkZonesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	zones, _, err := getSupplementFromMetadata(m).ListNetworkZones(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list network zones: %v", err)
+	}
+	q := d.Get("q").(string)
+	zoneType := d.Get("type").(string)
+	usage := d.Get("usage").(string)
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(q+zoneType+usage))))
+	var arr []map[string]interface{}
+	for _, zone := range filterNetworkZones(zones, q, zoneType, usage) {
+		arr = append(arr, map[string]interface{}{
+			"id":     zone.ID,
+			"name":   zone.Name,
+			"type":   zone.Type,
+			"usage":  zone.Usage,
+			"status": zone.Status,
+			"system": zone.System,
+		})
+	}
+	err = setNonPrimitives(d, map[string]interface{}{"network_zones": arr})
+	if err != nil {
+		return diag.Errorf("failed to set network zones: %v", err)
+	}
+	return nil
+}
+
+// filterNetworkZones filters the zones the same way the 'q' parameter of the other list endpoints does: the names
+// starting with the value, regardless of the case, match. The zones API doesn't support it.
+func filterNetworkZones(zones []*sdk.NetworkZone, q, zoneType, usage string) []*sdk.NetworkZone {
+	var res []*sdk.NetworkZone
+	for _, zone := range zones {
+		if q != "" && !strings.HasPrefix(strings.ToLower(zone.Name), strings.ToLower(q)) {
+			continue
+		}
+		if zoneType != "" && zone.Type != zoneType {
+			continue
+		}
+		if usage != "" && zone.Usage != usage {
+			continue
+		}
+		res = append(res, zone)
+	}
+	return res
+}
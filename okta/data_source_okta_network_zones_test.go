@@ -0,0 +1,61 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func TestFilterNetworkZones(t *testing.T) {
+	zones := []*sdk.NetworkZone{
+		{ID: "1", Name: "Office", Type: "IP", Usage: "POLICY"},
+		{ID: "2", Name: "office blocklist", Type: "IP", Usage: "BLOCKLIST"},
+		{ID: "3", Name: "Countries", Type: "DYNAMIC", Usage: "POLICY"},
+	}
+	for _, tc := range []struct {
+		q, zoneType, usage string
+		expected           []string
+	}{
+		{"", "", "", []string{"1", "2", "3"}},
+		{"OFFICE", "", "", []string{"1", "2"}},
+		{"office", "IP", "BLOCKLIST", []string{"2"}},
+		{"", "DYNAMIC", "", []string{"3"}},
+		{"blocklist", "", "", nil},
+	} {
+		var ids []string
+		for _, zone := range filterNetworkZones(zones, tc.q, tc.zoneType, tc.usage) {
+			ids = append(ids, zone.ID)
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(tc.expected) {
+			t.Errorf("expected %v for %+v, got %v", tc.expected, tc, ids)
+		}
+	}
+}
+
+func TestAccOktaDataSourceNetworkZones_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(networkZones)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", networkZones)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(networkZone, doesNetworkZoneExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "network_zones.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_zones.0.id", fmt.Sprintf("%s.ip", networkZone), "id"),
+					resource.TestCheckResourceAttr(resourceName, "network_zones.0.name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "network_zones.0.type", "IP"),
+					resource.TestCheckResourceAttr(resourceName, "network_zones.0.usage", "POLICY"),
+				),
+			},
+		},
+	})
+}
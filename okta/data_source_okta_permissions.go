@@ -0,0 +1,60 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"permissions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Permissions, which can be granted by the custom admin roles",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the permission, e.g. 'okta.users.read'",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the permission",
+						},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the permissions",
+			},
+		},
+	}
+}
+
+// The permissions are not available through the API, so the list known to the provider is returned.
+func dataSourcePermissionsRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	names := customRolePermissionNames()
+	permissions := make([]interface{}, len(names))
+	for i, name := range names {
+		permissions[i] = map[string]interface{}{
+			"name":        name,
+			"description": customRolePermissions[name],
+		}
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(strings.Join(names, ",")))))
+	_ = d.Set("permissions", permissions)
+	_ = d.Set("names", convertStringArrToInterface(names))
+	return nil
+}
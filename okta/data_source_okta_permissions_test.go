@@ -0,0 +1,53 @@
+package okta
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaDataSourcePermissions_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(oktaPermissions)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", oktaPermissions)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", strconv.Itoa(len(customRolePermissions))),
+					resource.TestCheckResourceAttr(resourceName, "names.#", strconv.Itoa(len(customRolePermissions))),
+					resource.TestCheckResourceAttr(resourceName, "permissions.0.name", "okta.apps.assignment.manage"),
+					resource.TestCheckResourceAttrSet(resourceName, "permissions.0.description"),
+					func(s *terraform.State) error {
+						output := s.RootModule().Outputs["read_permissions"]
+						if output == nil {
+							return errors.New("expected the read permissions to be found")
+						}
+						if names, ok := output.Value.([]interface{}); !ok || len(names) != 4 {
+							return fmt.Errorf("expected 4 read permissions, got %v", output.Value)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestCustomRolePermissionDescriptions(t *testing.T) {
+	for _, name := range customRolePermissionNames() {
+		if customRolePermissions[name] == "" {
+			t.Errorf("permission '%s' has no description", name)
+		}
+	}
+}
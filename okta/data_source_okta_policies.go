@@ -0,0 +1,115 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func dataSourcePolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePoliciesRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					sdk.SignOnPolicyType,
+					sdk.PasswordPolicyType,
+					sdk.MfaPolicyType,
+					sdk.IdpDiscoveryType,
+					sdk.OauthAuthorizationPolicyType,
+					sdk.ProfileEnrollmentPolicyType,
+				}),
+				Description: "Type of the policies",
+			},
+			"include_rules": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Export the rules of the policies, which requires a request per policy",
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"json": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Policy in JSON format without org-specific attributes",
+						},
+						"rules_json": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Rules of the policy as a JSON array, without org-specific attributes",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getSupplementFromMetadata(m)
+	qp := &query.Params{Type: d.Get("type").(string)}
+	policies, _, err := client.ListObjects(ctx, "/api/v1/policies", qp)
+	if err != nil {
+		return diag.Errorf("failed to list policies: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(qp.String()))))
+	arr := make([]map[string]interface{}, len(policies))
+	for i := range policies {
+		payload, err := normalizeExportedObject(policies[i])
+		if err != nil {
+			return diag.Errorf("failed to export policy: %v", err)
+		}
+		arr[i] = map[string]interface{}{
+			"id":     policies[i]["id"],
+			"name":   policies[i]["name"],
+			"status": policies[i]["status"],
+			"json":   payload,
+		}
+		if !d.Get("include_rules").(bool) {
+			continue
+		}
+		rules, _, err := client.ListObjects(ctx, fmt.Sprintf("/api/v1/policies/%v/rules", policies[i]["id"]), nil)
+		if err != nil {
+			return diag.Errorf("failed to list policy rules: %v", err)
+		}
+		exported := make([]json.RawMessage, len(rules))
+		for j := range rules {
+			rule, err := normalizeExportedObject(rules[j])
+			if err != nil {
+				return diag.Errorf("failed to export policy rule: %v", err)
+			}
+			exported[j] = json.RawMessage(rule)
+		}
+		rulesPayload, _ := json.Marshal(exported)
+		arr[i]["rules_json"] = string(rulesPayload)
+	}
+	err = setNonPrimitives(d, map[string]interface{}{"policies": arr})
+	if err != nil {
+		return diag.Errorf("failed to set policies: %v", err)
+	}
+	return nil
+}
@@ -25,8 +25,9 @@ func dataSourcePolicy() *schema.Resource {
 					sdk.PasswordPolicyType,
 					sdk.MfaPolicyType,
 					sdk.IdpDiscoveryType,
+					sdk.ProfileEnrollmentPolicyType,
 				}),
-				Description: fmt.Sprintf("Policy type: %s, %s, %s, or %s", sdk.SignOnPolicyType, sdk.PasswordPolicyType, sdk.MfaPolicyType, sdk.IdpDiscoveryType),
+				Description: fmt.Sprintf("Policy type: %s, %s, %s, %s, or %s", sdk.SignOnPolicyType, sdk.PasswordPolicyType, sdk.MfaPolicyType, sdk.IdpDiscoveryType, sdk.ProfileEnrollmentPolicyType),
 				Required:    true,
 			},
 		},
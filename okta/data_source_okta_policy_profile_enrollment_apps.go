@@ -0,0 +1,52 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func dataSourcePolicyProfileEnrollmentApps() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePolicyProfileEnrollmentAppsRead,
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the profile enrollment policy.",
+			},
+			"apps": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of IDs of the applications that use the profile enrollment policy.",
+			},
+		},
+	}
+}
+
+func dataSourcePolicyProfileEnrollmentAppsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	policyID := d.Get("policy_id").(string)
+	apps, resp, err := getSupplementFromMetadata(m).ListPolicyApps(ctx, policyID, &query.Params{Limit: defaultPaginationLimit})
+	if err != nil {
+		return diag.Errorf("failed to list applications of the profile enrollment policy: %v", err)
+	}
+	for resp.HasNextPage() {
+		var nextApps []*okta.Application
+		resp, err = resp.Next(ctx, &nextApps)
+		if err != nil {
+			return diag.Errorf("failed to list applications of the profile enrollment policy: %v", err)
+		}
+		apps = append(apps, nextApps...)
+	}
+	ids := make([]string, len(apps))
+	for i := range apps {
+		ids[i] = apps[i].Id
+	}
+	d.SetId(policyID)
+	_ = d.Set("apps", convertStringSetToInterface(ids))
+	return nil
+}
@@ -0,0 +1,32 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourcePolicyProfileEnrollmentApps_read(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("data.%s.test", policyProfileEnrollmentApps)
+	mgr := newFixtureManager(policyProfileEnrollmentApps)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", "data.okta_policy.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "apps.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,89 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// standardAdminRoleLabels are the labels of the standard admin roles, which are shown in the Admin Console.
+var standardAdminRoleLabels = map[string]string{
+	"SUPER_ADMIN":                 "Super Administrator",
+	"ORG_ADMIN":                   "Organization Administrator",
+	"API_ACCESS_MANAGEMENT_ADMIN": "API Access Management Administrator",
+	"APP_ADMIN":                   "Application Administrator",
+	"USER_ADMIN":                  "Group Administrator",
+	"MOBILE_ADMIN":                "Mobile Administrator",
+	"READ_ONLY_ADMIN":             "Read-only Administrator",
+	"HELP_DESK_ADMIN":             "Help Desk Administrator",
+	"REPORT_ADMIN":                "Report Administrator",
+	"GROUP_MEMBERSHIP_ADMIN":      "Group Membership Administrator",
+}
+
+func dataSourceRoles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRolesRead,
+		Schema: map[string]*schema.Schema{
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Standard and custom admin roles",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the standard role or ID of the custom role",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the role, it's 'CUSTOM' for the custom roles",
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Label of the role",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the custom role",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	customRoles, _, err := getSupplementFromMetadata(m).ListCustomRoles(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list custom admin roles: %v", err)
+	}
+	var ids string
+	roles := make([]interface{}, 0, len(validAdminRoles)+len(customRoles))
+	for _, roleType := range validAdminRoles {
+		roles = append(roles, map[string]interface{}{
+			"id":    roleType,
+			"type":  roleType,
+			"label": standardAdminRoleLabels[roleType],
+		})
+	}
+	for _, role := range customRoles {
+		ids += role.Id
+		roles = append(roles, map[string]interface{}{
+			"id":          role.Id,
+			"type":        "CUSTOM",
+			"label":       role.Label,
+			"description": role.Description,
+		})
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(ids))))
+	_ = d.Set("roles", roles)
+	return nil
+}
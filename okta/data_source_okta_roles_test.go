@@ -0,0 +1,40 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaDataSourceRoles_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(oktaRoles)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", oktaRoles)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(adminRoleCustom, doesAdminRoleCustomExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "roles.#"),
+					resource.TestCheckResourceAttr(resourceName, "roles.0.id", "SUPER_ADMIN"),
+					resource.TestCheckResourceAttr(resourceName, "roles.0.label", "Super Administrator"),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources[fmt.Sprintf("%s.test", adminRoleCustom)].Primary.ID
+						if output := s.RootModule().Outputs["custom_role_id"]; output == nil || output.Value != id {
+							return fmt.Errorf("expected the custom role '%s' to be found by its label", id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
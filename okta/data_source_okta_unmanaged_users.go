@@ -0,0 +1,56 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func dataSourceUnmanagedUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUnmanagedUsersRead,
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Okta search expression to limit the users, which are checked for the managed marker, e.g. 'status eq \"ACTIVE\"'",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the users without the managed marker",
+			},
+		},
+	}
+}
+
+func dataSourceUnmanagedUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	attr, value := managedMarker(m)
+	if attr == "" {
+		return diag.Errorf("'managed_marker_attribute' of the provider should be set to find the unmanaged users")
+	}
+	params := &query.Params{Search: d.Get("search").(string), Limit: defaultPaginationLimit}
+	users, err := collectUsers(ctx, getOktaClientFromMetadata(m), params)
+	if err != nil {
+		return diag.Errorf("failed to list users: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(params.String()+attr+value))))
+	_ = d.Set("ids", unmarkedUserIDs(users, attr, value))
+	return nil
+}
+
+func unmarkedUserIDs(users []*okta.User, attr, value string) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		if !isUserMarked(u, attr, value) {
+			ids = append(ids, u.Id)
+		}
+	}
+	return ids
+}
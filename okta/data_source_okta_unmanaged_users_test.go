@@ -0,0 +1,21 @@
+package okta
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func TestUnmarkedUserIDs(t *testing.T) {
+	users := []*okta.User{
+		{Id: "00u1", Profile: &okta.UserProfile{"managedBy": "terraform"}},
+		{Id: "00u2", Profile: &okta.UserProfile{"managedBy": "other-workspace"}},
+		{Id: "00u3", Profile: &okta.UserProfile{"login": "john@example.com"}},
+		{Id: "00u4"},
+	}
+	expected := []string{"00u2", "00u3", "00u4"}
+	if actual := unmarkedUserIDs(users, "managedBy", "terraform"); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected unmarked users %v, got %v", expected, actual)
+	}
+}
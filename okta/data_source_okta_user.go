@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -23,31 +24,47 @@ func dataSourceUser() *schema.Resource {
 			"search": {
 				Type:        schema.TypeSet,
 				Optional:    true,
-				Description: "Filter to find a user, each filter will be concatenated with an AND clause. Please be aware profile properties must match what is in Okta, which is likely camel case",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Property name to search for. This requires the search feature be on. Please see Okta documentation on their filter API for users. https://developer.okta.com/docs/api/resources/users#list-users-with-search",
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"comparison": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							Default:          "eq",
-							ValidateDiagFunc: stringInSlice([]string{"eq", "lt", "gt", "sw"}),
-						},
-					},
-				},
+				Description: "Filter to find a user, each filter will be concatenated with the 'compound_search_operator'. Please be aware profile properties must match what is in Okta, which is likely camel case",
+				Elem:        userSearchSchema,
 			},
+			"compound_search_operator": compoundSearchOperatorSchema,
 		}),
 	}
 }
 
+var userSearchSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Property name to search for. This requires the search feature be on. Please see Okta documentation on their filter API for users. https://developer.okta.com/docs/api/resources/users#list-users-with-search",
+		},
+		"value": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"comparison": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "eq",
+			ValidateDiagFunc: stringInSlice([]string{"eq", "lt", "gt", "sw"}),
+		},
+		"expression": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "A raw search expression string, e.g. 'profile.department eq \"Engineering\" or status eq \"ACTIVE\"'. It's used instead of 'name', 'value' and 'comparison'.",
+		},
+	},
+}
+
+var compoundSearchOperatorSchema = &schema.Schema{
+	Type:             schema.TypeString,
+	Optional:         true,
+	Default:          "and",
+	ValidateDiagFunc: stringInSlice([]string{"and", "or"}),
+	Description:      "Search operator used when joining multiple search clauses",
+}
+
 func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	var user *okta.User
@@ -62,7 +79,10 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 		}
 	} else if searchCriteriaOk {
 		var users []*okta.User
-		sc := getSearchCriteria(d)
+		sc, err := getSearchCriteria(d)
+		if err != nil {
+			return diag.Errorf("invalid search criteria: %v", err)
+		}
 		logger(m).Info("reading user using search", "search", sc)
 		users, _, err = client.User.ListUsers(ctx, &query.Params{Search: sc, Limit: 1})
 		if err != nil {
@@ -89,12 +109,24 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 	return nil
 }
 
-func getSearchCriteria(d *schema.ResourceData) string {
+// getSearchCriteria joins all the search filters with the 'compound_search_operator'. Raw expressions are enclosed in
+// parentheses, so their own operators are not mixed up with the compound one.
+func getSearchCriteria(d *schema.ResourceData) (string, error) {
 	rawFilters := d.Get("search").(*schema.Set)
 	filterList := make([]string, rawFilters.Len())
 	for i, f := range rawFilters.List() {
 		fmap := f.(map[string]interface{})
+		if expression := fmap["expression"].(string); expression != "" {
+			filterList[i] = expression
+			if rawFilters.Len() > 1 {
+				filterList[i] = fmt.Sprintf("(%s)", expression)
+			}
+			continue
+		}
+		if fmap["name"].(string) == "" || fmap["value"].(string) == "" {
+			return "", errors.New("either 'expression' or both 'name' and 'value' should be set in the 'search' block")
+		}
 		filterList[i] = fmt.Sprintf(`%s %s "%s"`, fmap["name"], fmap["comparison"], fmap["value"])
 	}
-	return strings.Join(filterList, " and ")
+	return strings.Join(filterList, fmt.Sprintf(" %s ", d.Get("compound_search_operator").(string))), nil
 }
@@ -0,0 +1,107 @@
+package okta
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// securityQuestions are the security questions supported by Okta, the keys are known before the user is created, so
+// they can be validated at plan time.
+var securityQuestions = map[string]string{
+	"disliked_food":                         "What is the food you least liked as a child?",
+	"name_of_first_plush_toy":               "What is the name of your first stuffed animal?",
+	"first_award":                           "What did you earn your first medal or award for?",
+	"favorite_security_question":            "What is your favorite security question?",
+	"favorite_toy":                          "What is the toy/stuffed animal you liked the most as a kid?",
+	"first_computer_game":                   "What was the first computer game you played?",
+	"favorite_movie_quote":                  "What is your favorite movie quote?",
+	"first_sports_team_mascot":              "What was the mascot of the first sports team you played on?",
+	"first_music_purchase":                  "What music album or song did you first purchase?",
+	"favorite_art_piece":                    "What is your favorite piece of art?",
+	"grandmother_favorite_desert":           "What was your grandmother's favorite dessert?",
+	"first_thing_cooked":                    "What was the first thing you learned to cook?",
+	"childhood_dream_job":                   "What was your dream job as a child?",
+	"first_kiss_location":                   "Where did you have your first kiss?",
+	"place_where_significant_other_was_met": "Where did you meet your spouse/significant other?",
+	"favorite_vacation_location":            "Where did you go for your favorite vacation?",
+	"new_years_two_thousand":                "Where were you on New Year's Eve in the year 2000?",
+	"favorite_speaker_actor":                "Who is your favorite speaker/orator?",
+	"favorite_book_movie_character":         "Who is your favorite book/movie character?",
+	"favorite_sports_player":                "Who is your favorite sports player?",
+}
+
+func securityQuestionKeys() []string {
+	keys := make([]string, 0, len(securityQuestions))
+	for k := range securityQuestions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func dataSourceUserSecurityQuestions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserSecurityQuestionsRead,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of a user to retrieve the security questions for",
+			},
+			"key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice(securityQuestionKeys()),
+				Description:      "Key of the security question, which should be available for the user",
+			},
+			"questions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"text": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserSecurityQuestionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	questions, _, err := getOktaClientFromMetadata(m).UserFactor.ListSupportedSecurityQuestions(ctx, userID)
+	if err != nil {
+		return diag.Errorf("failed to list security questions for '%s' user: %v", userID, err)
+	}
+	key := d.Get("key").(string)
+	found := key == ""
+	arr := make([]map[string]interface{}, len(questions))
+	for i := range questions {
+		found = found || questions[i].Question == key
+		arr[i] = map[string]interface{}{
+			"key":  questions[i].Question,
+			"text": questions[i].QuestionText,
+		}
+	}
+	if !found {
+		return diag.Errorf("security question '%s' is not available for '%s' user", key, userID)
+	}
+	d.SetId(userID)
+	err = setNonPrimitives(d, map[string]interface{}{
+		"questions": arr,
+	})
+	if err != nil {
+		return diag.Errorf("failed to set security questions: %v", err)
+	}
+	return nil
+}
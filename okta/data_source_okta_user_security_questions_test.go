@@ -0,0 +1,53 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaDataSourceUserSecurityQuestions_read(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("data.%s.test", userSecurityQuestions)
+	mgr := newFixtureManager(userSecurityQuestions)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "questions.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "questions.0.key"),
+					resource.TestCheckResourceAttrSet(resourceName, "questions.0.text"),
+				),
+			},
+		},
+	})
+}
+
+func TestUserSecurityQuestionsKeyValidation(t *testing.T) {
+	d := dataSourceUserSecurityQuestions()
+	diags := d.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"user_id": "00u1",
+		"key":     "disliked_food",
+	}))
+	if diags.HasError() {
+		t.Errorf("expected the supported security question to be valid, got %v", diags)
+	}
+	diags = d.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"user_id": "00u1",
+		"key":     "favorite_color",
+	}))
+	if !diags.HasError() {
+		t.Error("expected the unsupported security question to fail the validation")
+	}
+}
@@ -18,27 +18,10 @@ func dataSourceUsers() *schema.Resource {
 			"search": {
 				Type:        schema.TypeSet,
 				Required:    true,
-				Description: "Filter to find a user, each filter will be concatenated with an AND clause. Please be aware profile properties must match what is in Okta, which is likely camel case",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Property name to search for. This requires the search feature be on. Please see Okta documentation on their filter API for users. https://developer.okta.com/docs/api/resources/users#list-users-with-search",
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"comparison": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							Default:          "eq",
-							ValidateDiagFunc: stringInSlice([]string{"eq", "lt", "gt", "sw"}),
-						},
-					},
-				},
+				Description: "Filter to find users, each filter will be concatenated with the 'compound_search_operator'. Please be aware profile properties must match what is in Okta, which is likely camel case",
+				Elem:        userSearchSchema,
 			},
+			"compound_search_operator": compoundSearchOperatorSchema,
 			"users": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -57,7 +40,11 @@ func dataSourceUsers() *schema.Resource {
 }
 
 func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	params := &query.Params{Search: getSearchCriteria(d), Limit: defaultPaginationLimit, SortOrder: "0"}
+	sc, err := getSearchCriteria(d)
+	if err != nil {
+		return diag.Errorf("invalid search criteria: %v", err)
+	}
+	params := &query.Params{Search: sc, Limit: defaultPaginationLimit, SortOrder: "0"}
 	users, err := collectUsers(ctx, getOktaClientFromMetadata(m), params)
 	if err != nil {
 		return diag.Errorf("failed to list users: %v", err)
@@ -1,10 +1,12 @@
 package okta
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccOktaDataSourceUsers_read(t *testing.T) {
@@ -12,6 +14,7 @@ func TestAccOktaDataSourceUsers_read(t *testing.T) {
 	mgr := newFixtureManager("okta_users")
 	users := mgr.GetFixtures("users.tf", ri, t)
 	config := mgr.GetFixtures("basic.tf", ri, t)
+	expression := mgr.GetFixtures("expression.tf", ri, t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -36,6 +39,76 @@ func TestAccOktaDataSourceUsers_read(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.okta_users.test", "users.#"),
 				),
 			},
+			{
+				// Jones is found by the last name, Astley by the expression, however Okta search is eventually
+				// consistent, so only the presence of the users is checked
+				Config: expression,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.okta_users.test", "users.#"),
+				),
+			},
 		},
 	})
 }
+
+func TestGetSearchCriteria(t *testing.T) {
+	tests := []struct {
+		operator string
+		search   []interface{}
+		expected string
+		err      bool
+	}{
+		{
+			operator: "and",
+			search:   []interface{}{map[string]interface{}{"name": "profile.firstName", "value": "John"}},
+			expected: `profile.firstName eq "John"`,
+		},
+		{
+			operator: "or",
+			search:   []interface{}{map[string]interface{}{"expression": `status eq "ACTIVE" and profile.lastName sw "D"`}},
+			expected: `status eq "ACTIVE" and profile.lastName sw "D"`,
+		},
+		{
+			operator: "or",
+			search: []interface{}{
+				map[string]interface{}{"name": "profile.lastName", "value": "Doe"},
+				map[string]interface{}{"expression": `status eq "ACTIVE" and profile.lastName sw "D"`},
+			},
+			expected: `(status eq "ACTIVE" and profile.lastName sw "D") or profile.lastName eq "Doe"`,
+		},
+		{
+			operator: "and",
+			search:   []interface{}{map[string]interface{}{"name": "profile.lastName"}},
+			err:      true,
+		},
+	}
+	for i, test := range tests {
+		d := schema.TestResourceDataRaw(t, dataSourceUsers().Schema, map[string]interface{}{
+			"search":                   test.search,
+			"compound_search_operator": test.operator,
+		})
+		actual, err := getSearchCriteria(d)
+		if test.err {
+			if err == nil {
+				t.Errorf("%d: expected an error for search %v", i, test.search)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if len(test.search) > 1 {
+			// the order of the set elements depends on their hashes
+			for _, part := range strings.Split(test.expected, " or ") {
+				if !strings.Contains(actual, part) {
+					t.Errorf("%d: expected '%s' to contain '%s'", i, actual, part)
+				}
+			}
+			continue
+		}
+		if actual != test.expected {
+			t.Errorf("%d: expected '%s', actual: '%s'", i, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package okta
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceX509Certificate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceX509CertificateRead,
+		Schema: map[string]*schema.Schema{
+			"certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Certificate in PEM format, or base64 encoded DER, e.g. the 'x5c' of the app or IdP key credential.",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subject of the certificate.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Issuer of the certificate.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Serial number of the certificate in hexadecimal format.",
+			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time in RFC3339 format, when the certificate becomes valid.",
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time in RFC3339 format, when the certificate expires.",
+			},
+			"sha1_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-1 fingerprint of the certificate.",
+			},
+			"sha256_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 fingerprint of the certificate.",
+			},
+		},
+	}
+}
+
+func dataSourceX509CertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	cert, err := parseCertificate(d.Get("certificate").(string))
+	if err != nil {
+		return diag.Errorf("failed to parse certificate: %v", err)
+	}
+	sha256Sum := sha256.Sum256(cert.Raw)
+	sha1Sum := sha1.Sum(cert.Raw)
+	d.SetId(fmt.Sprintf("%x", sha256Sum))
+	_ = d.Set("subject", cert.Subject.String())
+	_ = d.Set("issuer", cert.Issuer.String())
+	_ = d.Set("serial_number", fmt.Sprintf("%x", cert.SerialNumber))
+	_ = d.Set("not_before", cert.NotBefore.UTC().Format(time.RFC3339))
+	_ = d.Set("not_after", cert.NotAfter.UTC().Format(time.RFC3339))
+	_ = d.Set("sha1_fingerprint", certificateFingerprint(sha1Sum[:]))
+	_ = d.Set("sha256_fingerprint", certificateFingerprint(sha256Sum[:]))
+	return nil
+}
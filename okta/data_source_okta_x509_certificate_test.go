@@ -0,0 +1,32 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOktaX509Certificate_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(x509Certificate)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", x509Certificate)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "subject", "CN=example.com,O=Example"),
+					resource.TestCheckResourceAttr(resourceName, "not_after", "2036-10-14T02:18:42Z"),
+					resource.TestCheckResourceAttr(resourceName, "sha256_fingerprint",
+						"EC:EB:38:F1:00:77:2E:BE:50:C7:AB:84:1B:91:5A:B4:CF:11:FF:12:5F:67:65:4E:D5:FC:9B:30:A2:11:50:B3"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,49 @@
+package okta
+
+import (
+	"encoding/json"
+)
+
+// Attributes of the exported objects, which are specific to the org the objects were read from.
+var orgSpecificKeys = []string{"id", "created", "lastUpdated", "lastMembershipUpdated", "_links", "_embedded"}
+
+// Attributes of the exported applications, which contain secrets or keys of the org.
+var appSecretKeys = []string{"client_secret", "password", "sharedPassword", "kid"}
+
+// normalizeExportedObject returns the JSON of the object without the org-specific attributes, so it can be used to
+// create the same object in another org. Keys of the JSON are sorted, so the output is stable. The 'omitKeys' are
+// removed at any level of the object.
+func normalizeExportedObject(object map[string]interface{}, omitKeys ...string) (string, error) {
+	normalized := make(map[string]interface{}, len(object))
+	for k, v := range object {
+		if !contains(orgSpecificKeys, k) {
+			normalized[k] = v
+		}
+	}
+	payload, err := json.Marshal(omitNestedKeys(normalized, omitKeys))
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+func omitNestedKeys(v interface{}, keys []string) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(value))
+		for k, nested := range value {
+			if !contains(keys, k) && k != "_links" {
+				res[k] = omitNestedKeys(nested, keys)
+			}
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(value))
+		for i := range value {
+			res[i] = omitNestedKeys(value[i], keys)
+		}
+		return res
+	default:
+		return v
+	}
+}
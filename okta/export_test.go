@@ -0,0 +1,30 @@
+package okta
+
+import (
+	"testing"
+)
+
+func TestNormalizeExportedObject(t *testing.T) {
+	object := map[string]interface{}{
+		"id":          "0oa1",
+		"created":     "2021-01-01T00:00:00.000Z",
+		"lastUpdated": "2021-01-01T00:00:00.000Z",
+		"_links":      map[string]interface{}{"self": "https://example.okta.com/api/v1/apps/0oa1"},
+		"label":       "Example",
+		"credentials": map[string]interface{}{
+			"oauthClient": map[string]interface{}{"client_id": "abc", "client_secret": "secret"},
+			"signing":     map[string]interface{}{"kid": "key"},
+		},
+		"settings": []interface{}{
+			map[string]interface{}{"id": "nested", "password": "secret", "_links": "link"},
+		},
+	}
+	expected := `{"credentials":{"oauthClient":{"client_id":"abc"},"signing":{}},"label":"Example","settings":[{"id":"nested"}]}`
+	actual, err := normalizeExportedObject(object, appSecretKeys...)
+	if err != nil {
+		t.Fatalf("failed to normalize object: %v", err)
+	}
+	if actual != expected {
+		t.Errorf("expected '%s', actual '%s'", expected, actual)
+	}
+}
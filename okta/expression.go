@@ -0,0 +1,94 @@
+package okta
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+var expressionBrackets = map[rune]rune{')': '(', ']': '[', '}': '{'}
+
+// lintExpression does a local syntax check of the Okta Expression Language expression, so the typos are found during
+// plan instead of producing rules, which silently match nothing. Only the mistakes, which can not be valid in any
+// expression, are reported: unbalanced brackets, unterminated strings, assignments and incomplete operators.
+func lintExpression(expr string) error {
+	trimmed := strings.TrimSpace(expr)
+	if trimmed == "" {
+		return fmt.Errorf("expression is empty")
+	}
+	runes := []rune(trimmed)
+	var (
+		brackets []int
+		quote    rune
+		quoteAt  int
+	)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if quote != 0 {
+			if r == '\\' {
+				i++
+			} else if r == quote {
+				quote = 0
+			}
+			continue
+		}
+		prev, next := expressionRuneAt(runes, i-1), expressionRuneAt(runes, i+1)
+		switch r {
+		case '"', '\'':
+			quote, quoteAt = r, i
+		case '(', '[', '{':
+			brackets = append(brackets, i)
+		case ')', ']', '}':
+			if len(brackets) == 0 || runes[brackets[len(brackets)-1]] != expressionBrackets[r] {
+				return fmt.Errorf("unexpected '%c' at position %d", r, i+1)
+			}
+			brackets = brackets[:len(brackets)-1]
+		case '=':
+			if prev != '=' && prev != '!' && prev != '<' && prev != '>' && next != '=' {
+				return fmt.Errorf("unexpected '=' at position %d, use '==' for comparison", i+1)
+			}
+		case '&', '|':
+			if prev != r && next != r {
+				return fmt.Errorf("unexpected '%c' at position %d, use '%c%c' for logical operations", r, i+1, r, r)
+			}
+		}
+	}
+	if quote != 0 {
+		return fmt.Errorf("unterminated string starting at position %d", quoteAt+1)
+	}
+	if len(brackets) > 0 {
+		i := brackets[len(brackets)-1]
+		return fmt.Errorf("unclosed '%c' at position %d", runes[i], i+1)
+	}
+	for _, op := range []string{"&&", "||", "==", "!=", "<", ">", "!", ".", ",", "AND", "OR", "NOT"} {
+		if strings.HasSuffix(trimmed, op) && (op[0] < 'A' || op[0] > 'Z' || trimmed == op || isExpressionSeparator(runes[len(runes)-len(op)-1])) {
+			return fmt.Errorf("expression is incomplete, it ends with '%s'", op)
+		}
+	}
+	return nil
+}
+
+func expressionRuneAt(runes []rune, i int) rune {
+	if i < 0 || i >= len(runes) {
+		return 0
+	}
+	return runes[i]
+}
+
+func isExpressionSeparator(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '(' || r == ')'
+}
+
+// stringIsExpression validates the syntax of the Okta Expression Language expression.
+func stringIsExpression(i interface{}, k cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %s to be string", k)
+	}
+	if err := lintExpression(v); err != nil {
+		return diag.Errorf("%s contains an invalid Okta Expression Language expression: %v", k, err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package okta
+
+import "testing"
+
+func TestLintExpression(t *testing.T) {
+	tests := []struct {
+		expr  string
+		valid bool
+	}{
+		{`user.department == "Engineering"`, true},
+		{`String.stringContains(user.email, "@example.com") && user.title != "Intern"`, true},
+		{`user.isMemberOf({'group.id':{'00gjitX9HqABSoqTB0g3'}}) OR isMemberOfAnyGroup("00g1", "00g2")`, true},
+		{`user.age >= 18 ? "adult" : "minor"`, true},
+		{`user.title == 'It\'s me (really)'`, true},
+		{`NOT user.isActive`, true},
+		{`user.brand == "OKTA_BRAND"`, true},
+		{``, false},
+		{`   `, false},
+		{`user.department = "Engineering"`, false},
+		{`user.department == "Engineering`, false},
+		{`String.stringContains(user.email, "@example.com"`, false},
+		{`user.isMemberOf({'group.id':{'00g'})`, false},
+		{`user.a == "x")`, false},
+		{`user.a == "x" & user.b == "y"`, false},
+		{`user.a == "x" | user.b == "y"`, false},
+		{`user.a == "x" &&`, false},
+		{`user.a == "x" AND`, false},
+		{`user.`, false},
+	}
+	for _, test := range tests {
+		err := lintExpression(test.expr)
+		if test.valid && err != nil {
+			t.Errorf("expected '%s' to be valid, got: %v", test.expr, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("expected '%s' to be invalid", test.expr)
+		}
+	}
+}
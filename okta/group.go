@@ -31,22 +31,19 @@ func listGroupUserIDs(ctx context.Context, m interface{}, id string) ([]string,
 }
 
 func listGroups(ctx context.Context, client *okta.Client, qp *query.Params) ([]*okta.Group, error) {
-	var resGroups []*okta.Group
 	groups, resp, err := client.Group.ListGroups(ctx, qp)
 	if err != nil {
 		return nil, err
 	}
-	for {
-		resGroups = append(resGroups, groups...)
-		if resp.HasNextPage() {
-			resp, err = resp.Next(ctx, &groups)
-			if err != nil {
-				return nil, err
-			}
-			continue
-		} else {
-			break
+	for resp.HasNextPage() {
+		// the next page is decoded into a new slice, since decoding into the previous one overwrites the groups,
+		// which have already been collected
+		var nextGroups []*okta.Group
+		resp, err = resp.Next(ctx, &nextGroups)
+		if err != nil {
+			return nil, err
 		}
+		groups = append(groups, nextGroups...)
 	}
-	return resGroups, nil
+	return groups, nil
 }
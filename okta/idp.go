@@ -199,17 +199,13 @@ func resourceDeleteAnyIdp(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func setIdpStatus(ctx context.Context, d *schema.ResourceData, client *okta.Client, status string) error {
-	desiredStatus := d.Get("status").(string)
-	if status == desiredStatus {
-		return nil
-	}
-	var err error
-	if desiredStatus == statusInactive {
-		_, _, err = client.IdentityProvider.DeactivateIdentityProvider(ctx, d.Id())
-	} else {
-		_, _, err = client.IdentityProvider.ActivateIdentityProvider(ctx, d.Id())
-	}
-	return err
+	return changeStatus(status, d.Get("status").(string), func() error {
+		_, _, err := client.IdentityProvider.ActivateIdentityProvider(ctx, d.Id())
+		return err
+	}, func() error {
+		_, _, err := client.IdentityProvider.DeactivateIdentityProvider(ctx, d.Id())
+		return err
+	})
 }
 
 func syncEndpoint(key string, e *okta.ProtocolEndpoint, d *schema.ResourceData) {
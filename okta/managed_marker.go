@@ -0,0 +1,51 @@
+package okta
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// The managed marker identifies the objects managed by Terraform, so the objects created outside of it can be found
+// for the drift governance. The marker is a custom profile attribute of the users (it must be defined in the user
+// schema, e.g. with 'okta_user_schema'), which is set to the configured value on create and update.
+
+// managedMarker returns the attribute and the value of the marker, the attribute is empty when it's not configured.
+func managedMarker(meta interface{}) (string, string) {
+	c := meta.(*Config)
+	return c.managedMarkerAttr, c.managedMarkerValue
+}
+
+// stampUserProfile sets the marker in the profile, unless the attribute is set in the configuration explicitly.
+func stampUserProfile(meta interface{}, profile *okta.UserProfile) {
+	attr, value := managedMarker(meta)
+	if attr == "" {
+		return
+	}
+	if _, ok := (*profile)[attr]; ok {
+		return
+	}
+	(*profile)[attr] = value
+}
+
+// userAttributesToIgnore returns the custom attributes, which are not read into the state: the ones ignored by the
+// configuration, and the marker, unless it's set in the configuration explicitly.
+func userAttributesToIgnore(d *schema.ResourceData, meta interface{}) []string {
+	ignored := convertInterfaceToStringSetNullable(d.Get("custom_profile_attributes_to_ignore"))
+	attr, _ := managedMarker(meta)
+	if attr == "" {
+		return ignored
+	}
+	if _, ok := (*populateUserProfile(d))[attr]; ok {
+		return ignored
+	}
+	return append(ignored, attr)
+}
+
+// isUserMarked reports whether the user has the marker.
+func isUserMarked(u *okta.User, attr, value string) bool {
+	if u.Profile == nil {
+		return false
+	}
+	v, ok := (*u.Profile)[attr].(string)
+	return ok && v == value
+}
@@ -0,0 +1,45 @@
+package okta
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func TestStampUserProfile(t *testing.T) {
+	meta := &Config{managedMarkerAttr: "managedBy", managedMarkerValue: "terraform"}
+	profile := &okta.UserProfile{"login": "john@example.com"}
+	stampUserProfile(meta, profile)
+	if (*profile)["managedBy"] != "terraform" {
+		t.Errorf("expected profile to be marked, got %v", *profile)
+	}
+	// the explicitly configured value is kept
+	profile = &okta.UserProfile{"managedBy": "manual"}
+	stampUserProfile(meta, profile)
+	if (*profile)["managedBy"] != "manual" {
+		t.Errorf("expected configured value to be kept, got %v", *profile)
+	}
+	profile = &okta.UserProfile{}
+	stampUserProfile(&Config{}, profile)
+	if len(*profile) != 0 {
+		t.Errorf("expected profile not to be marked without the marker, got %v", *profile)
+	}
+}
+
+func TestUserAttributesToIgnore(t *testing.T) {
+	meta := &Config{managedMarkerAttr: "managedBy", managedMarkerValue: "terraform"}
+	d := schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+		"custom_profile_attributes_to_ignore": []interface{}{"nickName"},
+	})
+	if actual := userAttributesToIgnore(d, meta); !reflect.DeepEqual([]string{"nickName", "managedBy"}, actual) {
+		t.Errorf("expected marker to be ignored, got %v", actual)
+	}
+	d = schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+		"custom_profile_attributes": `{"managedBy":"manual"}`,
+	})
+	if actual := userAttributesToIgnore(d, meta); len(actual) != 0 {
+		t.Errorf("expected configured marker not to be ignored, got %v", actual)
+	}
+}
@@ -0,0 +1,92 @@
+package okta
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+type (
+	// RequestMiddleware wraps the transport, which is used by the provider to send the requests to Okta.
+	// The returned http.RoundTripper should call the 'next' one to actually send the request.
+	RequestMiddleware func(next http.RoundTripper) http.RoundTripper
+
+	// RetryMiddleware wraps the policy, which decides if the request to Okta should be retried.
+	// It is applied only when the provider is configured with 'backoff'.
+	RetryMiddleware func(next retryablehttp.CheckRetry) retryablehttp.CheckRetry
+
+	// RoundTripperFunc is an adapter to allow the use of ordinary functions as http.RoundTripper.
+	RoundTripperFunc func(req *http.Request) (*http.Response, error)
+)
+
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+var (
+	middlewareLock     sync.Mutex
+	requestMiddlewares []RequestMiddleware
+	retryMiddlewares   []RetryMiddleware
+)
+
+// RegisterRequestMiddleware adds the middleware to the chain, which is applied to every request (and every retry of it)
+// made by the provider. The middlewares should be registered before the provider is configured, e.g. in 'init'
+// function of the package, which serves the provider. The middleware registered first is called first.
+func RegisterRequestMiddleware(mw RequestMiddleware) {
+	middlewareLock.Lock()
+	defer middlewareLock.Unlock()
+	requestMiddlewares = append(requestMiddlewares, mw)
+}
+
+// RegisterRetryMiddleware adds the middleware to the chain, which decides if the failed request should be retried.
+// The middleware registered first is called first, the default retry policy of the provider is called last.
+func RegisterRetryMiddleware(mw RetryMiddleware) {
+	middlewareLock.Lock()
+	defer middlewareLock.Unlock()
+	retryMiddlewares = append(retryMiddlewares, mw)
+}
+
+// HeaderMiddleware returns middleware, which sets the given headers on every request.
+func HeaderMiddleware(headers http.Header) RequestMiddleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			for k, v := range headers {
+				req.Header[http.CanonicalHeaderKey(k)] = v
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// MetricsMiddleware returns middleware, which reports every request, its outcome and duration to the given function.
+func MetricsMiddleware(observe func(req *http.Request, resp *http.Response, err error, duration time.Duration)) RequestMiddleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next.RoundTrip(req)
+			observe(req, resp, err, time.Since(start))
+			return resp, err
+		})
+	}
+}
+
+func applyRequestMiddlewares(t http.RoundTripper) http.RoundTripper {
+	middlewareLock.Lock()
+	defer middlewareLock.Unlock()
+	for i := len(requestMiddlewares) - 1; i >= 0; i-- {
+		t = requestMiddlewares[i](t)
+	}
+	return t
+}
+
+func applyRetryMiddlewares(policy retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+	middlewareLock.Lock()
+	defer middlewareLock.Unlock()
+	for i := len(retryMiddlewares) - 1; i >= 0; i-- {
+		policy = retryMiddlewares[i](policy)
+	}
+	return policy
+}
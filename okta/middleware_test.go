@@ -0,0 +1,55 @@
+package okta
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequestMiddlewares(t *testing.T) {
+	defer func() { requestMiddlewares = nil }()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("X-Test")))
+	}))
+	defer server.Close()
+
+	var calls []string
+	trace := func(name string) RequestMiddleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+	var observed int
+	RegisterRequestMiddleware(trace("first"))
+	RegisterRequestMiddleware(HeaderMiddleware(http.Header{"x-test": []string{"value"}}))
+	RegisterRequestMiddleware(MetricsMiddleware(func(_ *http.Request, resp *http.Response, err error, _ time.Duration) {
+		if err == nil {
+			observed = resp.StatusCode
+		}
+	}))
+	RegisterRequestMiddleware(trace("last"))
+
+	client := &http.Client{Transport: applyRequestMiddlewares(http.DefaultTransport)}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	if strings.Join(calls, ",") != "first,last" {
+		t.Errorf("expected middlewares to be called in the order of registration, actual: %v", calls)
+	}
+	if string(body) != "value" {
+		t.Errorf("expected header to be set by middleware, actual: '%s'", string(body))
+	}
+	if observed != http.StatusOK {
+		t.Errorf("expected status code %d to be observed, actual: %d", http.StatusOK, observed)
+	}
+}
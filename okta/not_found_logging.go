@@ -0,0 +1,84 @@
+package okta
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type operationKey struct{}
+
+// operation identifies the resource or data source, which operation made the API call. It is stored in the context of
+// the operation, and it's available in the responses, since the requests to Okta are made with the same context.
+type operation struct {
+	name   string
+	id     string
+	logger hclog.Logger
+}
+
+func withOperationContext(name string, f contextFunc) contextFunc {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c, ok := m.(*Config)
+		if !ok || c.logger == nil {
+			return f(ctx, d, m)
+		}
+		op := &operation{name: name, id: d.Id(), logger: c.logger}
+		return f(context.WithValue(ctx, operationKey{}, op), d, m)
+	}
+}
+
+// withRemovalLogging warns when the read of the resource removes it from the state, since the object has been deleted
+// outside of Terraform.
+func withRemovalLogging(name string, f contextFunc) contextFunc {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		id := d.Id()
+		diags := f(ctx, d, m)
+		if id != "" && d.Id() == "" && !diags.HasError() {
+			if c, ok := m.(*Config); ok && c.logger != nil {
+				c.logger.Warn("object no longer exists in Okta, removing it from the state", "resource", name, "id", id)
+			}
+		}
+		return diags
+	}
+}
+
+// addNotFoundLogging makes the 404 responses, which are suppressed by suppressErrorOn404, logged along with the
+// resource that made the request, so the objects that disappear outside of Terraform don't go unnoticed on refresh.
+func addNotFoundLogging(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		r.CreateContext = withOperationContext(name, r.CreateContext)
+		r.ReadContext = withOperationContext(name, withRemovalLogging(name, r.ReadContext))
+		r.UpdateContext = withOperationContext(name, r.UpdateContext)
+		r.DeleteContext = withOperationContext(name, r.DeleteContext)
+	}
+	for name, r := range p.DataSourcesMap {
+		r.ReadContext = withOperationContext(name, r.ReadContext)
+	}
+}
+
+func logSuppressedNotFound(resp *okta.Response) {
+	if resp == nil || resp.Response == nil || resp.Request == nil {
+		return
+	}
+	req := resp.Request
+	op, ok := req.Context().Value(operationKey{}).(*operation)
+	if !ok {
+		log.Printf("[WARN] suppressed 404 Not Found response: method=%s path=%s", req.Method, req.URL.Path)
+		return
+	}
+	args := []interface{}{"resource", op.name, "method", req.Method, "path", req.URL.Path}
+	if op.id != "" {
+		args = append(args, "id", op.id)
+	}
+	op.logger.Warn("suppressed 404 Not Found response", args...)
+}
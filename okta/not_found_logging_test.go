@@ -0,0 +1,46 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func TestSuppressErrorOn404Logging(t *testing.T) {
+	var buf bytes.Buffer
+	c := &Config{logger: hclog.New(&hclog.LoggerOptions{Output: &buf})}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("00g1")
+	read := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.okta.com/api/v1/groups/00g1", nil)
+		resp := &okta.Response{Response: &http.Response{StatusCode: http.StatusNotFound, Request: req}}
+		if err := suppressErrorOn404(resp, nil); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId("")
+		return nil
+	}
+	wrapped := withOperationContext("okta_group", withRemovalLogging("okta_group", read))
+	if diags := wrapped(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	out := buf.String()
+	for _, s := range []string{
+		"suppressed 404 Not Found response",
+		"resource=okta_group",
+		"path=/api/v1/groups/00g1",
+		"id=00g1",
+		"removing it from the state",
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("expected log output to contain %q, got: %s", s, out)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Okta API scope families required by the resources and data sources when the provider uses OAuth 2.0 authentication.
+// The '.manage' scope is required to change an object, and either '.read' or '.manage' is required to read it.
+// Resources and data sources that do not call the management API map to an empty family.
+var oauthScopeFamilies = map[string]string{
+	accountRecovery:                    "okta.policies",
+	adminRoleCustom:                    "okta.roles",
+	adminRoleCustomAssignments:         "okta.roles",
+	adminRoleTargets:                   "okta.roles",
+	apiTokenNetwork:                    "okta.apiTokens",
+	appAutoLogin:                       "okta.apps",
+	appBookmark:                        "okta.apps",
+	appBasicAuth:                       "okta.apps",
+	appGroupAssignment:                 "okta.apps",
+	appGroupAssignments:                "okta.apps",
+	appLogo:                            "okta.apps",
+	appUser:                            "okta.apps",
+	appOAuth:                           "okta.apps",
+	appOAuthAPIScope:                   "okta.apps",
+	appOAuthRedirectURI:                "okta.apps",
+	appOAuthSecret:                     "okta.apps",
+	appOrg2Org:                         "okta.apps",
+	appSaml:                            "okta.apps",
+	appSamlCertificate:                 "okta.apps",
+	appSignOnPolicy:                    "okta.policies",
+	appSignOnPolicyRule:                "okta.policies",
+	appSecurePasswordStore:             "okta.apps",
+	appSwa:                             "okta.apps",
+	appThreeField:                      "okta.apps",
+	appUserSchema:                      "okta.schemas",
+	appUserBaseSchema:                  "okta.schemas",
+	appWsFederation:                    "okta.apps",
+	authServer:                         "okta.authorizationServers",
+	authServerDefault:                  "okta.authorizationServers",
+	authServerClaim:                    "okta.authorizationServers",
+	authServerClaimDefault:             "okta.authorizationServers",
+	authServerPolicy:                   "okta.authorizationServers",
+	authServerPolicyRule:               "okta.authorizationServers",
+	authServerScope:                    "okta.authorizationServers",
+	authServers:                        "okta.authorizationServers",
+	captcha:                            "okta.captchas",
+	captchaOrgWideSettings:             "okta.captchas",
+	emailDomain:                        "okta.emailDomains",
+	emailDomainVerification:            "okta.emailDomains",
+	emailSender:                        "okta.brands",
+	emailSenderVerification:            "okta.brands",
+	eventHook:                          "okta.eventHooks",
+	factor:                             "okta.factors",
+	groupRole:                          "okta.roles",
+	groupRoles:                         "okta.roles",
+	groupRule:                          "okta.groups",
+	groupRulesStatus:                   "okta.groups",
+	idpOidc:                            "okta.idps",
+	idpSaml:                            "okta.idps",
+	oktaIdps:                           "okta.idps",
+	idpSamlKey:                         "okta.idps",
+	idpSocial:                          "okta.idps",
+	inlineHook:                         "okta.inlineHooks",
+	logStream:                          "okta.logStreams",
+	networkZone:                        "okta.networkZones",
+	networkZones:                       "okta.networkZones",
+	oktaApps:                           "okta.apps",
+	oktaBrand:                          "okta.brands",
+	oktaDomain:                         "okta.domains",
+	domainCertificate:                  "okta.domains",
+	oktaGroup:                          "okta.groups",
+	oktaGroups:                         "okta.groups",
+	oktaGroupMembership:                "okta.groups",
+	oktaGroupMemberships:               "okta.groups",
+	oktaLog:                            "okta.logs",
+	oktaPermissions:                    "okta.roles",
+	oktaPolicies:                       "okta.policies",
+	oktaProfileMapping:                 "okta.profileMappings",
+	oktaRoles:                          "okta.roles",
+	oktaTheme:                          "okta.brands",
+	oktaUser:                           "okta.users",
+	policyJSON:                         "okta.policies",
+	policyMfa:                          "okta.policies",
+	policyMfaDefault:                   "okta.policies",
+	policyPassword:                     "okta.policies",
+	policyPasswordDefault:              "okta.policies",
+	policyProfileEnrollmentApps:        "okta.policies",
+	policyRuleIdpDiscovery:             "okta.policies",
+	idpDiscoveryRuleDefault:            "okta.policies",
+	policyRuleMfa:                      "okta.policies",
+	policyRulePassword:                 "okta.policies",
+	policyRuleSignOn:                   "okta.policies",
+	policySignOn:                       "okta.policies",
+	resourceSet:                        "okta.roles",
+	subscription:                       "okta.users",
+	templateEmail:                      "okta.templates",
+	templateSms:                        "okta.templates",
+	trustedOrigin:                      "okta.trustedOrigins",
+	userBaseSchema:                     "okta.schemas",
+	userLifecycleBatch:                 "okta.users",
+	userSchema:                         "okta.schemas",
+	userSecurityQuestions:              "okta.users",
+	userType:                           "okta.userTypes",
+	unmanagedUsers:                     "okta.users",
+	x509Certificate:                    "",
+	"okta_app":                         "okta.apps",
+	"okta_app_metadata_saml":           "okta.apps",
+	"okta_auth_server_metadata":        "",
+	"okta_auth_server_scopes":          "okta.authorizationServers",
+	"okta_default_policies":            "okta.policies",
+	"okta_default_policy":              "okta.policies",
+	"okta_everyone_group":              "okta.groups",
+	"okta_idp_metadata_saml":           "okta.idps",
+	"okta_policy":                      "okta.policies",
+	"okta_user_profile_mapping_source": "okta.profileMappings",
+	"okta_users":                       "okta.users",
+
+	// deprecated names
+	"okta_auto_login_app":            "okta.apps",
+	"okta_bookmark_app":              "okta.apps",
+	"okta_idp":                       "okta.idps",
+	"okta_mfa_policy":                "okta.policies",
+	"okta_mfa_policy_rule":           "okta.policies",
+	"okta_oauth_app":                 "okta.apps",
+	"okta_oauth_app_redirect_uri":    "okta.apps",
+	"okta_password_policy":           "okta.policies",
+	"okta_password_policy_rule":      "okta.policies",
+	"okta_saml_app":                  "okta.apps",
+	"okta_saml_idp":                  "okta.idps",
+	"okta_saml_idp_signing_key":      "okta.idps",
+	"okta_secure_password_store_app": "okta.apps",
+	"okta_signon_policy":             "okta.policies",
+	"okta_signon_policy_rule":        "okta.policies",
+	"okta_social_idp":                "okta.idps",
+	"okta_swa_app":                   "okta.apps",
+	"okta_three_field_app":           "okta.apps",
+}
+
+// resolveScopeAccess resolves the scopes granted to the provider into the scope families it can access, the value is
+// true when the family can be managed. It's done once, when the provider is configured, and it warns about the scopes
+// that are not used by any resource, since they are likely misspelled.
+func resolveScopeAccess(scopes []string) (map[string]bool, diag.Diagnostics) {
+	families := make(map[string]bool)
+	for _, family := range oauthScopeFamilies {
+		if family != "" {
+			families[family] = true
+		}
+	}
+	access := make(map[string]bool)
+	var diags diag.Diagnostics
+	for _, scope := range scopes {
+		switch {
+		case strings.HasSuffix(scope, ".manage") && families[strings.TrimSuffix(scope, ".manage")]:
+			access[strings.TrimSuffix(scope, ".manage")] = true
+		case strings.HasSuffix(scope, ".read") && families[strings.TrimSuffix(scope, ".read")]:
+			family := strings.TrimSuffix(scope, ".read")
+			if _, ok := access[family]; !ok {
+				access[family] = false
+			}
+		default:
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("OAuth 2.0 scope '%s' is not used by any resource or data source", scope),
+			})
+		}
+	}
+	return access, diags
+}
+
+// validateScopes ensures that the scopes granted to the provider are sufficient to work with the given resource.
+// The scopes are resolved when the provider is configured, so it's only a lookup. This check is skipped when the
+// provider uses an API token.
+func (c *Config) validateScopes(name string, readOnly bool) error {
+	if c.scopeAccess == nil {
+		return nil
+	}
+	family := oauthScopeFamilies[name]
+	if family == "" {
+		return nil
+	}
+	manage, granted := c.scopeAccess[family]
+	if manage || (granted && readOnly) {
+		return nil
+	}
+	if readOnly {
+		return fmt.Errorf("'%s' requires either '%s.read' or '%s.manage' scope, but the provider was configured with the following scopes: %v", name, family, family, c.scopes)
+	}
+	return fmt.Errorf("'%s' requires '%s.manage' scope, but the provider was configured with the following scopes: %v", name, family, c.scopes)
+}
+
+type contextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+func withScopeValidation(name string, readOnly bool, f contextFunc) contextFunc {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if c, ok := m.(*Config); ok {
+			if err := c.validateScopes(name, readOnly); err != nil {
+				return diag.Errorf("insufficient OAuth 2.0 scopes: %v", err)
+			}
+		}
+		return f(ctx, d, m)
+	}
+}
+
+// addScopeValidation makes every resource and data source fail fast with a clear message when the provider
+// uses OAuth 2.0 authentication and the granted scopes do not cover it.
+func addScopeValidation(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		r.CreateContext = withScopeValidation(name, false, r.CreateContext)
+		r.ReadContext = withScopeValidation(name, true, r.ReadContext)
+		r.UpdateContext = withScopeValidation(name, false, r.UpdateContext)
+		r.DeleteContext = withScopeValidation(name, false, r.DeleteContext)
+	}
+	for name, r := range p.DataSourcesMap {
+		r.ReadContext = withScopeValidation(name, true, r.ReadContext)
+	}
+}
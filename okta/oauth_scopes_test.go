@@ -0,0 +1,51 @@
+package okta
+
+import (
+	"testing"
+)
+
+func TestOAuthScopeFamilies(t *testing.T) {
+	p := Provider()
+	for name := range p.ResourcesMap {
+		if _, ok := oauthScopeFamilies[name]; !ok {
+			t.Errorf("resource '%s' has no OAuth 2.0 scope family", name)
+		}
+	}
+	for name := range p.DataSourcesMap {
+		if _, ok := oauthScopeFamilies[name]; !ok {
+			t.Errorf("data source '%s' has no OAuth 2.0 scope family", name)
+		}
+	}
+}
+
+func TestValidateScopes(t *testing.T) {
+	access, diags := resolveScopeAccess([]string{"okta.groups.manage", "okta.users.read", "okta.user.manage"})
+	if len(diags) != 1 || diags.HasError() {
+		t.Fatalf("expected a warning about the unknown scope, got %v", diags)
+	}
+	c := &Config{scopes: []string{"okta.groups.manage", "okta.users.read", "okta.user.manage"}, scopeAccess: access}
+	cases := []struct {
+		name     string
+		readOnly bool
+		valid    bool
+	}{
+		{oktaGroup, false, true},
+		{oktaGroup, true, true},
+		{oktaUser, true, true},
+		{oktaUser, false, false},
+		{appOAuth, true, false},
+		{x509Certificate, true, true},
+	}
+	for _, tc := range cases {
+		err := c.validateScopes(tc.name, tc.readOnly)
+		if tc.valid && err != nil {
+			t.Errorf("expected '%s' (read only: %t) to be allowed, got %v", tc.name, tc.readOnly, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("expected '%s' (read only: %t) to be rejected", tc.name, tc.readOnly)
+		}
+	}
+	if err := (&Config{apiToken: "token"}).validateScopes(appOAuth, false); err != nil {
+		t.Errorf("expected scopes not to be validated with an API token, got %v", err)
+	}
+}
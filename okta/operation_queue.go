@@ -0,0 +1,172 @@
+package okta
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The objects of some types must have unique attributes, e.g. the names of network zones. When the object is replaced
+// by another resource with the same name, e.g. after the resource has been renamed in the configuration, Terraform
+// runs the create of the new object and the delete of the old one concurrently, so the create fails if it goes first.
+// The operation queue gives the deletes of such objects the priority over the creates of the objects with the same
+// unique attributes.
+
+// uniqueAttributeResources maps the resources, which objects must have unique attributes, to these attributes.
+var uniqueAttributeResources = map[string][]string{
+	networkZone:   {"name"},
+	trustedOrigin: {"name", "origin"},
+}
+
+// operationQueue tracks the pending and completed deletes of the objects with unique attributes during the provider
+// run.
+type operationQueue struct {
+	mu        sync.Mutex
+	deletes   map[string]chan struct{}
+	completed map[string]time.Time
+}
+
+func newOperationQueue() *operationQueue {
+	return &operationQueue{
+		deletes:   make(map[string]chan struct{}),
+		completed: make(map[string]time.Time),
+	}
+}
+
+func uniqueAttributeKeys(resource string, d *schema.ResourceData) []string {
+	var keys []string
+	for _, attr := range uniqueAttributeResources[resource] {
+		if v, ok := d.Get(attr).(string); ok && v != "" {
+			keys = append(keys, resource+"/"+attr+"/"+strings.ToLower(v))
+		}
+	}
+	return keys
+}
+
+// startDelete marks the deletes of the objects with the keys as pending, the returned function marks them as done.
+func (q *operationQueue) startDelete(keys []string) func() {
+	done := make(chan struct{})
+	q.mu.Lock()
+	for _, key := range keys {
+		q.deletes[key] = done
+	}
+	q.mu.Unlock()
+	return func() {
+		q.mu.Lock()
+		now := time.Now()
+		for _, key := range keys {
+			if q.deletes[key] == done {
+				delete(q.deletes, key)
+			}
+			q.completed[key] = now
+		}
+		q.mu.Unlock()
+		close(done)
+	}
+}
+
+// pendingDelete returns the channel, which is closed when the pending delete of the object with any of the keys is
+// done, and whether such delete has been completed since the time.
+func (q *operationQueue) pendingDelete(keys []string, since time.Time) (chan struct{}, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, key := range keys {
+		if done, ok := q.deletes[key]; ok {
+			return done, false
+		}
+	}
+	for _, key := range keys {
+		if completed, ok := q.completed[key]; ok && !completed.Before(since) {
+			return nil, true
+		}
+	}
+	return nil, false
+}
+
+// waitForDelete waits for the pending delete of the object with any of the keys to finish. It reports whether there
+// was such delete, either pending or completed since the time.
+func (q *operationQueue) waitForDelete(ctx context.Context, keys []string, since time.Time) bool {
+	done, completed := q.pendingDelete(keys, since)
+	if completed {
+		return true
+	}
+	if done == nil {
+		return false
+	}
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// isUniqueAttributeConflict reports whether Okta rejected the object, because another one has the same unique
+// attribute.
+func isUniqueAttributeConflict(diags diag.Diagnostics) bool {
+	for _, d := range diags {
+		if d.Severity == diag.Error && strings.Contains(strings.ToLower(d.Summary+" "+d.Detail), "already exists") {
+			return true
+		}
+	}
+	return false
+}
+
+func withDeletePriority(resource string, f contextFunc) contextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c, ok := m.(*Config)
+		if !ok || c.operations == nil {
+			return f(ctx, d, m)
+		}
+		keys := uniqueAttributeKeys(resource, d)
+		// the pending delete of the object with the same unique attributes goes first
+		c.operations.waitForDelete(ctx, keys, time.Now())
+		start := time.Now()
+		diags := f(ctx, d, m)
+		if d.Id() != "" || !isUniqueAttributeConflict(diags) {
+			return diags
+		}
+		// the create is retried only if the conflicting object is being deleted by this provider run, otherwise the
+		// conflict is with the object, which isn't going away
+		if !c.operations.waitForDelete(ctx, keys, start) {
+			for i := range diags {
+				if diags[i].Severity == diag.Error && diags[i].Detail == "" {
+					diags[i].Detail = "Another object has the same unique attributes. If it's replaced with 'create_before_destroy', " +
+						"it's deleted only after this object is created, so it has to be removed or renamed first."
+				}
+			}
+			return diags
+		}
+		logger(m).Info("retrying create after the object with the same unique attributes has been deleted", "resource", resource)
+		return f(ctx, d, m)
+	}
+}
+
+func withDeleteTracking(resource string, f contextFunc) contextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c, ok := m.(*Config)
+		if !ok || c.operations == nil {
+			return f(ctx, d, m)
+		}
+		done := c.operations.startDelete(uniqueAttributeKeys(resource, d))
+		defer done()
+		return f(ctx, d, m)
+	}
+}
+
+// addDeletePriority makes the creates of the objects with unique attributes wait for the concurrent deletes of the
+// objects with the same attributes, so the object can be replaced by another resource within the same apply.
+func addDeletePriority(p *schema.Provider) {
+	for name := range uniqueAttributeResources {
+		r, ok := p.ResourcesMap[name]
+		if !ok {
+			continue
+		}
+		r.CreateContext = withDeletePriority(name, r.CreateContext)
+		r.DeleteContext = withDeleteTracking(name, r.DeleteContext)
+	}
+}
@@ -0,0 +1,121 @@
+package okta
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestDeletePriority verifies that the create, which is rejected because of the name conflict, is retried after the
+// concurrent delete of the object with the same name.
+func TestDeletePriority(t *testing.T) {
+	c := &Config{operations: newOperationQueue(), logger: hclog.NewNullLogger()}
+	var (
+		creates int
+		deleted = make(chan struct{})
+		started = make(chan struct{})
+		release = make(chan struct{})
+	)
+	del := withDeleteTracking(networkZone, func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		close(started)
+		<-release
+		close(deleted)
+		return nil
+	})
+	oldZone := schema.TestResourceDataRaw(t, resourceNetworkZone().Schema, map[string]interface{}{"name": "zone", "type": "IP"})
+	oldZone.SetId("nzo1")
+	delErrs := make(chan diag.Diagnostics, 1)
+	create := withDeletePriority(networkZone, func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		creates++
+		if creates == 1 {
+			// the delete starts after the first create attempt has been sent
+			go func() { delErrs <- del(ctx, oldZone, m) }()
+			<-started
+			close(release)
+			return diag.Errorf("failed to create network zone: The API returned an error: Api validation failed: name. Causes: errorSummary: name: An object with this field already exists")
+		}
+		select {
+		case <-deleted:
+		default:
+			t.Error("expected the create to be retried after the delete")
+		}
+		d.SetId("nzo2")
+		return nil
+	})
+	newZone := schema.TestResourceDataRaw(t, resourceNetworkZone().Schema, map[string]interface{}{"name": "Zone", "type": "IP"})
+	if diags := create(context.Background(), newZone, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if diags := <-delErrs; diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if newZone.Id() != "nzo2" || creates != 2 {
+		t.Fatalf("expected the create to succeed on the second attempt, ID: '%s', attempts: %d", newZone.Id(), creates)
+	}
+}
+
+// TestDeletePriorityWaitsForPendingDelete verifies that the create waits for the pending delete of the object with the
+// same name before it's sent.
+func TestDeletePriorityWaitsForPendingDelete(t *testing.T) {
+	c := &Config{operations: newOperationQueue(), logger: hclog.NewNullLogger()}
+	var (
+		deleted = make(chan struct{})
+		started = make(chan struct{})
+		release = make(chan struct{})
+	)
+	del := withDeleteTracking(trustedOrigin, func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		close(started)
+		<-release
+		close(deleted)
+		return nil
+	})
+	create := withDeletePriority(trustedOrigin, func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		select {
+		case <-deleted:
+		default:
+			t.Error("expected the create to be sent after the delete")
+		}
+		d.SetId("tos2")
+		return nil
+	})
+	oldOrigin := schema.TestResourceDataRaw(t, resourceTrustedOrigin().Schema, map[string]interface{}{"name": "old", "origin": "https://example.com"})
+	oldOrigin.SetId("tos1")
+	newOrigin := schema.TestResourceDataRaw(t, resourceTrustedOrigin().Schema, map[string]interface{}{"name": "new", "origin": "https://example.com"})
+	delErrs := make(chan diag.Diagnostics, 1)
+	go func() { delErrs <- del(context.Background(), oldOrigin, c) }()
+	<-started
+	createErrs := make(chan diag.Diagnostics, 1)
+	go func() { createErrs <- create(context.Background(), newOrigin, c) }()
+	close(release)
+	if diags := <-createErrs; diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if diags := <-delErrs; diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if newOrigin.Id() != "tos2" {
+		t.Fatalf("expected the object to be created, got ID: '%s'", newOrigin.Id())
+	}
+}
+
+// TestDeletePriorityWithoutDelete verifies that the conflict is returned right away, when the object with the same
+// name isn't being deleted.
+func TestDeletePriorityWithoutDelete(t *testing.T) {
+	c := &Config{operations: newOperationQueue(), logger: hclog.NewNullLogger()}
+	var creates int
+	create := withDeletePriority(trustedOrigin, func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		creates++
+		return diag.Errorf("failed to create trusted origin: An object with this field already exists")
+	})
+	d := schema.TestResourceDataRaw(t, resourceTrustedOrigin().Schema, map[string]interface{}{"name": "origin", "origin": "https://example.com"})
+	diags := create(context.Background(), d, c)
+	if !diags.HasError() || diags[0].Detail == "" {
+		t.Fatalf("expected the conflict error with the detail, got: %v", diags)
+	}
+	if creates != 1 {
+		t.Fatalf("expected the create not to be retried, got %d attempts", creates)
+	}
+}
@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,13 +31,7 @@ var (
 			// Suppress diff if config is empty.
 			DiffSuppressFunc: createValueDiffSuppression("0"),
 		},
-		"status": {
-			Type:             schema.TypeString,
-			Optional:         true,
-			Default:          statusActive,
-			ValidateDiagFunc: stringInSlice([]string{statusActive, statusInactive}),
-			Description:      "Policy Status: ACTIVE or INACTIVE.",
-		},
+		"status": buildStatusSchema("Policy Status: ACTIVE or INACTIVE."),
 		"groups_included": {
 			Type:        schema.TypeSet,
 			Optional:    true,
@@ -45,6 +40,7 @@ var (
 				Type: schema.TypeString,
 			},
 		},
+		"export_json": exportJSONSchema,
 	}
 
 	defaultPolicySchema = map[string]*schema.Schema{
@@ -63,23 +59,19 @@ var (
 			Computed:    true,
 			Description: "Default policy priority",
 		},
-		"status": {
-			Type:        schema.TypeString,
-			Computed:    true,
-			Description: "Default policy status",
-		},
+		"status": buildComputedStatusSchema("Default policy status"),
 		"default_included_group_id": {
 			Type:        schema.TypeString,
 			Computed:    true,
 			Description: "Default group ID (always included)",
 		},
+		"export_json": exportJSONSchema,
 	}
 
-	statusSchema = &schema.Schema{
-		Type:             schema.TypeString,
-		Optional:         true,
-		Default:          statusActive,
-		ValidateDiagFunc: stringInSlice([]string{statusActive, statusInactive}),
+	exportJSONSchema = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Full representation of the policy returned by Okta API in JSON format",
 	}
 )
 
@@ -107,8 +99,34 @@ func findPolicy(ctx context.Context, m interface{}, name, policyType string) (*o
 	return nil, fmt.Errorf("no policies retrieved for policy type '%s' and name '%s'", policyType, name)
 }
 
+// findSystemPolicy returns the built-in default policy of the type, which is marked as 'system' by Okta. The policy is
+// looked up by the name in the orgs, which don't mark it.
+func findSystemPolicy(ctx context.Context, m interface{}, policyType string) (*okta.Policy, error) {
+	policies, resp, err := getOktaClientFromMetadata(m).Policy.ListPolicies(ctx, &query.Params{Type: policyType})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %v", err)
+	}
+	for {
+		for _, policy := range policies {
+			if policy.System != nil && *policy.System {
+				return policy, nil
+			}
+		}
+		if !resp.HasNextPage() {
+			break
+		}
+		policies = nil
+		resp, err = resp.Next(ctx, &policies)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list policies: %v", err)
+		}
+	}
+	return findPolicy(ctx, m, "Default Policy", policyType)
+}
+
+// setDefaultPolicy adopts the built-in default policy of the type, it is never created or removed by the resources.
 func setDefaultPolicy(ctx context.Context, d *schema.ResourceData, m interface{}, policyType string) (*okta.Policy, error) {
-	policy, err := findPolicy(ctx, m, "Default Policy", policyType)
+	policy, err := findSystemPolicy(ctx, m, policyType)
 	if err != nil {
 		return nil, err
 	}
@@ -129,6 +147,15 @@ func setDefaultPolicy(ctx context.Context, d *schema.ResourceData, m interface{}
 	return policy, nil
 }
 
+// syncDefaultPolicy sets the attributes of the default policy, which are managed by Okta. The priority is never sent
+// back, since the default policy is always evaluated last.
+func syncDefaultPolicy(d *schema.ResourceData, policy *sdk.Policy) {
+	_ = d.Set("name", policy.Name)
+	_ = d.Set("description", policy.Description)
+	_ = d.Set("status", policy.Status)
+	_ = d.Set("priority", policy.Priority)
+}
+
 func getPeopleConditions(d *schema.ResourceData) *okta.GroupRulePeopleCondition {
 	return &okta.GroupRulePeopleCondition{
 		Groups: &okta.GroupRuleGroupCondition{
@@ -185,38 +212,47 @@ func getGroups(d *schema.ResourceData) *okta.PolicyPeopleCondition {
 	return people
 }
 
-// Grabs policy from upstream, if the resource does not exist the returned policy will be nil which is not considered an error
+// Grabs policy from upstream, if the resource does not exist the returned policy will be nil which is not considered an error.
+// The policy is fetched as generic map, so its full representation is kept in 'export_json' of the resources having it.
 func getPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) (*sdk.Policy, error) {
 	logger(m).Info("getting policy", "id", d.Id())
-	policy, resp, err := getSupplementFromMetadata(m).GetPolicy(ctx, d.Id())
+	raw, resp, err := getSupplementFromMetadata(m).GetObject(ctx, fmt.Sprintf("/api/v1/policies/%s", d.Id()))
 	if err := suppressErrorOn404(resp, err); err != nil {
 		return nil, err
 	}
-	if policy == nil {
+	if raw == nil {
 		d.SetId("")
 		return nil, nil
 	}
-	return policy, err
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal policy: %v", err)
+	}
+	var policy sdk.Policy
+	if err = json.Unmarshal(b, &policy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal policy: %v", err)
+	}
+	_ = d.Set("export_json", string(b))
+	return &policy, nil
 }
 
 // activate or deactivate a policy according to the terraform schema status field
 func policyActivate(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	logger(m).Info("changing policy's status", "id", d.Id(), "status", d.Get("status").(string))
 	client := getOktaClientFromMetadata(m)
-
-	if d.Get("status").(string) == statusActive {
+	return changeStatus("", d.Get("status").(string), func() error {
 		_, err := client.Policy.ActivatePolicy(ctx, d.Id())
 		if err != nil {
 			return fmt.Errorf("activation has failed: %v", err)
 		}
-	}
-	if d.Get("status").(string) == statusInactive {
+		return nil
+	}, func() error {
 		_, err := client.Policy.DeactivatePolicy(ctx, d.Id())
 		if err != nil {
 			return fmt.Errorf("deactivation has failed: %v", err)
 		}
-	}
-	return nil
+		return nil
+	})
 }
 
 func updatePolicy(ctx context.Context, d *schema.ResourceData, m interface{}, template sdk.Policy) error {
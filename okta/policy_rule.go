@@ -30,7 +30,6 @@ var baseRuleSchema = map[string]*schema.Schema{
 	},
 	"name": {
 		Type:        schema.TypeString,
-		ForceNew:    true,
 		Required:    true,
 		Description: "Policy Rule Name",
 	},
@@ -41,13 +40,7 @@ var baseRuleSchema = map[string]*schema.Schema{
 		// Suppress diff if config is empty.
 		DiffSuppressFunc: createValueDiffSuppression("0"),
 	},
-	"status": {
-		Type:             schema.TypeString,
-		Optional:         true,
-		Default:          statusActive,
-		ValidateDiagFunc: stringInSlice([]string{statusActive, statusInactive}),
-		Description:      "Policy Rule Status: ACTIVE or INACTIVE.",
-	},
+	"status": buildStatusSchema("Policy Rule Status: ACTIVE or INACTIVE."),
 	"network_connection": {
 		Type:             schema.TypeString,
 		Optional:         true,
@@ -207,19 +200,19 @@ func updateRule(ctx context.Context, d *schema.ResourceData, m interface{}, temp
 // activate or deactivate a policy rule according to the terraform schema status field
 func policyRuleActivate(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	client := getOktaClientFromMetadata(m).Policy
-	if d.Get("status").(string) == statusActive {
+	return changeStatus("", d.Get("status").(string), func() error {
 		_, err := client.ActivatePolicyRule(ctx, d.Get("policyid").(string), d.Id())
 		if err != nil {
 			return fmt.Errorf("activation has failed: %v", err)
 		}
-	}
-	if d.Get("status").(string) == statusInactive {
+		return nil
+	}, func() error {
 		_, err := client.DeactivatePolicyRule(ctx, d.Get("policyid").(string), d.Id())
 		if err != nil {
 			return fmt.Errorf("deactivation has failed: %v", err)
 		}
-	}
-	return nil
+		return nil
+	})
 }
 
 func deleteRule(ctx context.Context, d *schema.ResourceData, m interface{}, checkIsSystemPolicy bool) error {
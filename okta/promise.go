@@ -1,6 +1,7 @@
 package okta
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -9,38 +10,64 @@ import (
 // Basic result struct that can be expanded to support output
 type result struct {
 	err error
+	// skipped is set, when the job was not started, because the context was done
+	skipped bool
 }
 
-// Dead simple Promise all that will only work in very basic circumstances.
-// We could make the function return a generic result object as well.
-func promiseAll(limit int, wg *sync.WaitGroup, resp chan []*result, funcs ...func() error) {
-	jobIndex := 0
+// Dead simple Promise all that will only work in very basic circumstances. At most 'limit' jobs run at the same time.
+// Once the context is done, the jobs which are not started yet are skipped, while the running ones are expected to
+// return on their own, since their API calls share the same context. The results are in the order of the jobs.
+func promiseAll(ctx context.Context, limit int, funcs ...func() error) []*result {
 	resultList := make([]*result, len(funcs))
-
-	for jobIndex < len(funcs) {
-		for i := 0; i < limit; i++ {
-			wg.Add(1)
-			go func(index int, cb func() error) {
-				defer wg.Done()
-				err := cb()
-				resultList[index] = &result{err}
-			}(jobIndex, funcs[jobIndex])
-			jobIndex++
+	if limit < 1 {
+		limit = 1
+	}
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i := range funcs {
+		if ctx.Err() == nil {
+			select {
+			case sem <- struct{}{}:
+				wg.Add(1)
+				go func(index int, cb func() error) {
+					defer func() {
+						<-sem
+						wg.Done()
+					}()
+					resultList[index] = &result{err: cb()}
+				}(i, funcs[i])
+				continue
+			case <-ctx.Done():
+			}
 		}
-		wg.Wait()
+		resultList[i] = &result{err: ctx.Err(), skipped: true}
 	}
-
-	resp <- resultList
+	wg.Wait()
+	return resultList
 }
 
+// getPromiseError combines the errors of the failed jobs. The skipped jobs are reported as a single error, so the
+// actual failures are not lost among the cancellations.
 func getPromiseError(resultList []*result, message string) error {
-	var errList []string
+	var (
+		errList    []string
+		skipped    int
+		skippedErr error
+	)
 
 	for _, r := range resultList {
+		if r.skipped {
+			skipped++
+			skippedErr = r.err
+			continue
+		}
 		if r.err != nil {
 			errList = append(errList, r.err.Error())
 		}
 	}
+	if skipped > 0 {
+		errList = append(errList, fmt.Sprintf("%d of %d operations were not started: %v", skipped, len(resultList), skippedErr))
+	}
 
 	if len(errList) > 0 {
 		return fmt.Errorf("%s. Errors: %s", message, strings.Join(errList, ", "))
@@ -0,0 +1,81 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPromiseAll(t *testing.T) {
+	var running, maxRunning int32
+	funcs := make([]func() error, 10)
+	for i := range funcs {
+		index := i
+		funcs[i] = func() error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			if index == 3 {
+				return errors.New("failed to assign user '3'")
+			}
+			return nil
+		}
+	}
+	results := promiseAll(context.Background(), 3, funcs...)
+	if maxRunning > 3 {
+		t.Errorf("expected at most 3 jobs to run at the same time, got %d", maxRunning)
+	}
+	for i, r := range results {
+		if r.skipped || (r.err != nil) != (i == 3) {
+			t.Errorf("unexpected result of job %d: %+v", i, r)
+		}
+	}
+	err := getPromiseError(results, "failed to associate users")
+	if err == nil || err.Error() != "failed to associate users. Errors: failed to assign user '3'" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPromiseAll_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	funcs := make([]func() error, 5)
+	for i := range funcs {
+		funcs[i] = func() error {
+			cancel()
+			<-ctx.Done()
+			return ctx.Err()
+		}
+	}
+	done := make(chan []*result)
+	go func() {
+		done <- promiseAll(ctx, 1, funcs...)
+	}()
+	var results []*result
+	select {
+	case results = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected canceled jobs not to be started")
+	}
+	if results[0].skipped || !errors.Is(results[0].err, context.Canceled) {
+		t.Errorf("expected the first job to be canceled while running, got %+v", results[0])
+	}
+	for _, r := range results[1:] {
+		if !r.skipped {
+			t.Errorf("expected the job to be skipped, got %+v", r)
+		}
+	}
+	err := getPromiseError(results, "failed to update group memberships")
+	if err == nil || !strings.Contains(err.Error(), "4 of 5 operations were not started: context canceled") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -15,60 +15,101 @@ import (
 
 // Resource names, defined in place, used throughout the provider and tests
 const (
-	adminRoleTargets       = "okta_admin_role_targets"
-	appAutoLogin           = "okta_app_auto_login"
-	appBookmark            = "okta_app_bookmark"
-	appBasicAuth           = "okta_app_basic_auth"
-	appGroupAssignment     = "okta_app_group_assignment"
-	appGroupAssignments    = "okta_app_group_assignments"
-	appUser                = "okta_app_user"
-	appOAuth               = "okta_app_oauth"
-	appOAuthAPIScope       = "okta_app_oauth_api_scope"
-	appOAuthRedirectURI    = "okta_app_oauth_redirect_uri"
-	appSaml                = "okta_app_saml"
-	appSecurePasswordStore = "okta_app_secure_password_store"
-	appSwa                 = "okta_app_swa"
-	appThreeField          = "okta_app_three_field"
-	appUserSchema          = "okta_app_user_schema"
-	appUserBaseSchema      = "okta_app_user_base_schema"
-	authServer             = "okta_auth_server"
-	authServerDefault      = "okta_auth_server_default"
-	authServerClaim        = "okta_auth_server_claim"
-	authServerClaimDefault = "okta_auth_server_claim_default"
-	authServerPolicy       = "okta_auth_server_policy"
-	authServerPolicyRule   = "okta_auth_server_policy_rule"
-	authServerScope        = "okta_auth_server_scope"
-	eventHook              = "okta_event_hook"
-	factor                 = "okta_factor"
-	groupRole              = "okta_group_role"
-	groupRoles             = "okta_group_roles"
-	groupRule              = "okta_group_rule"
-	idpOidc                = "okta_idp_oidc"
-	idpSaml                = "okta_idp_saml"
-	idpSamlKey             = "okta_idp_saml_key"
-	idpSocial              = "okta_idp_social"
-	inlineHook             = "okta_inline_hook"
-	networkZone            = "okta_network_zone"
-	oktaGroup              = "okta_group"
-	oktaGroups             = "okta_groups"
-	oktaGroupMembership    = "okta_group_membership"
-	oktaProfileMapping     = "okta_profile_mapping"
-	oktaUser               = "okta_user"
-	policyMfa              = "okta_policy_mfa"
-	policyMfaDefault       = "okta_policy_mfa_default"
-	policyPassword         = "okta_policy_password"
-	policyPasswordDefault  = "okta_policy_password_default"
-	policyRuleIdpDiscovery = "okta_policy_rule_idp_discovery"
-	policyRuleMfa          = "okta_policy_rule_mfa"
-	policyRulePassword     = "okta_policy_rule_password"
-	policyRuleSignOn       = "okta_policy_rule_signon"
-	policySignOn           = "okta_policy_signon"
-	templateEmail          = "okta_template_email"
-	templateSms            = "okta_template_sms"
-	trustedOrigin          = "okta_trusted_origin"
-	userBaseSchema         = "okta_user_base_schema"
-	userSchema             = "okta_user_schema"
-	userType               = "okta_user_type"
+	accountRecovery             = "okta_account_recovery"
+	adminRoleCustom             = "okta_admin_role_custom"
+	adminRoleCustomAssignments  = "okta_admin_role_custom_assignments"
+	adminRoleTargets            = "okta_admin_role_targets"
+	apiTokenNetwork             = "okta_api_token_network"
+	appAutoLogin                = "okta_app_auto_login"
+	appBookmark                 = "okta_app_bookmark"
+	appBasicAuth                = "okta_app_basic_auth"
+	appGroupAssignment          = "okta_app_group_assignment"
+	appGroupAssignments         = "okta_app_group_assignments"
+	appLogo                     = "okta_app_logo"
+	appUser                     = "okta_app_user"
+	appOAuth                    = "okta_app_oauth"
+	appOAuthAPIScope            = "okta_app_oauth_api_scope"
+	appOAuthRedirectURI         = "okta_app_oauth_redirect_uri"
+	appOAuthSecret              = "okta_app_oauth_secret"
+	appOrg2Org                  = "okta_app_org2org"
+	appSaml                     = "okta_app_saml"
+	appSamlCertificate          = "okta_app_saml_certificate"
+	appSignOnPolicy             = "okta_app_signon_policy"
+	appSignOnPolicyRule         = "okta_app_signon_policy_rule"
+	appSecurePasswordStore      = "okta_app_secure_password_store"
+	appSwa                      = "okta_app_swa"
+	appThreeField               = "okta_app_three_field"
+	appUserSchema               = "okta_app_user_schema"
+	appUserBaseSchema           = "okta_app_user_base_schema"
+	appWsFederation             = "okta_app_ws_federation"
+	authServer                  = "okta_auth_server"
+	authServerDefault           = "okta_auth_server_default"
+	authServerClaim             = "okta_auth_server_claim"
+	authServerClaimDefault      = "okta_auth_server_claim_default"
+	authServerPolicy            = "okta_auth_server_policy"
+	authServerPolicyRule        = "okta_auth_server_policy_rule"
+	authServerScope             = "okta_auth_server_scope"
+	authServers                 = "okta_auth_servers"
+	captcha                     = "okta_captcha"
+	captchaOrgWideSettings      = "okta_captcha_org_wide_settings"
+	emailDomain                 = "okta_email_domain"
+	emailDomainVerification     = "okta_email_domain_verification"
+	emailSender                 = "okta_email_sender"
+	emailSenderVerification     = "okta_email_sender_verification"
+	eventHook                   = "okta_event_hook"
+	factor                      = "okta_factor"
+	groupRole                   = "okta_group_role"
+	groupRoles                  = "okta_group_roles"
+	groupRule                   = "okta_group_rule"
+	groupRulesStatus            = "okta_group_rules_status"
+	idpOidc                     = "okta_idp_oidc"
+	idpSaml                     = "okta_idp_saml"
+	idpSamlKey                  = "okta_idp_saml_key"
+	idpSocial                   = "okta_idp_social"
+	inlineHook                  = "okta_inline_hook"
+	logStream                   = "okta_log_stream"
+	networkZone                 = "okta_network_zone"
+	networkZones                = "okta_network_zones"
+	oktaApps                    = "okta_apps"
+	oktaBrand                   = "okta_brand"
+	oktaDomain                  = "okta_domain"
+	domainCertificate           = "okta_domain_certificate"
+	x509Certificate             = "okta_x509_certificate"
+	oktaGroup                   = "okta_group"
+	oktaGroups                  = "okta_groups"
+	unmanagedUsers              = "okta_unmanaged_users"
+	oktaIdps                    = "okta_idps"
+	oktaGroupMembership         = "okta_group_membership"
+	oktaGroupMemberships        = "okta_group_memberships"
+	oktaLog                     = "okta_log"
+	oktaProfileMapping          = "okta_profile_mapping"
+	oktaPermissions             = "okta_permissions"
+	oktaPolicies                = "okta_policies"
+	oktaRoles                   = "okta_roles"
+	oktaTheme                   = "okta_theme"
+	oktaUser                    = "okta_user"
+	policyJSON                  = "okta_policy_json"
+	policyMfa                   = "okta_policy_mfa"
+	policyMfaDefault            = "okta_policy_mfa_default"
+	policyPassword              = "okta_policy_password"
+	policyPasswordDefault       = "okta_policy_password_default"
+	policyProfileEnrollmentApps = "okta_policy_profile_enrollment_apps"
+	policyRuleIdpDiscovery      = "okta_policy_rule_idp_discovery"
+	idpDiscoveryRuleDefault     = "okta_policy_rule_idp_discovery_default"
+	policyRuleMfa               = "okta_policy_rule_mfa"
+	policyRulePassword          = "okta_policy_rule_password"
+	policyRuleSignOn            = "okta_policy_rule_signon"
+	policySignOn                = "okta_policy_signon"
+	resourceSet                 = "okta_resource_set"
+	subscription                = "okta_subscription"
+	templateEmail               = "okta_template_email"
+	templateSms                 = "okta_template_sms"
+	trustedOrigin               = "okta_trusted_origin"
+	userBaseSchema              = "okta_user_base_schema"
+	userLifecycleBatch          = "okta_user_lifecycle_batch"
+	userSchema                  = "okta_user_schema"
+	userSecurityQuestions       = "okta_user_security_questions"
+	userType                    = "okta_user_type"
 )
 
 // Provider establishes a client connection to an okta site
@@ -76,7 +117,7 @@ const (
 func Provider() *schema.Provider {
 	deprecatedPolicies := dataSourceDefaultPolicies()
 	deprecatedPolicies.DeprecationMessage = "This data source will be deprecated in favor of okta_default_policy or okta_policy data sources."
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"org_name": {
 				Type:        schema.TypeString,
@@ -95,7 +136,7 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeString,
 				Optional:      true,
 				DefaultFunc:   schema.EnvDefaultFunc("OKTA_API_CLIENT_ID", nil),
-				Description:   "API Token granting privileges to Okta API.",
+				Description:   "Client ID of the OAuth 2.0 service app, which is used to obtain the access token for Okta API.",
 				ConflictsWith: []string{"api_token"},
 			},
 			"scopes": {
@@ -103,14 +144,14 @@ func Provider() *schema.Provider {
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				DefaultFunc:   envDefaultSetFunc("OKTA_API_SCOPES", nil),
-				Description:   "API Token granting privileges to Okta API.",
+				Description:   "Scopes granted to the OAuth 2.0 service app, which are requested for the access token.",
 				ConflictsWith: []string{"api_token"},
 			},
 			"private_key": {
 				Optional:      true,
 				Type:          schema.TypeString,
 				DefaultFunc:   schema.EnvDefaultFunc("OKTA_API_PRIVATE_KEY", nil),
-				Description:   "API Token granting privileges to Okta API.",
+				Description:   "Private key (or path to it) of the OAuth 2.0 service app, which is used to sign the JWT assertion.",
 				ConflictsWith: []string{"api_token"},
 			},
 			"base_url": {
@@ -122,28 +163,37 @@ func Provider() *schema.Provider {
 			"backoff": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     true,
+				DefaultFunc: schema.EnvDefaultFunc("OKTA_BACKOFF", true),
 				Description: "Use exponential back off strategy for rate limits.",
 			},
 			"min_wait_seconds": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     30,
-				Description: "minimum seconds to wait when rate limit is hit. We use exponential backoffs when backoff is enabled.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("OKTA_MIN_WAIT_SECONDS", 30),
+				ValidateDiagFunc: intAtLeast(0),
+				Description:      "minimum seconds to wait when rate limit is hit. We use exponential backoffs when backoff is enabled.",
 			},
 			"max_wait_seconds": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     300,
-				Description: "maximum seconds to wait when rate limit is hit. We use exponential backoffs when backoff is enabled.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("OKTA_MAX_WAIT_SECONDS", 300),
+				ValidateDiagFunc: intAtLeast(0),
+				Description:      "maximum seconds to wait when rate limit is hit. We use exponential backoffs when backoff is enabled.",
 			},
 			"max_retries": {
 				Type:             schema.TypeInt,
 				Optional:         true,
-				Default:          5,
-				ValidateDiagFunc: intAtMost(100), // Have to cut it off somewhere right?
+				DefaultFunc:      schema.EnvDefaultFunc("OKTA_MAX_RETRIES", 5),
+				ValidateDiagFunc: intBetween(0, 100), // Have to cut it off somewhere right?
 				Description:      "maximum number of retries to attempt before erroring out.",
 			},
+			"max_api_capacity": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("MAX_API_CAPACITY", 100),
+				ValidateDiagFunc: intBetween(1, 100),
+				Description:      "Percentage of the rate limit of every endpoint, which can be consumed by the provider. The requests are paused until the reset of the rate limit, when the capacity is consumed.",
+			},
 			"parallelism": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -160,65 +210,164 @@ func Provider() *schema.Provider {
 			"request_timeout": {
 				Type:             schema.TypeInt,
 				Optional:         true,
-				Default:          0,
+				DefaultFunc:      schema.EnvDefaultFunc("OKTA_REQUEST_TIMEOUT", 0),
 				ValidateDiagFunc: intBetween(0, 100),
 				Description:      "Timeout for single request (in seconds) which is made to Okta, the default is `0` (means no limit is set). The maximum value can be `100`.",
 			},
+			"prevent_app_recreation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail the plans that replace applications, unless 'allow_recreate' is set on the application resource.",
+			},
+			"check_app_labels": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Warn in the logs during the plan when the label of an application resource is already used by another application.",
+			},
+			"log_unknown_attributes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Log the attributes of the applications returned by the API, which are unknown to the provider, and expose them in 'unmanaged_attributes'.",
+			},
+			"certificate_expiry_warning_days": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: intAtLeast(0),
+				Description:      "Warn during the plan when the signing certificate of a SAML application or identity provider expires within the number of days. Disabled when it's 0.",
+			},
+			"api_metrics_summary": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OKTA_API_METRICS_SUMMARY", false),
+				Description: "Log the summary of the API calls made by the provider when it exits: calls by endpoint family, retries, rate limited responses and wall time.",
+			},
+			"managed_marker_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OKTA_MANAGED_MARKER_ATTRIBUTE", ""),
+				Description: "Custom user profile attribute, which is set to 'managed_marker_value' on the users managed by Terraform. The attribute must be defined in the user schema.",
+			},
+			"managed_marker_value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OKTA_MANAGED_MARKER_VALUE", "terraform"),
+				Description: "Value of the 'managed_marker_attribute', e.g. the name of the workspace.",
+			},
+			"conditional_requests": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OKTA_CONDITIONAL_REQUESTS", false),
+				Description: "Revalidate the objects, which were already read by the provider, with the conditional requests ('If-None-Match' and 'If-Modified-Since'), where Okta supports them, so the unchanged objects are not transferred again. The objects are still decoded and set in the state.",
+			},
+			"user_name_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Default username template of the applications, which don't set 'user_name_template'.",
+			},
+			"user_name_template_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice([]string{"NONE", "CUSTOM", "BUILT_IN"}),
+				Description:      "Default username template type of the applications, which don't set 'user_name_template_type'.",
+			},
+			"user_name_template_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Default username template suffix of the applications, which don't set 'user_name_template_suffix'.",
+			},
+			"resolve_secret_references": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OKTA_RESOLVE_SECRET_REFERENCES", false),
+				Description: "Resolve the AWS Secrets Manager and Vault references in the passwords of the applications and their users.",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			adminRoleTargets:       resourceAdminRoleTargets(),
-			appAutoLogin:           resourceAppAutoLogin(),
-			appBookmark:            resourceAppBookmark(),
-			appBasicAuth:           resourceAppBasicAuth(),
-			appGroupAssignment:     resourceAppGroupAssignment(),
-			appGroupAssignments:    resourceAppGroupAssignments(),
-			appUser:                resourceAppUser(),
-			appOAuth:               resourceAppOAuth(),
-			appOAuthAPIScope:       resourceAppOAuthAPIScope(),
-			appOAuthRedirectURI:    resourceAppOAuthRedirectURI(),
-			appSaml:                resourceAppSaml(),
-			appSecurePasswordStore: resourceAppSecurePasswordStore(),
-			appSwa:                 resourceAppSwa(),
-			appThreeField:          resourceAppThreeField(),
-			appUserSchema:          resourceAppUserSchema(),
-			appUserBaseSchema:      resourceAppUserBaseSchema(),
-			authServer:             resourceAuthServer(),
-			authServerDefault:      resourceAuthServerDefault(),
-			authServerClaim:        resourceAuthServerClaim(),
-			authServerClaimDefault: resourceAuthServerClaimDefault(),
-			authServerPolicy:       resourceAuthServerPolicy(),
-			authServerPolicyRule:   resourceAuthServerPolicyRule(),
-			authServerScope:        resourceAuthServerScope(),
-			eventHook:              resourceEventHook(),
-			factor:                 resourceFactor(),
-			groupRole:              resourceGroupRole(),
-			groupRoles:             resourceGroupRoles(),
-			groupRule:              resourceGroupRule(),
-			idpOidc:                resourceIdpOidc(),
-			idpSaml:                resourceIdpSaml(),
-			idpSamlKey:             resourceIdpSigningKey(),
-			idpSocial:              resourceIdpSocial(),
-			inlineHook:             resourceInlineHook(),
-			networkZone:            resourceNetworkZone(),
-			oktaGroup:              resourceGroup(),
-			oktaGroupMembership:    resourceGroupMembership(),
-			oktaProfileMapping:     resourceOktaProfileMapping(),
-			oktaUser:               resourceUser(),
-			policyMfa:              resourcePolicyMfa(),
-			policyMfaDefault:       resourcePolicyMfaDefault(),
-			policyPassword:         resourcePolicyPassword(),
-			policyPasswordDefault:  resourcePolicyPasswordDefault(),
-			policySignOn:           resourcePolicySignOn(),
-			policyRuleIdpDiscovery: resourcePolicyRuleIdpDiscovery(),
-			policyRuleMfa:          resourcePolicyMfaRule(),
-			policyRulePassword:     resourcePolicyPasswordRule(),
-			policyRuleSignOn:       resourcePolicySignonRule(),
-			templateEmail:          resourceTemplateEmail(),
-			templateSms:            resourceTemplateSms(),
-			trustedOrigin:          resourceTrustedOrigin(),
-			userSchema:             resourceUserSchema(),
-			userBaseSchema:         resourceUserBaseSchema(),
-			userType:               resourceUserType(),
+			accountRecovery:            resourceAccountRecovery(),
+			adminRoleCustom:            resourceAdminRoleCustom(),
+			adminRoleCustomAssignments: resourceAdminRoleCustomAssignments(),
+			adminRoleTargets:           resourceAdminRoleTargets(),
+			apiTokenNetwork:            resourceAPITokenNetwork(),
+			appAutoLogin:               resourceAppAutoLogin(),
+			appBookmark:                resourceAppBookmark(),
+			appBasicAuth:               resourceAppBasicAuth(),
+			appGroupAssignment:         resourceAppGroupAssignment(),
+			appGroupAssignments:        resourceAppGroupAssignments(),
+			appLogo:                    resourceAppLogo(),
+			appUser:                    resourceAppUser(),
+			appOAuth:                   resourceAppOAuth(),
+			appOAuthAPIScope:           resourceAppOAuthAPIScope(),
+			appOAuthRedirectURI:        resourceAppOAuthRedirectURI(),
+			appOAuthSecret:             resourceAppOAuthSecret(),
+			appOrg2Org:                 resourceAppOrg2Org(),
+			appSaml:                    resourceAppSaml(),
+			appSignOnPolicy:            resourceAppSignOnPolicy(),
+			appSignOnPolicyRule:        resourceAppSignOnPolicyRule(),
+			appSecurePasswordStore:     resourceAppSecurePasswordStore(),
+			appSwa:                     resourceAppSwa(),
+			appThreeField:              resourceAppThreeField(),
+			appWsFederation:            resourceAppWsFederation(),
+			appUserSchema:              resourceAppUserSchema(),
+			appUserBaseSchema:          resourceAppUserBaseSchema(),
+			authServer:                 resourceAuthServer(),
+			authServerDefault:          resourceAuthServerDefault(),
+			authServerClaim:            resourceAuthServerClaim(),
+			authServerClaimDefault:     resourceAuthServerClaimDefault(),
+			authServerPolicy:           resourceAuthServerPolicy(),
+			authServerPolicyRule:       resourceAuthServerPolicyRule(),
+			authServerScope:            resourceAuthServerScope(),
+			captcha:                    resourceCaptcha(),
+			captchaOrgWideSettings:     resourceCaptchaOrgWideSettings(),
+			emailDomain:                resourceEmailDomain(),
+			emailDomainVerification:    resourceEmailDomainVerification(),
+			emailSender:                resourceEmailSender(),
+			emailSenderVerification:    resourceEmailSenderVerification(),
+			eventHook:                  resourceEventHook(),
+			factor:                     resourceFactor(),
+			groupRole:                  resourceGroupRole(),
+			groupRoles:                 resourceGroupRoles(),
+			groupRule:                  resourceGroupRule(),
+			groupRulesStatus:           resourceGroupRulesStatus(),
+			idpOidc:                    resourceIdpOidc(),
+			idpSaml:                    resourceIdpSaml(),
+			idpSamlKey:                 resourceIdpSigningKey(),
+			idpSocial:                  resourceIdpSocial(),
+			inlineHook:                 resourceInlineHook(),
+			logStream:                  resourceLogStream(),
+			networkZone:                resourceNetworkZone(),
+			oktaBrand:                  resourceBrand(),
+			oktaDomain:                 resourceDomain(),
+			domainCertificate:          resourceDomainCertificate(),
+			oktaGroup:                  resourceGroup(),
+			oktaGroupMembership:        resourceGroupMembership(),
+			oktaGroupMemberships:       resourceGroupMemberships(),
+			oktaProfileMapping:         resourceOktaProfileMapping(),
+			oktaTheme:                  resourceTheme(),
+			oktaUser:                   resourceUser(),
+			policyJSON:                 resourcePolicyJSON(),
+			policyMfa:                  resourcePolicyMfa(),
+			policyMfaDefault:           resourcePolicyMfaDefault(),
+			policyPassword:             resourcePolicyPassword(),
+			policyPasswordDefault:      resourcePolicyPasswordDefault(),
+			policySignOn:               resourcePolicySignOn(),
+			policyRuleIdpDiscovery:     resourcePolicyRuleIdpDiscovery(),
+			idpDiscoveryRuleDefault:    resourcePolicyRuleIdpDiscoveryDefault(),
+			policyRuleMfa:              resourcePolicyMfaRule(),
+			policyRulePassword:         resourcePolicyPasswordRule(),
+			policyRuleSignOn:           resourcePolicySignonRule(),
+			resourceSet:                resourceResourceSet(),
+			subscription:               resourceSubscription(),
+			templateEmail:              resourceTemplateEmail(),
+			templateSms:                resourceTemplateSms(),
+			trustedOrigin:              resourceTrustedOrigin(),
+			userSchema:                 resourceUserSchema(),
+			userBaseSchema:             resourceUserBaseSchema(),
+			userLifecycleBatch:         resourceUserLifecycleBatch(),
+			userType:                   resourceUserType(),
 
 			// The day I realized I was naming stuff wrong :'-(
 			"okta_idp":                       deprecateIncorrectNaming(resourceIdpOidc(), idpOidc),
@@ -242,8 +391,13 @@ func Provider() *schema.Provider {
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"okta_app":                         dataSourceApp(),
+			oktaApps:                           dataSourceApps(),
 			appSaml:                            dataSourceAppSaml(),
+			appSamlCertificate:                 dataSourceAppSamlCertificate(),
 			appOAuth:                           dataSourceAppOauth(),
+			appGroupAssignments:                dataSourceAppGroupAssignments(),
+			oktaBrand:                          dataSourceBrand(),
+			x509Certificate:                    dataSourceX509Certificate(),
 			"okta_app_metadata_saml":           dataSourceAppMetadataSaml(),
 			"okta_default_policies":            deprecatedPolicies,
 			"okta_default_policy":              dataSourceDefaultPolicies(),
@@ -254,17 +408,36 @@ func Provider() *schema.Provider {
 			idpSaml:                            dataSourceIdpSaml(),
 			idpOidc:                            dataSourceIdpOidc(),
 			idpSocial:                          dataSourceIdpSocial(),
+			oktaIdps:                           dataSourceIdps(),
+			networkZones:                       dataSourceNetworkZones(),
+			oktaLog:                            dataSourceLog(),
 			"okta_policy":                      dataSourcePolicy(),
+			oktaPermissions:                    dataSourcePermissions(),
+			oktaPolicies:                       dataSourcePolicies(),
+			policyProfileEnrollmentApps:        dataSourcePolicyProfileEnrollmentApps(),
+			oktaRoles:                          dataSourceRoles(),
+			adminRoleCustom:                    dataSourceAdminRoleCustom(),
 			authServerPolicy:                   dataSourceAuthServerPolicy(),
 			"okta_user_profile_mapping_source": dataSourceUserProfileMappingSource(),
 			oktaUser:                           dataSourceUser(),
 			"okta_users":                       dataSourceUsers(),
+			unmanagedUsers:                     dataSourceUnmanagedUsers(),
+			userSecurityQuestions:              dataSourceUserSecurityQuestions(),
 			authServer:                         dataSourceAuthServer(),
+			authServers:                        dataSourceAuthServers(),
+			"okta_auth_server_metadata":        dataSourceAuthServerMetadata(),
 			"okta_auth_server_scopes":          dataSourceAuthServerScopes(),
 			userType:                           dataSourceUserType(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
+	addScopeValidation(p)
+	addAppRecreationGuard(p)
+	addAppLabelCheck(p)
+	addDeletePriority(p)
+	addNotFoundLogging(p)
+	addTracing(p)
+	return p
 }
 
 func deprecateIncorrectNaming(d *schema.Resource, newResource string) *schema.Resource {
@@ -275,24 +448,44 @@ func deprecateIncorrectNaming(d *schema.Resource, newResource string) *schema.Re
 func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	log.Printf("[INFO] Initializing Okta client")
 	config := Config{
-		orgName:        d.Get("org_name").(string),
-		domain:         d.Get("base_url").(string),
-		apiToken:       d.Get("api_token").(string),
-		parallelism:    d.Get("parallelism").(int),
-		clientID:       d.Get("client_id").(string),
-		privateKey:     d.Get("private_key").(string),
-		scopes:         convertInterfaceToStringSet(d.Get("scopes")),
-		retryCount:     d.Get("max_retries").(int),
-		minWait:        d.Get("min_wait_seconds").(int),
-		maxWait:        d.Get("max_wait_seconds").(int),
-		backoff:        d.Get("backoff").(bool),
-		logLevel:       d.Get("log_level").(int),
-		requestTimeout: d.Get("request_timeout").(int),
+		orgName:              d.Get("org_name").(string),
+		domain:               d.Get("base_url").(string),
+		apiToken:             d.Get("api_token").(string),
+		parallelism:          d.Get("parallelism").(int),
+		clientID:             d.Get("client_id").(string),
+		privateKey:           d.Get("private_key").(string),
+		scopes:               convertInterfaceToStringSet(d.Get("scopes")),
+		retryCount:           d.Get("max_retries").(int),
+		minWait:              d.Get("min_wait_seconds").(int),
+		maxWait:              d.Get("max_wait_seconds").(int),
+		maxAPICapacity:       d.Get("max_api_capacity").(int),
+		backoff:              d.Get("backoff").(bool),
+		logLevel:             d.Get("log_level").(int),
+		requestTimeout:       d.Get("request_timeout").(int),
+		preventAppRecreation: d.Get("prevent_app_recreation").(bool),
+		checkAppLabels:       d.Get("check_app_labels").(bool),
+		logUnknownAttributes: d.Get("log_unknown_attributes").(bool),
+		certWarningDays:      d.Get("certificate_expiry_warning_days").(int),
+		apiMetricsSummary:    d.Get("api_metrics_summary").(bool),
+		conditionalRequests:  d.Get("conditional_requests").(bool),
+		managedMarkerAttr:    d.Get("managed_marker_attribute").(string),
+		managedMarkerValue:   d.Get("managed_marker_value").(string),
+		userNameTemplate:     d.Get("user_name_template").(string),
+		userNameTemplateType: d.Get("user_name_template_type").(string),
+		userNameSuffix:       d.Get("user_name_template_suffix").(string),
+		resolveSecretRefs:    d.Get("resolve_secret_references").(bool),
+	}
+	if config.minWait > config.maxWait {
+		return nil, diag.Errorf("'min_wait_seconds' (%d) can not be greater than 'max_wait_seconds' (%d)", config.minWait, config.maxWait)
+	}
+	var diags diag.Diagnostics
+	if config.apiToken == "" {
+		config.scopeAccess, diags = resolveScopeAccess(config.scopes)
 	}
 	if err := config.loadAndValidate(); err != nil {
-		return nil, diag.Errorf("[ERROR] Error initializing the Okta SDK clients: %v", err)
+		return nil, append(diags, diag.Errorf("[ERROR] Error initializing the Okta SDK clients: %v", err)...)
 	}
-	return &config, nil
+	return &config, diags
 }
 
 func envDefaultSetFunc(k string, dv interface{}) schema.SchemaDefaultFunc {
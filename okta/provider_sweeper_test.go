@@ -24,6 +24,7 @@ func TestMain(m *testing.M) {
 	// Acceptance test sweepers necessary to prevent dangling resources
 	setupSweeper(policyPassword, deletePasswordPolicies)
 	setupSweeper(policySignOn, deleteSignOnPolicies)
+	setupSweeper(appSignOnPolicy, deleteAppSignOnPolicies)
 	setupSweeper(policyRuleIdpDiscovery, deletePolicyRuleIdpDiscovery)
 	setupSweeper(policyMfa, deleteMfaPolicies)
 	setupSweeper(policyRuleSignOn, deleteSignOnPolicyRules)
@@ -39,6 +40,8 @@ func TestMain(m *testing.M) {
 	setupSweeper(userBaseSchema, sweepUserBaseSchema)
 	setupSweeper(networkZone, sweepNetworkZones)
 	setupSweeper(inlineHook, sweepInlineHooks)
+	setupSweeper(logStream, sweepLogStreams)
+	setupSweeper(captcha, sweepCaptchas)
 	setupSweeper(userType, sweepUserTypes)
 
 	// add zones sweeper
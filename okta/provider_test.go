@@ -1,6 +1,7 @@
 package okta
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -34,6 +35,48 @@ func TestProvider_impl(t *testing.T) {
 	_ = Provider()
 }
 
+func TestProvider_retryEnvDefaults(t *testing.T) {
+	for k, v := range map[string]string{
+		"OKTA_BACKOFF":          "false",
+		"OKTA_MIN_WAIT_SECONDS": "10",
+		"OKTA_MAX_WAIT_SECONDS": "60",
+		"OKTA_MAX_RETRIES":      "2",
+		"OKTA_REQUEST_TIMEOUT":  "15",
+	} {
+		_ = os.Setenv(k, v)
+		defer os.Unsetenv(k)
+	}
+	p := Provider()
+	for k, expected := range map[string]string{
+		"backoff":          "false",
+		"min_wait_seconds": "10",
+		"max_wait_seconds": "60",
+		"max_retries":      "2",
+		"request_timeout":  "15",
+	} {
+		v, err := p.Schema[k].DefaultValue()
+		if err != nil {
+			t.Fatalf("failed to get default value of '%s': %v", k, err)
+		}
+		if v != expected {
+			t.Errorf("expected default value of '%s' to be '%s', got '%v'", k, expected, v)
+		}
+	}
+}
+
+func TestProviderConfigure_invalidWait(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"org_name":         "test",
+		"api_token":        "token",
+		"min_wait_seconds": 120,
+		"max_wait_seconds": 60,
+	})
+	_, diags := providerConfigure(context.Background(), d)
+	if !diags.HasError() {
+		t.Fatal("expected an error, when 'min_wait_seconds' is greater than 'max_wait_seconds'")
+	}
+}
+
 func oktaConfig() (*Config, error) {
 	config := &Config{
 		orgName:        os.Getenv("OKTA_ORG_NAME"),
@@ -0,0 +1,139 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+type (
+	// rateLimiter pauses the requests to the endpoint, when the share of its rate limit, which was consumed in the current
+	// window, reaches the configured capacity. Okta reports the state of the rate limit of the endpoint in the
+	// 'X-Rate-Limit-*' headers of every response, so the requests wait for the reset of the window, instead of failing
+	// with 429 and being retried.
+	rateLimiter struct {
+		capacity int
+		logger   hclog.Logger
+		lock     sync.Mutex
+		buckets  map[string]*rateLimitBucket
+		now      func() time.Time
+	}
+
+	rateLimitBucket struct {
+		limit     int
+		remaining int
+		reset     time.Time
+	}
+)
+
+func newRateLimiter(capacity int, logger hclog.Logger) *rateLimiter {
+	return &rateLimiter{
+		capacity: capacity,
+		logger:   logger,
+		buckets:  make(map[string]*rateLimitBucket),
+		now:      time.Now,
+	}
+}
+
+// transport returns the http.RoundTripper, which waits for the rate limit before sending the request.
+func (l *rateLimiter) transport(next http.RoundTripper) http.RoundTripper {
+	return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		key := rateLimitKey(req)
+		if err := l.wait(req.Context(), key); err != nil {
+			return nil, err
+		}
+		resp, err := next.RoundTrip(req)
+		if err == nil {
+			l.update(key, resp.Header)
+		}
+		return resp, err
+	})
+}
+
+// wait blocks until the request to the endpoint can be sent, and reserves it in the current window.
+func (l *rateLimiter) wait(ctx context.Context, key string) error {
+	for {
+		l.lock.Lock()
+		b, ok := l.buckets[key]
+		if !ok || !l.now().Before(b.reset) {
+			delete(l.buckets, key)
+			l.lock.Unlock()
+			return nil
+		}
+		if b.limit-b.remaining < b.limit*l.capacity/100 && b.remaining > 0 {
+			b.remaining--
+			l.lock.Unlock()
+			return nil
+		}
+		limit, delay := b.limit, b.reset.Sub(l.now())
+		l.lock.Unlock()
+		l.logger.Warn("rate limit capacity of the endpoint is consumed, waiting for the reset", "endpoint", key,
+			"limit", limit, "capacity", l.capacity, "wait", delay.String())
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// update saves the state of the rate limit of the endpoint, which was reported in the response.
+func (l *rateLimiter) update(key string, header http.Header) {
+	limit, err := strconv.Atoi(header.Get("X-Rate-Limit-Limit"))
+	if err != nil || limit <= 0 {
+		return
+	}
+	remaining, err := strconv.Atoi(header.Get("X-Rate-Limit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(header.Get("X-Rate-Limit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	b, ok := l.buckets[key]
+	// responses of the concurrent requests can come in any order, so the lowest remaining value of the window is kept
+	if ok && b.reset.Equal(time.Unix(reset, 0)) && b.remaining < remaining {
+		return
+	}
+	l.buckets[key] = &rateLimitBucket{limit: limit, remaining: remaining, reset: time.Unix(reset, 0)}
+}
+
+// rateLimitKey returns the endpoint of the request. Okta applies the rate limits to the endpoints, e.g.
+// '/api/v1/users/{id}', so the IDs of the objects in the path are replaced with the placeholder.
+func rateLimitKey(req *http.Request) string {
+	parts := strings.Split(req.URL.Path, "/")
+	for i, part := range parts {
+		if isOktaID(part) {
+			parts[i] = "{id}"
+		}
+	}
+	return req.Method + " " + strings.Join(parts, "/")
+}
+
+// isOktaID reports whether the path segment looks like an ID of an Okta object, e.g. '00u1a2b3c4d5e6f7g8h9'.
+func isOktaID(s string) bool {
+	if len(s) < 15 {
+		return false
+	}
+	var hasDigit bool
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+		if unicode.IsDigit(r) {
+			hasDigit = true
+		}
+	}
+	return hasDigit
+}
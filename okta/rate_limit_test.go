@@ -0,0 +1,74 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestRateLimitKey(t *testing.T) {
+	for _, tc := range []struct {
+		method, url, expected string
+	}{
+		{"GET", "https://example.okta.com/api/v1/users/00u1a2b3c4d5e6f7g8h9", "GET /api/v1/users/{id}"},
+		{"POST", "https://example.okta.com/api/v1/groups/00g1a2b3c4d5e6f7g8h9/users/00u1a2b3c4d5e6f7g8h9", "POST /api/v1/groups/{id}/users/{id}"},
+		{"GET", "https://example.okta.com/api/v1/apps?limit=200", "GET /api/v1/apps"},
+		{"GET", "https://example.okta.com/api/v1/meta/schemas/user/default", "GET /api/v1/meta/schemas/user/default"},
+	} {
+		req, _ := http.NewRequest(tc.method, tc.url, nil)
+		if actual := rateLimitKey(req); actual != tc.expected {
+			t.Errorf("expected key of '%s %s' to be '%s', actual: '%s'", tc.method, tc.url, tc.expected, actual)
+		}
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Unix()
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-Rate-Limit-Limit", "10")
+		w.Header().Set("X-Rate-Limit-Remaining", strconv.Itoa(10-requests))
+		w.Header().Set("X-Rate-Limit-Reset", strconv.FormatInt(reset, 10))
+	}))
+	defer server.Close()
+
+	limiter := newRateLimiter(50, hclog.NewNullLogger())
+	client := &http.Client{Transport: limiter.transport(http.DefaultTransport)}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	for i := 0; i < 10; i++ {
+		req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+"/api/v1/users", nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			break
+		}
+		resp.Body.Close()
+	}
+	if requests != 5 {
+		t.Errorf("expected 5 requests to be sent before waiting for the reset, actual: %d", requests)
+	}
+
+	// the window is reset
+	limiter.now = func() time.Time { return time.Unix(reset, 0) }
+	req, _ := http.NewRequest("GET", server.URL+"/api/v1/users", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("expected request to be sent after the reset, actual error: %v", err)
+	}
+	resp.Body.Close()
+
+	// other endpoints are not limited
+	limiter.now = time.Now
+	req, _ = http.NewRequest("GET", server.URL+"/api/v1/groups", nil)
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatalf("expected request to other endpoint to be sent, actual error: %v", err)
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,121 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// Okta keeps the org-wide self-service recovery configuration inside the default password policy. This resource
+// only owns the recovery part of that policy. okta_policy_password_default manages the same settings, so only one of
+// them should be used.
+func resourceAccountRecovery() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAccountRecoveryCreate,
+		ReadContext:   resourceAccountRecoveryRead,
+		UpdateContext: resourceAccountRecoveryUpdate,
+		DeleteContext: resourceAccountRecoveryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				policy, err := findSystemPolicy(ctx, m, sdk.PasswordPolicyType)
+				if err != nil {
+					return nil, err
+				}
+				d.SetId(policy.Id)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"email_recovery": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice([]string{statusActive, statusInactive}),
+				Description:      "Enable or disable email password recovery: ACTIVE or INACTIVE.",
+				Default:          statusActive,
+			},
+			"recovery_email_token": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Lifetime in minutes of the recovery email token.",
+				Default:     60,
+			},
+			"sms_recovery": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice([]string{statusActive, statusInactive}),
+				Description:      "Enable or disable SMS password recovery: ACTIVE or INACTIVE.",
+				Default:          statusInactive,
+			},
+			"call_recovery": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice([]string{statusActive, statusInactive}),
+				Description:      "Enable or disable voice call recovery: ACTIVE or INACTIVE.",
+				Default:          statusInactive,
+			},
+			"question_recovery": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice([]string{statusActive, statusInactive}),
+				Description:      "Enable or disable security question password recovery: ACTIVE or INACTIVE.",
+				Default:          statusActive,
+			},
+			"question_min_length": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Min length of the password recovery question answer.",
+				Default:     4,
+			},
+		},
+	}
+}
+
+func resourceAccountRecoveryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	policy, err := findSystemPolicy(ctx, m, sdk.PasswordPolicyType)
+	if err != nil {
+		return diag.Errorf("failed to find default password policy: %v", err)
+	}
+	d.SetId(policy.Id)
+	return resourceAccountRecoveryUpdate(ctx, d, m)
+}
+
+func resourceAccountRecoveryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	policy, err := getPolicy(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to get account recovery settings: %v", err)
+	}
+	if policy == nil {
+		d.SetId("")
+		return nil
+	}
+	if policy.Settings != nil {
+		setPasswordPolicyRecoverySettings(d, policy.Settings.Recovery)
+	}
+	return nil
+}
+
+func resourceAccountRecoveryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	policy, err := getPolicy(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to get account recovery settings: %v", err)
+	}
+	if policy == nil {
+		return diag.Errorf("default password policy does not exist")
+	}
+	if policy.Settings == nil {
+		policy.Settings = &sdk.PolicySettings{}
+	}
+	policy.Settings.Recovery = buildPasswordPolicyRecoverySettings(d)
+	_, _, err = getSupplementFromMetadata(m).UpdatePolicy(ctx, d.Id(), *policy)
+	if err != nil {
+		return diag.Errorf("failed to update account recovery settings: %v", err)
+	}
+	return resourceAccountRecoveryRead(ctx, d, m)
+}
+
+// Recovery settings can not be removed, they are only dropped from the state
+func resourceAccountRecoveryDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
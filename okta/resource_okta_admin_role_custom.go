@@ -0,0 +1,254 @@
+package okta
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// customRolePermissions maps the permissions, which can be granted by the custom admin roles, to their descriptions as
+// they are shown in the Admin Console.
+var customRolePermissions = map[string]string{
+	"okta.apps.assignment.manage":           "Manage the users and groups assigned to the applications",
+	"okta.apps.manage":                      "Edit the applications and manage their settings",
+	"okta.apps.read":                        "View the applications and their details",
+	"okta.authzservers.manage":              "Edit the authorization servers and manage their settings",
+	"okta.authzservers.read":                "View the authorization servers and their details",
+	"okta.groups.appAssignment.manage":      "Manage the application assignments of the groups",
+	"okta.groups.create":                    "Create groups",
+	"okta.groups.manage":                    "Edit the groups and their memberships",
+	"okta.groups.members.manage":            "Add users to groups and remove them",
+	"okta.groups.read":                      "View the groups and their details",
+	"okta.profilesources.import.run":        "Run imports from the profile sources",
+	"okta.users.appAssignment.manage":       "Manage the application assignments of the users",
+	"okta.users.create":                     "Create users",
+	"okta.users.credentials.expirePassword": "Expire the passwords of the users",
+	"okta.users.credentials.manage":         "Manage the credentials of the users",
+	"okta.users.credentials.resetFactors":   "Reset the authenticators of the users",
+	"okta.users.credentials.resetPassword":  "Reset the passwords of the users",
+	"okta.users.groupMembership.manage":     "Manage the group memberships of the users",
+	"okta.users.lifecycle.activate":         "Activate users",
+	"okta.users.lifecycle.clearSessions":    "Clear the sessions of the users",
+	"okta.users.lifecycle.deactivate":       "Deactivate users",
+	"okta.users.lifecycle.delete":           "Delete users",
+	"okta.users.lifecycle.manage":           "Perform any lifecycle operation on the users",
+	"okta.users.lifecycle.suspend":          "Suspend users",
+	"okta.users.lifecycle.unlock":           "Unlock users",
+	"okta.users.lifecycle.unsuspend":        "Unsuspend users",
+	"okta.users.manage":                     "Edit the users and perform any operation on them",
+	"okta.users.read":                       "View the users and their details",
+	"okta.users.userprofile.manage":         "Edit the profile attributes of the users",
+}
+
+// customRolePermissionNames returns the sorted names of the permissions, which can be granted by the custom admin roles.
+func customRolePermissionNames() []string {
+	names := make([]string, 0, len(customRolePermissions))
+	for name := range customRolePermissions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// customRolePermissionImplications are the permissions, which Okta adds to the custom admin role, when the permission is
+// granted, e.g. 'okta.users.read' for 'okta.users.manage'. The implications are transitive.
+var customRolePermissionImplications = map[string][]string{
+	"okta.apps.assignment.manage":      {"okta.apps.read"},
+	"okta.apps.manage":                 {"okta.apps.read", "okta.apps.assignment.manage"},
+	"okta.authzservers.manage":         {"okta.authzservers.read"},
+	"okta.groups.appAssignment.manage": {"okta.groups.read"},
+	"okta.groups.create":               {"okta.groups.read"},
+	"okta.groups.manage":               {"okta.groups.read", "okta.groups.members.manage", "okta.groups.appAssignment.manage"},
+	"okta.groups.members.manage":       {"okta.groups.read"},
+	"okta.users.appAssignment.manage":  {"okta.users.read"},
+	"okta.users.create":                {"okta.users.read"},
+	"okta.users.credentials.manage": {
+		"okta.users.read", "okta.users.credentials.expirePassword", "okta.users.credentials.resetFactors",
+		"okta.users.credentials.resetPassword",
+	},
+	"okta.users.credentials.expirePassword": {"okta.users.read"},
+	"okta.users.credentials.resetFactors":   {"okta.users.read"},
+	"okta.users.credentials.resetPassword":  {"okta.users.read"},
+	"okta.users.groupMembership.manage":     {"okta.users.read"},
+	"okta.users.lifecycle.manage": {
+		"okta.users.read", "okta.users.lifecycle.activate", "okta.users.lifecycle.clearSessions",
+		"okta.users.lifecycle.deactivate", "okta.users.lifecycle.delete", "okta.users.lifecycle.suspend",
+		"okta.users.lifecycle.unlock", "okta.users.lifecycle.unsuspend",
+	},
+	"okta.users.lifecycle.activate":      {"okta.users.read"},
+	"okta.users.lifecycle.clearSessions": {"okta.users.read"},
+	"okta.users.lifecycle.deactivate":    {"okta.users.read"},
+	"okta.users.lifecycle.delete":        {"okta.users.read"},
+	"okta.users.lifecycle.suspend":       {"okta.users.read"},
+	"okta.users.lifecycle.unlock":        {"okta.users.read"},
+	"okta.users.lifecycle.unsuspend":     {"okta.users.read"},
+	"okta.users.manage": {
+		"okta.users.read", "okta.users.create", "okta.users.userprofile.manage", "okta.users.credentials.manage",
+		"okta.users.lifecycle.manage", "okta.users.groupMembership.manage", "okta.users.appAssignment.manage",
+	},
+	"okta.users.userprofile.manage": {"okta.users.read"},
+}
+
+func resourceAdminRoleCustom() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAdminRoleCustomCreate,
+		ReadContext:   resourceAdminRoleCustomRead,
+		UpdateContext: resourceAdminRoleCustomUpdate,
+		DeleteContext: resourceAdminRoleCustomDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique label for the role",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Description of the role",
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: stringInSlice(customRolePermissionNames()),
+				},
+				Description: "List of permissions that the role grants, e.g. 'okta.users.read'",
+			},
+			"permissions_effective": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of permissions that the role grants in Okta, including the ones implied by the configured permissions",
+			},
+		},
+	}
+}
+
+func resourceAdminRoleCustomCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	role, _, err := getSupplementFromMetadata(m).CreateCustomRole(ctx, buildCustomRole(d))
+	if err != nil {
+		return diag.Errorf("failed to create custom admin role: %v", err)
+	}
+	d.SetId(role.Id)
+	return resourceAdminRoleCustomRead(ctx, d, m)
+}
+
+func resourceAdminRoleCustomRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	role, resp, err := getSupplementFromMetadata(m).GetCustomRole(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get custom admin role: %v", err)
+	}
+	if role == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("label", role.Label)
+	_ = d.Set("description", role.Description)
+	permissions, err := listCustomRolePermissions(ctx, m, d.Id())
+	if err != nil {
+		return diag.Errorf("failed to list custom admin role permissions: %v", err)
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"permissions":           convertStringSetToInterface(configuredCustomRolePermissions(convertInterfaceToStringSet(d.Get("permissions")), permissions)),
+		"permissions_effective": convertStringSetToInterface(permissions),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set custom admin role properties: %v", err)
+	}
+	return nil
+}
+
+func resourceAdminRoleCustomUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("label", "description") {
+		_, _, err := getSupplementFromMetadata(m).UpdateCustomRole(ctx, d.Id(), buildCustomRole(d))
+		if err != nil {
+			return diag.Errorf("failed to update custom admin role: %v", err)
+		}
+	}
+	if d.HasChange("permissions") {
+		oldPermissions, newPermissions := d.GetChange("permissions")
+		oldSet := oldPermissions.(*schema.Set)
+		newSet := newPermissions.(*schema.Set)
+		for _, permission := range convertInterfaceToStringSet(newSet.Difference(oldSet)) {
+			_, err := getSupplementFromMetadata(m).AddCustomRolePermission(ctx, d.Id(), permission)
+			if err != nil {
+				return diag.Errorf("failed to add '%s' permission to the custom admin role: %v", permission, err)
+			}
+		}
+		for _, permission := range convertInterfaceToStringSet(oldSet.Difference(newSet)) {
+			resp, err := getSupplementFromMetadata(m).DeleteCustomRolePermission(ctx, d.Id(), permission)
+			if err := suppressErrorOn404(resp, err); err != nil {
+				return diag.Errorf("failed to remove '%s' permission from the custom admin role: %v", permission, err)
+			}
+		}
+	}
+	return resourceAdminRoleCustomRead(ctx, d, m)
+}
+
+func resourceAdminRoleCustomDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteCustomRole(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete custom admin role: %v", err)
+	}
+	return nil
+}
+
+func buildCustomRole(d *schema.ResourceData) sdk.CustomRole {
+	return sdk.CustomRole{
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+		Permissions: convertInterfaceToStringSet(d.Get("permissions")),
+	}
+}
+
+func listCustomRolePermissions(ctx context.Context, m interface{}, roleID string) ([]string, error) {
+	permissions, _, err := getSupplementFromMetadata(m).ListCustomRolePermissions(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	labels := make([]string, len(permissions))
+	for i := range permissions {
+		labels[i] = permissions[i].Label
+	}
+	return labels, nil
+}
+
+// configuredCustomRolePermissions returns the effective permissions of the role without the ones, which Okta implies
+// from the configured permissions, since those would otherwise show up as a diff on every plan. The other permissions,
+// e.g. added outside of Terraform, are kept, so the drift is shown. All the effective permissions are returned, when
+// nothing is configured yet, e.g. on import.
+func configuredCustomRolePermissions(configured, effective []string) []string {
+	implied := impliedCustomRolePermissions(configured)
+	var permissions []string
+	for _, permission := range effective {
+		if contains(configured, permission) || !implied[permission] {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions
+}
+
+// impliedCustomRolePermissions returns the permissions, which Okta adds to the role with the granted ones.
+func impliedCustomRolePermissions(granted []string) map[string]bool {
+	implied := make(map[string]bool)
+	queue := append([]string(nil), granted...)
+	for len(queue) > 0 {
+		permission := queue[0]
+		queue = queue[1:]
+		for _, p := range customRolePermissionImplications[permission] {
+			if !implied[p] {
+				implied[p] = true
+				queue = append(queue, p)
+			}
+		}
+	}
+	return implied
+}
@@ -0,0 +1,141 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceAdminRoleCustomAssignments() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAdminRoleCustomAssignmentsCreate,
+		ReadContext:   resourceAdminRoleCustomAssignmentsRead,
+		UpdateContext: resourceAdminRoleCustomAssignmentsUpdate,
+		DeleteContext: resourceAdminRoleCustomAssignmentsDelete,
+		Importer:      createNestedResourceImporter([]string{"resource_set_id", "custom_role_id"}),
+		Schema: map[string]*schema.Schema{
+			"resource_set_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the target Resource Set",
+			},
+			"custom_role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Custom Role",
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: stringIsURL(validURLSchemes...),
+				},
+				Description: "The hrefs that point to User(s) and/or Group(s) that receive the Role, e.g. 'https://example.okta.com/api/v1/users/00u1234'",
+			},
+		},
+	}
+}
+
+func resourceAdminRoleCustomAssignmentsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	setID := d.Get("resource_set_id").(string)
+	roleID := d.Get("custom_role_id").(string)
+	binding := sdk.ResourceSetBinding{
+		Role:    roleID,
+		Members: convertInterfaceToStringSet(d.Get("members")),
+	}
+	_, err := getSupplementFromMetadata(m).CreateResourceSetBinding(ctx, setID, binding)
+	if err != nil {
+		return diag.Errorf("failed to assign custom admin role: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", setID, roleID))
+	return resourceAdminRoleCustomAssignmentsRead(ctx, d, m)
+}
+
+func resourceAdminRoleCustomAssignmentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	setID := d.Get("resource_set_id").(string)
+	roleID := d.Get("custom_role_id").(string)
+	binding, resp, err := getSupplementFromMetadata(m).GetResourceSetBinding(ctx, setID, roleID)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get custom admin role assignment: %v", err)
+	}
+	if binding == nil {
+		d.SetId("")
+		return nil
+	}
+	members, err := listCustomRoleAssignmentMembers(ctx, m, setID, roleID)
+	if err != nil {
+		return diag.Errorf("failed to list custom admin role assignment members: %v", err)
+	}
+	hrefs := make([]string, 0, len(members))
+	for href := range members {
+		hrefs = append(hrefs, href)
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"members": convertStringSetToInterface(hrefs),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set custom admin role assignment properties: %v", err)
+	}
+	return nil
+}
+
+func resourceAdminRoleCustomAssignmentsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	setID := d.Get("resource_set_id").(string)
+	roleID := d.Get("custom_role_id").(string)
+	oldMembers, newMembers := d.GetChange("members")
+	oldSet := oldMembers.(*schema.Set)
+	newSet := newMembers.(*schema.Set)
+	add := convertInterfaceToStringSet(newSet.Difference(oldSet))
+	if len(add) > 0 {
+		_, err := getSupplementFromMetadata(m).AddResourceSetBindingMembers(ctx, setID, roleID, sdk.IAMAdditions{Additions: add})
+		if err != nil {
+			return diag.Errorf("failed to add members to the custom admin role assignment: %v", err)
+		}
+	}
+	remove := convertInterfaceToStringSet(oldSet.Difference(newSet))
+	if len(remove) > 0 {
+		members, err := listCustomRoleAssignmentMembers(ctx, m, setID, roleID)
+		if err != nil {
+			return diag.Errorf("failed to list custom admin role assignment members: %v", err)
+		}
+		for _, href := range remove {
+			id, ok := members[href]
+			if !ok {
+				continue
+			}
+			resp, err := getSupplementFromMetadata(m).DeleteResourceSetBindingMember(ctx, setID, roleID, id)
+			if err := suppressErrorOn404(resp, err); err != nil {
+				return diag.Errorf("failed to remove '%s' member from the custom admin role assignment: %v", href, err)
+			}
+		}
+	}
+	return resourceAdminRoleCustomAssignmentsRead(ctx, d, m)
+}
+
+func resourceAdminRoleCustomAssignmentsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteResourceSetBinding(ctx, d.Get("resource_set_id").(string), d.Get("custom_role_id").(string))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete custom admin role assignment: %v", err)
+	}
+	return nil
+}
+
+// listCustomRoleAssignmentMembers returns the map of the member URLs to their IDs in the assignment.
+func listCustomRoleAssignmentMembers(ctx context.Context, m interface{}, setID, roleID string) (map[string]string, error) {
+	members, _, err := getSupplementFromMetadata(m).ListResourceSetBindingMembers(ctx, setID, roleID)
+	if err != nil {
+		return nil, err
+	}
+	hrefs := make(map[string]string, len(members))
+	for _, member := range members {
+		hrefs[member.Href()] = member.Id
+	}
+	return hrefs, nil
+}
@@ -0,0 +1,46 @@
+package okta
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaAdminRoleCustomAssignments_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", adminRoleCustomAssignments)
+	mgr := newFixtureManager(adminRoleCustomAssignments)
+	// Replace example org url with actual url to prevent API error
+	config := strings.ReplaceAll(mgr.GetFixtures("basic.tf", ri, t), "https://your.okta.org", getOktaDomainName())
+	updatedConfig := strings.ReplaceAll(mgr.GetFixtures("basic_updated.tf", ri, t), "https://your.okta.org", getOktaDomainName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(adminRoleCustom, doesAdminRoleCustomExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "resource_set_id", "okta_resource_set.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "custom_role_id", "okta_admin_role_custom.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
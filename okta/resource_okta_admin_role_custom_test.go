@@ -0,0 +1,99 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaAdminRoleCustom_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", adminRoleCustom)
+	mgr := newFixtureManager(adminRoleCustom)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(adminRoleCustom, doesAdminRoleCustomExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesAdminRoleCustomExist),
+					resource.TestCheckResourceAttr(resourceName, "label", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "description", "testing, testing"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "okta.users.read"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "okta.groups.read"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions_effective.*", "okta.users.read"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions_effective.*", "okta.groups.read"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesAdminRoleCustomExist),
+					resource.TestCheckResourceAttr(resourceName, "label", fmt.Sprintf("testAccUpdated_%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "description", "testing, testing updated"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "okta.users.manage"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "okta.groups.manage"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestConfiguredCustomRolePermissions(t *testing.T) {
+	for _, tc := range []struct {
+		configured, effective, expected []string
+	}{
+		{
+			[]string{"okta.users.manage"},
+			[]string{"okta.users.manage", "okta.users.read", "okta.users.lifecycle.unlock"},
+			[]string{"okta.users.manage"},
+		},
+		{
+			// the permission added outside of Terraform is kept, so the drift is shown
+			[]string{"okta.users.read"},
+			[]string{"okta.users.read", "okta.groups.read"},
+			[]string{"okta.users.read", "okta.groups.read"},
+		},
+		{
+			nil,
+			[]string{"okta.apps.manage", "okta.apps.read"},
+			[]string{"okta.apps.manage", "okta.apps.read"},
+		},
+	} {
+		actual := configuredCustomRolePermissions(tc.configured, tc.effective)
+		if strings.Join(actual, ",") != strings.Join(tc.expected, ",") {
+			t.Errorf("expected %v for %v, got %v", tc.expected, tc.configured, actual)
+		}
+	}
+}
+
+func TestCustomRolePermissionImplications(t *testing.T) {
+	for permission, implied := range customRolePermissionImplications {
+		for _, p := range append([]string{permission}, implied...) {
+			if _, ok := customRolePermissions[p]; !ok {
+				t.Errorf("unknown permission '%s' in the implications of '%s'", p, permission)
+			}
+		}
+	}
+}
+
+func doesAdminRoleCustomExist(id string) (bool, error) {
+	_, resp, err := getSupplementFromMetadata(testAccProvider.Meta()).GetCustomRole(context.Background(), id)
+	return doesResourceExist(resp, err)
+}
@@ -0,0 +1,147 @@
+package okta
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func resourceAPITokenNetwork() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAPITokenNetworkUpdate,
+		ReadContext:   resourceAPITokenNetworkRead,
+		UpdateContext: resourceAPITokenNetworkUpdate,
+		DeleteContext: resourceAPITokenNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("token_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"token_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the API token. If not set, the API token used by the provider is managed",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the API token",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the user, who created the API token",
+			},
+			"network_connection": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ANYWHERE",
+				ValidateDiagFunc: stringInSlice([]string{"ANYWHERE", "ZONE"}),
+				Description:      "Network selection mode: ANYWHERE or ZONE",
+			},
+			"network_includes": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"network_excludes"},
+				Description:   "IDs of the network zones, from which the API token can be used",
+			},
+			"network_excludes": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"network_includes"},
+				Description:   "IDs of the network zones, from which the API token can't be used",
+			},
+		},
+	}
+}
+
+func resourceAPITokenNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	token, resp, err := getSupplementFromMetadata(m).GetApiToken(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get API token: %v", err)
+	}
+	if token == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("token_id", token.Id)
+	_ = d.Set("name", token.Name)
+	_ = d.Set("user_id", token.UserId)
+	network := token.Network
+	if network == nil {
+		network = &okta.PolicyNetworkCondition{Connection: "ANYWHERE"}
+	}
+	_ = d.Set("network_connection", network.Connection)
+	err = setNonPrimitives(d, map[string]interface{}{
+		"network_includes": convertStringSetToInterface(network.Include),
+		"network_excludes": convertStringSetToInterface(network.Exclude),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set API token network conditions: %v", err)
+	}
+	return nil
+}
+
+// resourceAPITokenNetworkUpdate changes the network conditions of the existing API token, the API tokens can't be
+// created through the API.
+func resourceAPITokenNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	network := &okta.PolicyNetworkCondition{
+		Connection: d.Get("network_connection").(string),
+		Include:    convertInterfaceToStringSetNullable(d.Get("network_includes")),
+		Exclude:    convertInterfaceToStringSetNullable(d.Get("network_excludes")),
+	}
+	if network.Connection == "ZONE" && len(network.Include) == 0 && len(network.Exclude) == 0 {
+		return diag.FromErr(errors.New("'network_includes' or 'network_excludes' should be set, when 'network_connection' is ZONE"))
+	}
+	if network.Connection != "ZONE" && (len(network.Include) > 0 || len(network.Exclude) > 0) {
+		return diag.FromErr(errors.New("'network_includes' and 'network_excludes' can be set only when 'network_connection' is ZONE"))
+	}
+	id := d.Id()
+	if id == "" {
+		id = d.Get("token_id").(string)
+		if id == "" {
+			id = "current"
+		}
+	}
+	err := updateAPITokenNetwork(ctx, m, id, network)
+	if err != nil {
+		return diag.Errorf("failed to update API token network conditions: %v", err)
+	}
+	if d.Id() == "" {
+		token, _, err := getSupplementFromMetadata(m).GetApiToken(ctx, id)
+		if err != nil {
+			return diag.Errorf("failed to get API token: %v", err)
+		}
+		d.SetId(token.Id)
+	}
+	return resourceAPITokenNetworkRead(ctx, d, m)
+}
+
+// resourceAPITokenNetworkDelete removes the network restrictions, so the API token can be used from anywhere again.
+func resourceAPITokenNetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := updateAPITokenNetwork(ctx, m, d.Id(), &okta.PolicyNetworkCondition{Connection: "ANYWHERE"})
+	if err != nil {
+		return diag.Errorf("failed to remove API token network conditions: %v", err)
+	}
+	return nil
+}
+
+func updateAPITokenNetwork(ctx context.Context, m interface{}, id string, network *okta.PolicyNetworkCondition) error {
+	client := getSupplementFromMetadata(m)
+	token, _, err := client.GetApiToken(ctx, id)
+	if err != nil {
+		return err
+	}
+	token.Network = network
+	_, _, err = client.UpdateApiToken(ctx, token.Id, *token)
+	return err
+}
@@ -16,9 +16,10 @@ func resourceAppAutoLogin() *schema.Resource {
 		UpdateContext: resourceAppAutoLoginUpdate,
 		DeleteContext: resourceAppAutoLoginDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: appImporter,
 		},
 		Schema: buildAppSwaSchema(map[string]*schema.Schema{
+			"app_settings_json": buildAppSettingsJSONSchema(),
 			"preconfigured_app": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -64,17 +65,20 @@ func resourceAppAutoLogin() *schema.Resource {
 			"shared_password": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Shared password, required for certain schemes.",
+				Description: "Shared password, required for certain schemes. It may refer to the AWS Secrets Manager or Vault secret.",
 			},
 		}),
 	}
 }
 
 func resourceAppAutoLoginCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	app := buildAppAutoLogin(d)
+	app, err := buildAppAutoLogin(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to create auto login application: %v", err)
+	}
 	activate := d.Get("status").(string) == statusActive
 	params := &query.Params{Activate: &activate}
-	_, _, err := getOktaClientFromMetadata(m).Application.CreateApplication(ctx, app, params)
+	_, _, err = getOktaClientFromMetadata(m).Application.CreateApplication(ctx, app, params)
 	if err != nil {
 		return diag.Errorf("failed to create auto login application: %v", err)
 	}
@@ -104,12 +108,16 @@ func resourceAppAutoLoginRead(ctx context.Context, d *schema.ResourceData, m int
 		_ = d.Set("sign_on_url", app.Settings.SignOn.LoginUrl)
 		_ = d.Set("sign_on_redirect_url", app.Settings.SignOn.RedirectUrl)
 	}
+	if app.Settings.App != nil {
+		err = setAppSettings(d, app.Settings.App)
+		if err != nil {
+			return diag.Errorf("failed to set auto login app settings: %v", err)
+		}
+	}
 	_ = d.Set("credentials_scheme", app.Credentials.Scheme)
 	_ = d.Set("reveal_password", app.Credentials.RevealPassword)
 	_ = d.Set("shared_username", app.Credentials.UserName) // We can sync shared username but not password from upstream
-	_ = d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
-	_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
-	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
+	setUserNameTemplate(d, m, app.Credentials.UserNameTemplate)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 	err = syncGroupsAndUsers(ctx, app.Id, d, m)
@@ -129,8 +137,11 @@ func resourceAppAutoLoginRead(ctx context.Context, d *schema.ResourceData, m int
 
 func resourceAppAutoLoginUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
-	app := buildAppAutoLogin(d)
-	err := updateAppByID(ctx, d.Id(), m, app)
+	app, err := buildAppAutoLogin(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to update auto login application: %v", err)
+	}
+	err = updateAppByID(ctx, d.Id(), m, app)
 	if err != nil {
 		return diag.Errorf("failed to update auto login application: %v", err)
 	}
@@ -153,7 +164,7 @@ func resourceAppAutoLoginDelete(ctx context.Context, d *schema.ResourceData, m i
 	return nil
 }
 
-func buildAppAutoLogin(d *schema.ResourceData) *okta.AutoLoginApplication {
+func buildAppAutoLogin(ctx context.Context, d *schema.ResourceData, m interface{}) (*okta.AutoLoginApplication, error) {
 	// Abstracts away name and SignOnMode which are constant for this app type.
 	app := okta.NewAutoLoginApplication()
 	app.Label = d.Get("label").(string)
@@ -164,13 +175,19 @@ func buildAppAutoLogin(d *schema.ResourceData) *okta.AutoLoginApplication {
 	}
 
 	app.Settings = &okta.AutoLoginApplicationSettings{
+		App: buildAppSettings(d),
 		SignOn: &okta.AutoLoginApplicationSettingsSignOn{
 			LoginUrl:    d.Get("sign_on_url").(string),
 			RedirectUrl: d.Get("sign_on_redirect_url").(string),
 		},
 	}
+	app.Accessibility = buildAccessibility(d)
 	app.Visibility = buildVisibility(d)
-	app.Credentials = buildSchemeCreds(d)
+	creds, err := buildSchemeCreds(ctx, d, m)
+	if err != nil {
+		return nil, err
+	}
+	app.Credentials = creds
 
-	return app
+	return app, nil
 }
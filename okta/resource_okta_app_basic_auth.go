@@ -16,7 +16,7 @@ func resourceAppBasicAuth() *schema.Resource {
 		UpdateContext: resourceAppBasicAuthUpdate,
 		DeleteContext: resourceAppBasicAuthDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: appImporter,
 		},
 		Schema: buildAppSchemaWithVisibility(map[string]*schema.Schema{
 			"auth_url": {
@@ -75,6 +75,7 @@ func resourceAppBasicAuthRead(ctx context.Context, d *schema.ResourceData, m int
 	_ = d.Set("auto_submit_toolbar", app.Visibility.AutoSubmitToolbar)
 	_ = d.Set("hide_ios", app.Visibility.Hide.IOS)
 	_ = d.Set("hide_web", app.Visibility.Hide.Web)
+	setAppLinks(d, app.Visibility.AppLinks)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
 	err = syncGroupsAndUsers(ctx, app.Id, d, m)
 	if err != nil {
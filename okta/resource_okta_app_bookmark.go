@@ -16,11 +16,16 @@ func resourceAppBookmark() *schema.Resource {
 		UpdateContext: resourceAppBookmarkUpdate,
 		DeleteContext: resourceAppBookmarkDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: appImporter,
 		},
 		// For those familiar with Terraform schemas be sure to check the base application schema and/or
 		// the examples in the documentation
 		Schema: buildAppSchemaWithVisibility(map[string]*schema.Schema{
+			"app_settings_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Application settings in JSON format. They are managed with the other attributes of the application.",
+			},
 			"url": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -69,6 +74,10 @@ func resourceAppBookmarkRead(ctx context.Context, d *schema.ResourceData, m inte
 	_ = d.Set("url", app.Settings.App.Url)
 	_ = d.Set("label", app.Label)
 	_ = d.Set("request_integration", app.Settings.App.RequestIntegration)
+	err = setAppSettingsFromStruct(d, app.Settings.App)
+	if err != nil {
+		return diag.Errorf("failed to set bookmark app settings: %v", err)
+	}
 	_ = d.Set("name", app.Name)
 	_ = d.Set("status", app.Status)
 	_ = d.Set("sign_on_mode", app.SignOnMode)
@@ -76,6 +85,7 @@ func resourceAppBookmarkRead(ctx context.Context, d *schema.ResourceData, m inte
 	_ = d.Set("auto_submit_toolbar", app.Visibility.AutoSubmitToolbar)
 	_ = d.Set("hide_ios", app.Visibility.Hide.IOS)
 	_ = d.Set("hide_web", app.Visibility.Hide.Web)
+	setAppLinks(d, app.Visibility.AppLinks)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
 	err = syncGroupsAndUsers(ctx, app.Id, d, m)
 	if err != nil {
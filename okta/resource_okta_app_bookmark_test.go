@@ -40,6 +40,7 @@ func TestAccAppBookmarkApplication_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
 					resource.TestCheckResourceAttr(resourceName, "url", "https://test.com"),
 					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "app_links_json", `{"login":false}`),
 					resource.TestCheckResourceAttrSet(resourceName, "logo_url"),
 				),
 			},
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -11,6 +12,12 @@ import (
 	"github.com/okta/okta-sdk-golang/v2/okta"
 )
 
+const (
+	profileStrategyReplace         = "REPLACE"
+	profileStrategyIgnoreAddedKeys = "IGNORE_ADDED_KEYS"
+	profileStrategyDeclaredKeys    = "DECLARED_KEYS"
+)
+
 func resourceAppGroupAssignment() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAppGroupAssignmentCreate,
@@ -26,6 +33,7 @@ func resourceAppGroupAssignment() *schema.Resource {
 				_ = d.Set("app_id", parts[0])
 				_ = d.Set("group_id", parts[1])
 				_ = d.Set("retain_assignment", false)
+				_ = d.Set("profile_merge_strategy", profileStrategyReplace)
 				assignment, _, err := getOktaClientFromMetadata(m).Application.
 					GetApplicationGroupAssignment(ctx, parts[0], parts[1], nil)
 				if err != nil {
@@ -49,8 +57,9 @@ func resourceAppGroupAssignment() *schema.Resource {
 				ForceNew:    true,
 			},
 			"priority": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Priority of the assignment, which is used when the user is assigned to the app by several groups",
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					p, n := d.GetChange("priority")
 					return p == n && new == "0"
@@ -61,10 +70,18 @@ func resourceAppGroupAssignment() *schema.Resource {
 				ValidateDiagFunc: stringIsJSON,
 				StateFunc:        normalizeDataJSON,
 				Optional:         true,
+				Description:      "Group-scoped app profile in JSON format. Only the attributes set here are managed.",
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return new == ""
 				},
 			},
+			"profile_merge_strategy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          profileStrategyReplace,
+				ValidateDiagFunc: stringInSlice([]string{profileStrategyReplace, profileStrategyIgnoreAddedKeys, profileStrategyDeclaredKeys}),
+				Description:      "How the profile is written and compared with the one in Okta: REPLACE, IGNORE_ADDED_KEYS or DECLARED_KEYS.",
+			},
 			"retain_assignment": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -76,11 +93,15 @@ func resourceAppGroupAssignment() *schema.Resource {
 }
 
 func resourceAppGroupAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	body, err := buildAppGroupAssignmentWithStrategy(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	assignment, _, err := getOktaClientFromMetadata(m).Application.CreateApplicationGroupAssignment(
 		ctx,
 		d.Get("app_id").(string),
 		d.Get("group_id").(string),
-		buildAppGroupAssignment(d),
+		body,
 	)
 	if err != nil {
 		return diag.Errorf("failed to create application group assignment: %v", err)
@@ -90,12 +111,16 @@ func resourceAppGroupAssignmentCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAppGroupAssignmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	body, err := buildAppGroupAssignmentWithStrategy(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	// Create actually does a PUT
-	_, _, err := getOktaClientFromMetadata(m).Application.CreateApplicationGroupAssignment(
+	_, _, err = getOktaClientFromMetadata(m).Application.CreateApplicationGroupAssignment(
 		ctx,
 		d.Get("app_id").(string),
 		d.Get("group_id").(string),
-		buildAppGroupAssignment(d),
+		body,
 	)
 	if err != nil {
 		return diag.Errorf("failed to update application group assignment: %v", err)
@@ -117,11 +142,7 @@ func resourceAppGroupAssignmentRead(ctx context.Context, d *schema.ResourceData,
 		d.SetId("")
 		return nil
 	}
-	jsonProfile, err := json.Marshal(g.Profile)
-	if err != nil {
-		return diag.Errorf("failed to marshal app user profile to JSON: %v", err)
-	}
-	_ = d.Set("profile", string(jsonProfile))
+	_ = d.Set("profile", groupAssignmentProfileJSON(g.Profile, d.Get("profile").(string), d.Get("profile_merge_strategy").(string)))
 	_ = d.Set("priority", g.Priority)
 	return nil
 }
@@ -133,12 +154,12 @@ func resourceAppGroupAssignmentDelete(ctx context.Context, d *schema.ResourceDat
 		return nil
 	}
 
-	_, err := getOktaClientFromMetadata(m).Application.DeleteApplicationGroupAssignment(
+	resp, err := getOktaClientFromMetadata(m).Application.DeleteApplicationGroupAssignment(
 		ctx,
 		d.Get("app_id").(string),
 		d.Get("group_id").(string),
 	)
-	if err != nil {
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to delete application group assignment: %v", err)
 	}
 	return nil
@@ -154,3 +175,38 @@ func buildAppGroupAssignment(d *schema.ResourceData) okta.ApplicationGroupAssign
 		Priority: int64(priority),
 	}
 }
+
+// buildAppGroupAssignmentWithStrategy merges the configured profile into the current one of the assignment, when the
+// profile is managed with 'DECLARED_KEYS' strategy, so the attributes set by Okta or outside of Terraform are kept.
+func buildAppGroupAssignmentWithStrategy(ctx context.Context, d *schema.ResourceData, m interface{}) (okta.ApplicationGroupAssignment, error) {
+	assignment := buildAppGroupAssignment(d)
+	if d.Get("profile_merge_strategy").(string) != profileStrategyDeclaredKeys || assignment.Profile == nil {
+		return assignment, nil
+	}
+	current, resp, err := getOktaClientFromMetadata(m).Application.GetApplicationGroupAssignment(
+		ctx,
+		d.Get("app_id").(string),
+		d.Get("group_id").(string),
+		nil,
+	)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return assignment, fmt.Errorf("failed to get application group assignment: %v", err)
+	}
+	if current != nil {
+		assignment.Profile = mergeProfile(current.Profile, assignment.Profile)
+	}
+	return assignment, nil
+}
+
+// groupAssignmentProfileJSON returns the profile of the group assignment in JSON format. With the 'REPLACE' strategy
+// only the top level attributes present in the configured profile are compared, with the other strategies the
+// attributes added by Okta are ignored at every level of nesting.
+func groupAssignmentProfileJSON(profile interface{}, configured, strategy string) string {
+	if profile == nil || configured == "" || strategy == profileStrategyReplace {
+		return assignmentProfileJSON(profile, configured)
+	}
+	var configuredProfile interface{}
+	_ = json.Unmarshal([]byte(configured), &configuredProfile)
+	b, _ := json.Marshal(filterProfile(profile, configuredProfile))
+	return string(b)
+}
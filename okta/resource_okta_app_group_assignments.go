@@ -1,10 +1,13 @@
 package okta
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,30 +20,32 @@ func resourceAppGroupAssignments() *schema.Resource {
 		ReadContext:   resourceAppGroupAssignmentsRead,
 		DeleteContext: resourceAppGroupAssignmentsDelete,
 		UpdateContext: resourceAppGroupAssignmentsUpdate,
-		Importer:      &schema.ResourceImporter{},
-
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("app_id", d.Id())
+				_ = d.Set("retain_assignments", false)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceAppGroupAssignmentsResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceAppGroupAssignmentsStateUpgradeV0,
+				Version: 0,
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"app_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
-
 			"group": {
-				Type:        schema.TypeSet,
+				Type:        schema.TypeList,
 				Required:    true,
-				Description: "A group to assign to this application",
+				Description: "List of the groups to assign to this application, ordered by the priority, the first group has the highest priority",
 				MinItems:    1,
-				Set: func(v interface{}) int {
-					buf := bytes.NewBuffer(nil)
-					group := v.(map[string]interface{})
-
-					buf.WriteString(fmt.Sprintf("%s-", group["id"].(string)))
-					buf.WriteString(fmt.Sprintf("%s-", normalizeDataJSON(group["profile"])))
-					buf.WriteString(fmt.Sprintf("%d-", group["priority"].(int)))
-
-					return schema.HashString(buf.String())
-				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -49,12 +54,10 @@ func resourceAppGroupAssignments() *schema.Resource {
 							Description: "A group to associate with the application",
 						},
 						"priority": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								p, n := d.GetChange("priority")
-								return p == n && new == "0"
-							},
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Priority of the assignment, defaults to the priority of the previous group plus one",
+							DiffSuppressFunc: suppressImplicitAppGroupAssignmentPriority,
 						},
 						"profile": {
 							Type:             schema.TypeString,
@@ -62,52 +65,135 @@ func resourceAppGroupAssignments() *schema.Resource {
 							StateFunc:        normalizeDataJSON,
 							Optional:         true,
 							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								return new == ""
+								// the profile is kept only when the group at the same position is the same
+								o, n := d.GetChange(strings.TrimSuffix(k, "profile") + "id")
+								return new == "" && o == n
 							},
 						},
 					},
 				},
 			},
+			"retain_assignments": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Leave the group assignments, which are not in the configuration, alone instead of removing them",
+			},
 		},
 	}
 }
 
-func resourceAppGroupAssignmentsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	groups := d.Get("group").(*schema.Set).List()
-	client := getOktaClientFromMetadata(m)
+// resourceAppGroupAssignmentsResourceV0 returns the schema of the resource, where the groups were a set.
+func resourceAppGroupAssignmentsResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"profile": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceAppGroupAssignmentsStateUpgradeV0 orders the groups, which were a set, by the priority.
+func resourceAppGroupAssignmentsStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	groups, ok := rawState["group"].([]interface{})
+	if !ok {
+		return rawState, nil
+	}
+	priority := func(group interface{}) float64 {
+		g, _ := group.(map[string]interface{})
+		switch p := g["priority"].(type) {
+		case float64:
+			return p
+		case int:
+			return float64(p)
+		case json.Number:
+			f, _ := p.Float64()
+			return f
+		}
+		return 0
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return priority(groups[i]) < priority(groups[j])
+	})
+	rawState["group"] = groups
+	return rawState, nil
+}
 
-	assignments := tfGroupsToGroupAssignments(groups...)
+// suppressImplicitAppGroupAssignmentPriority suppresses the diff of the priority, which is not set, when the priority
+// of the assignment is the one implied by the position of the group.
+func suppressImplicitAppGroupAssignmentPriority(k, old, new string, d *schema.ResourceData) bool {
+	if new != "" && new != "0" {
+		return false
+	}
+	parts := strings.Split(k, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	i, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return false
+	}
+	priorities := appGroupAssignmentPriorities(d.Get("group").([]interface{}))
+	return i < len(priorities) && old == strconv.FormatInt(priorities[i], 10)
+}
 
-	// run through all groups in the set and create an assignment
-	for groupID, assignment := range assignments {
-		_, _, err := client.Application.CreateApplicationGroupAssignment(
-			ctx,
-			d.Get("app_id").(string),
-			groupID,
-			assignment,
-		)
+func resourceAppGroupAssignmentsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	appID := d.Get("app_id").(string)
+	assignments := buildAppGroupAssignments(d.Get("group").([]interface{}))
+	if !d.Get("retain_assignments").(bool) {
+		// the assignments, which are not in the configuration, are removed right away instead of on the next apply
+		existing, err := listApplicationGroupAssignments(ctx, client, appID)
+		if err != nil {
+			return diag.Errorf("failed to fetch group assignments: %v", err)
+		}
+		_, toRemove := diffAppGroupAssignments(flattenAppGroupAssignments(existing), assignments)
+		err = deleteGroupAssignments(client.Application.DeleteApplicationGroupAssignment, ctx, appID, toRemove)
 		if err != nil {
-			return diag.Errorf("failed to create application group assignment: %v", err)
+			return diag.Errorf("failed to delete group assignment: %v", err)
 		}
 	}
-
+	err := addGroupAssignments(client.Application.CreateApplicationGroupAssignment, ctx, appID, assignments)
+	if err != nil {
+		return diag.Errorf("failed to create application group assignment: %v", err)
+	}
 	// okta_app_group_assignments completely control all assignments for an application
-	d.SetId(d.Get("app_id").(string))
+	d.SetId(appID)
 	return resourceAppGroupAssignmentsRead(ctx, d, m)
 }
 
 func resourceAppGroupAssignmentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := getOktaClientFromMetadata(m)
-
-	assignments, err := listApplicationGroupAssignments(
-		ctx,
-		client,
-		d.Get("app_id").(string),
-	)
+	assignments, err := listApplicationGroupAssignments(ctx, getOktaClientFromMetadata(m), d.Id())
 	if err != nil {
 		return diag.Errorf("failed to fetch group assignments: %v", err)
 	}
-
+	groups := d.Get("group").([]interface{})
+	if d.Get("retain_assignments").(bool) {
+		assignments = filterAppGroupAssignments(assignments, groups)
+	}
+	sortAppGroupAssignments(assignments, groups)
 	tfFlattenedAssignments := make([]interface{}, len(assignments))
 	for i, assignment := range assignments {
 		tfAssignment, err := groupAssignmentToTFGroup(assignment)
@@ -116,7 +202,7 @@ func resourceAppGroupAssignmentsRead(ctx context.Context, d *schema.ResourceData
 		}
 		tfFlattenedAssignments[i] = tfAssignment
 	}
-
+	_ = d.Set("app_id", d.Id())
 	err = d.Set("group", tfFlattenedAssignments)
 	if err != nil {
 		return diag.Errorf("failed to set groups in tf state: %v", err)
@@ -126,18 +212,10 @@ func resourceAppGroupAssignmentsRead(ctx context.Context, d *schema.ResourceData
 
 func resourceAppGroupAssignmentsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
-
-	for _, rawGroup := range d.Get("group").(*schema.Set).List() {
-		group := rawGroup.(map[string]interface{})
-
-		_, err := client.Application.DeleteApplicationGroupAssignment(
-			ctx,
-			d.Get("app_id").(string),
-			group["id"].(string),
-		)
-		if err != nil {
-			return diag.Errorf("failed to delete application group assignment: %v", err)
-		}
+	assignments := buildAppGroupAssignments(d.Get("group").([]interface{}))
+	err := deleteGroupAssignments(client.Application.DeleteApplicationGroupAssignment, ctx, d.Get("app_id").(string), assignments)
+	if err != nil {
+		return diag.Errorf("failed to delete application group assignment: %v", err)
 	}
 	return nil
 }
@@ -145,40 +223,88 @@ func resourceAppGroupAssignmentsDelete(ctx context.Context, d *schema.ResourceDa
 func resourceAppGroupAssignmentsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	appID := d.Get("app_id").(string)
-
-	old, new := d.GetChange("group")
-	oldSet := old.(*schema.Set)
-	newSet := new.(*schema.Set)
-
-	toAdd := tfGroupsToGroupAssignments(
-		newSet.Difference(oldSet).List()...,
-	)
-	toRemove := tfGroupsToGroupAssignments(
-		oldSet.Difference(newSet).List()...,
-	)
-
-	err := deleteGroupAssignments(
-		client.Application.DeleteApplicationGroupAssignment,
-		ctx,
-		appID,
-		toRemove,
+	oldGroups, newGroups := d.GetChange("group")
+	toAdd, toRemove := diffAppGroupAssignments(
+		buildAppGroupAssignments(oldGroups.([]interface{})),
+		buildAppGroupAssignments(newGroups.([]interface{})),
 	)
+	err := deleteGroupAssignments(client.Application.DeleteApplicationGroupAssignment, ctx, appID, toRemove)
 	if err != nil {
 		return diag.Errorf("failed to delete group assignment: %v", err)
 	}
-
-	err = addGroupAssignments(
-		client.Application.CreateApplicationGroupAssignment,
-		ctx,
-		appID,
-		toAdd,
-	)
+	err = addGroupAssignments(client.Application.CreateApplicationGroupAssignment, ctx, appID, toAdd)
 	if err != nil {
 		return diag.Errorf("failed to add group assignment: %v", err)
 	}
 	return resourceAppGroupAssignmentsRead(ctx, d, m)
 }
 
+// sortAppGroupAssignments orders the assignments by the priority. The assignments of the configured groups keep the
+// order of the 'group' blocks, so the explicit priorities, which don't follow the order of the blocks, don't produce a
+// diff, while the other assignments, e.g. the imported ones, follow them.
+func sortAppGroupAssignments(assignments []*okta.ApplicationGroupAssignment, groups []interface{}) {
+	position := make(map[string]int, len(groups))
+	for i, group := range groups {
+		if g, ok := group.(map[string]interface{}); ok {
+			position[g["id"].(string)] = i
+		}
+	}
+	sort.SliceStable(assignments, func(i, j int) bool {
+		pi, iConfigured := position[assignments[i].Id]
+		pj, jConfigured := position[assignments[j].Id]
+		switch {
+		case iConfigured && jConfigured:
+			return pi < pj
+		case iConfigured != jConfigured:
+			return iConfigured
+		}
+		return assignments[i].Priority < assignments[j].Priority
+	})
+}
+
+// filterAppGroupAssignments returns the assignments of the groups, which are managed by the resource.
+func filterAppGroupAssignments(assignments []*okta.ApplicationGroupAssignment, groups []interface{}) []*okta.ApplicationGroupAssignment {
+	managed := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		if g, ok := group.(map[string]interface{}); ok {
+			managed[g["id"].(string)] = true
+		}
+	}
+	var filtered []*okta.ApplicationGroupAssignment
+	for _, assignment := range assignments {
+		if managed[assignment.Id] {
+			filtered = append(filtered, assignment)
+		}
+	}
+	return filtered
+}
+
+// diffAppGroupAssignments returns the assignments to be created or updated, in order, and the assignments to be removed.
+func diffAppGroupAssignments(old, new []okta.ApplicationGroupAssignment) (toAdd, toRemove []okta.ApplicationGroupAssignment) {
+	current := make(map[string]okta.ApplicationGroupAssignment, len(old))
+	for _, assignment := range old {
+		current[assignment.Id] = assignment
+	}
+	for _, assignment := range new {
+		existing, ok := current[assignment.Id]
+		delete(current, assignment.Id)
+		if ok && assignment.Profile == nil {
+			// the profile is not managed, so the current one is kept
+			assignment.Profile = existing.Profile
+		}
+		if ok && existing.Priority == assignment.Priority && reflect.DeepEqual(existing.Profile, assignment.Profile) {
+			continue
+		}
+		toAdd = append(toAdd, assignment)
+	}
+	for _, assignment := range old {
+		if _, ok := current[assignment.Id]; ok {
+			toRemove = append(toRemove, assignment)
+		}
+	}
+	return toAdd, toRemove
+}
+
 // groupAssignmentToTFGroup
 func groupAssignmentToTFGroup(assignment *okta.ApplicationGroupAssignment) (map[string]interface{}, error) {
 	profile := "{}"
@@ -199,29 +325,64 @@ func groupAssignmentToTFGroup(assignment *okta.ApplicationGroupAssignment) (map[
 	return tfAssignment, nil
 }
 
-func tfGroupsToGroupAssignments(groups ...interface{}) map[string]okta.ApplicationGroupAssignment {
-	assignments := map[string]okta.ApplicationGroupAssignment{}
-	// run through all groups in the set and create an assignment
-	for _, untypedGroup := range groups {
-		group := untypedGroup.(map[string]interface{})
+// flattenAppGroupAssignments returns the assignments as they are built from the configuration.
+func flattenAppGroupAssignments(assignments []*okta.ApplicationGroupAssignment) []okta.ApplicationGroupAssignment {
+	arr := make([]okta.ApplicationGroupAssignment, len(assignments))
+	for i := range assignments {
+		arr[i] = *assignments[i]
+	}
+	return arr
+}
+
+// appGroupAssignmentPriorities returns the priorities of the groups in the order of the 'group' blocks. The priority
+// of the group, which is not set, is the priority of the previous group plus one. The groups with no id are skipped.
+func appGroupAssignmentPriorities(groups []interface{}) []int64 {
+	priorities := make([]int64, len(groups))
+	priority := int64(-1)
+	for i, untypedGroup := range groups {
+		group, _ := untypedGroup.(map[string]interface{})
+		if id, _ := group["id"].(string); id == "" {
+			continue
+		}
+		if p, _ := group["priority"].(int); p > 0 {
+			priority = int64(p)
+		} else {
+			priority++
+		}
+		priorities[i] = priority
+	}
+	return priorities
+}
 
+// buildAppGroupAssignments returns the assignments ordered by the priority. The priority of the group, which is not
+// set, is the priority of the previous group plus one.
+func buildAppGroupAssignments(groups []interface{}) []okta.ApplicationGroupAssignment {
+	var assignments []okta.ApplicationGroupAssignment
+	priorities := appGroupAssignmentPriorities(groups)
+	for i, untypedGroup := range groups {
+		group, ok := untypedGroup.(map[string]interface{})
+		if !ok {
+			continue
+		}
 		id := group["id"].(string)
 		// skip empty groups with no id
 		if id == "" {
 			continue
 		}
-		priority := group["priority"].(int)
-
-		rawProfile := group["profile"]
+		priority := priorities[i]
 		var profile interface{}
-		_ = json.Unmarshal([]byte(rawProfile.(string)), &profile)
-
-		assignments[id] = okta.ApplicationGroupAssignment{
+		if rawProfile, ok := group["profile"].(string); ok && rawProfile != "" {
+			_ = json.Unmarshal([]byte(rawProfile), &profile)
+		}
+		assignments = append(assignments, okta.ApplicationGroupAssignment{
 			Profile:  profile,
-			Priority: int64(priority),
+			Priority: priority,
 			Id:       id,
-		}
+		})
 	}
+	sort.SliceStable(assignments, func(i, j int) bool {
+		return assignments[i].Priority < assignments[j].Priority
+	})
 	return assignments
 }
 
@@ -230,10 +391,10 @@ func addGroupAssignments(
 	add func(context.Context, string, string, okta.ApplicationGroupAssignment) (*okta.ApplicationGroupAssignment, *okta.Response, error),
 	ctx context.Context,
 	appID string,
-	assignments map[string]okta.ApplicationGroupAssignment,
+	assignments []okta.ApplicationGroupAssignment,
 ) error {
-	for groupID, assignment := range assignments {
-		_, _, err := add(ctx, appID, groupID, assignment)
+	for _, assignment := range assignments {
+		_, _, err := add(ctx, appID, assignment.Id, assignment)
 		if err != nil {
 			return err
 		}
@@ -246,14 +407,14 @@ func deleteGroupAssignments(
 	delete func(context.Context, string, string) (*okta.Response, error),
 	ctx context.Context,
 	appID string,
-	assignments map[string]okta.ApplicationGroupAssignment,
+	assignments []okta.ApplicationGroupAssignment,
 ) error {
-	for groupID := range assignments {
-		_, err := delete(ctx, appID, groupID)
-		if err != nil {
+	for _, assignment := range assignments {
+		resp, err := delete(ctx, appID, assignment.Id)
+		if err := suppressErrorOn404(resp, err); err != nil {
 			return fmt.Errorf(
 				"could not delete assignment for group %s, to application %s: %w",
-				groupID,
+				assignment.Id,
 				appID,
 				err,
 			)
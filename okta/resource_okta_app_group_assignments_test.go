@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/okta-sdk-golang/v2/okta"
 )
 
 func TestAccAppGroupAssignments_crud(t *testing.T) {
@@ -38,6 +39,11 @@ func TestAccAppGroupAssignments_crud(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					ensureAppGroupAssignmentsExist(resourceName, group1, group2),
 					resource.TestCheckResourceAttrSet(resourceName, "app_id"),
+					resource.TestCheckResourceAttr(resourceName, "group.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "group.0.id", group1, "id"),
+					resource.TestCheckResourceAttr(resourceName, "group.0.priority", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "group.1.id", group2, "id"),
+					resource.TestCheckResourceAttr(resourceName, "group.1.priority", "1"),
 				),
 			},
 			{
@@ -51,6 +57,163 @@ func TestAccAppGroupAssignments_crud(t *testing.T) {
 	})
 }
 
+func TestAccAppGroupAssignments_retain(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", appGroupAssignments)
+	mgr := newFixtureManager(appGroupAssignments)
+	config := mgr.GetFixtures("retain.tf", ri, t)
+	group2 := fmt.Sprintf("%s.test2", oktaGroup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureAppGroupAssignmentsExist(resourceName, group2),
+					resource.TestCheckResourceAttr(resourceName, "group.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "group.0.id", group2, "id"),
+					ensureAppGroupAssignmentExists(fmt.Sprintf("%s.test", appGroupAssignment)),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildAppGroupAssignments(t *testing.T) {
+	assignments := buildAppGroupAssignments([]interface{}{
+		map[string]interface{}{"id": "a", "priority": 0, "profile": ""},
+		map[string]interface{}{"id": "b", "priority": 5, "profile": `{"role":"admin"}`},
+		map[string]interface{}{"id": "", "priority": 0, "profile": ""},
+		map[string]interface{}{"id": "c", "priority": 0, "profile": ""},
+		map[string]interface{}{"id": "d", "priority": 3, "profile": ""},
+	})
+	if len(assignments) != 4 {
+		t.Fatalf("expected 4 assignments, got %d", len(assignments))
+	}
+	for i, expected := range []struct {
+		id       string
+		priority int64
+	}{{"a", 0}, {"d", 3}, {"b", 5}, {"c", 6}} {
+		if assignments[i].Id != expected.id || assignments[i].Priority != expected.priority {
+			t.Errorf("expected %s with priority %d at %d, got %s with priority %d", expected.id, expected.priority, i,
+				assignments[i].Id, assignments[i].Priority)
+		}
+	}
+	if assignments[0].Profile != nil {
+		t.Errorf("expected no profile, got %v", assignments[0].Profile)
+	}
+}
+
+// TestAppGroupAssignmentsImplicitPriorityDiff verifies that the priority, which is not set, is compared against the
+// priority implied by the position of the group, so the priorities changed outside of Terraform are detected.
+func TestAppGroupAssignmentsImplicitPriorityDiff(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"app_id": "0oa1",
+		"group": []interface{}{
+			map[string]interface{}{"id": "a"},
+			map[string]interface{}{"id": "b"},
+		},
+	})
+	for _, tc := range []struct {
+		priority string
+		diff     bool
+	}{
+		{"1", false},
+		{"5", true},
+	} {
+		state := &terraform.InstanceState{
+			ID: "0oa1",
+			Attributes: map[string]string{
+				"id":                 "0oa1",
+				"app_id":             "0oa1",
+				"retain_assignments": "false",
+				"group.#":            "2",
+				"group.0.id":         "a",
+				"group.0.priority":   "0",
+				"group.1.id":         "b",
+				"group.1.priority":   tc.priority,
+			},
+		}
+		diff, err := resourceAppGroupAssignments().SimpleDiff(context.Background(), state, config, nil)
+		if err != nil {
+			t.Fatalf("failed to diff group assignments: %v", err)
+		}
+		_, changed := diff.Attributes["group.1.priority"]
+		if diff == nil || diff.Empty() {
+			changed = false
+		}
+		if changed != tc.diff {
+			t.Errorf("expected the diff of priority %s to be %t, got %+v", tc.priority, tc.diff, diff)
+		}
+	}
+}
+
+func TestSortAppGroupAssignments(t *testing.T) {
+	assignments := []*okta.ApplicationGroupAssignment{
+		{Id: "imported", Priority: 0},
+		{Id: "a", Priority: 1},
+		{Id: "b", Priority: 2},
+		{Id: "other", Priority: 3},
+	}
+	sortAppGroupAssignments(assignments, []interface{}{
+		map[string]interface{}{"id": "b", "priority": 2},
+		map[string]interface{}{"id": "a", "priority": 1},
+	})
+	for i, expected := range []string{"b", "a", "imported", "other"} {
+		if assignments[i].Id != expected {
+			t.Errorf("expected %s at %d, got %s", expected, i, assignments[i].Id)
+		}
+	}
+}
+
+func TestAppGroupAssignmentsStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"app_id": "app",
+		"group": []interface{}{
+			map[string]interface{}{"id": "b", "priority": float64(2), "profile": "{}"},
+			map[string]interface{}{"id": "a", "priority": float64(1), "profile": "{}"},
+		},
+	}
+	upgraded, err := resourceAppGroupAssignmentsStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("failed to upgrade state: %v", err)
+	}
+	groups := upgraded["group"].([]interface{})
+	if groups[0].(map[string]interface{})["id"] != "a" || groups[1].(map[string]interface{})["id"] != "b" {
+		t.Errorf("expected the groups to be ordered by the priority, got %v", groups)
+	}
+}
+
+func TestDiffAppGroupAssignments(t *testing.T) {
+	old := []okta.ApplicationGroupAssignment{
+		{Id: "a", Priority: 0, Profile: map[string]interface{}{"role": "admin"}},
+		{Id: "b", Priority: 1},
+		{Id: "c", Priority: 2},
+	}
+	new := []okta.ApplicationGroupAssignment{
+		{Id: "b", Priority: 0},
+		{Id: "a", Priority: 1},
+		{Id: "d", Priority: 2},
+	}
+	toAdd, toRemove := diffAppGroupAssignments(old, new)
+	if len(toAdd) != 3 || toAdd[0].Id != "b" || toAdd[1].Id != "a" || toAdd[2].Id != "d" {
+		t.Fatalf("unexpected assignments to add: %+v", toAdd)
+	}
+	if toAdd[1].Profile == nil {
+		t.Error("expected the unmanaged profile to be kept")
+	}
+	if len(toRemove) != 1 || toRemove[0].Id != "c" {
+		t.Fatalf("unexpected assignments to remove: %+v", toRemove)
+	}
+	toAdd, toRemove = diffAppGroupAssignments(old, old)
+	if len(toAdd) != 0 || len(toRemove) != 0 {
+		t.Fatalf("expected no changes, got %+v and %+v", toAdd, toRemove)
+	}
+}
+
 func ensureAppGroupAssignmentsExist(name string, groupsExpected ...string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		missingErr := fmt.Errorf("resource not found: %s", name)
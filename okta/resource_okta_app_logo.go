@@ -0,0 +1,132 @@
+package okta
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func resourceAppLogo() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppLogoCreate,
+		ReadContext:   resourceAppLogoRead,
+		UpdateContext: resourceAppLogoUpdate,
+		DeleteContext: resourceAppLogoDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		// The content of the file may change, while its path stays the same
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			file := d.Get("file").(string)
+			if !d.NewValueKnown("file") || file == "" {
+				return nil
+			}
+			hash, err := fileSHA256(file)
+			if err != nil {
+				return err
+			}
+			if hash != d.Get("file_hash").(string) {
+				return d.SetNew("file_hash", hash)
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the application",
+			},
+			"file": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: logoValid(),
+				Description:      "Path to the logo file, it should be a PNG, JPEG or GIF file less than 1 MB in size",
+			},
+			"file_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the uploaded logo file",
+			},
+			"logo_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of the application's logo",
+			},
+		},
+	}
+}
+
+func resourceAppLogoCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	appID := d.Get("app_id").(string)
+	err := uploadAppLogo(ctx, d, m, appID)
+	if err != nil {
+		return diag.Errorf("failed to upload application logo: %v", err)
+	}
+	d.SetId(appID)
+	return resourceAppLogoRead(ctx, d, m)
+}
+
+func resourceAppLogoRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	app := okta.NewApplication()
+	err := fetchAppByID(ctx, d.Id(), m, app)
+	if err != nil {
+		return diag.Errorf("failed to get application: %v", err)
+	}
+	if app.Id == "" {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("app_id", app.Id)
+	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
+	return nil
+}
+
+func resourceAppLogoUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("file", "file_hash") {
+		err := uploadAppLogo(ctx, d, m, d.Id())
+		if err != nil {
+			return diag.Errorf("failed to upload application logo: %v", err)
+		}
+	}
+	return resourceAppLogoRead(ctx, d, m)
+}
+
+// Okta has no API to remove the logo, the application keeps the last uploaded one until it's replaced.
+func resourceAppLogoDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+func uploadAppLogo(ctx context.Context, d *schema.ResourceData, m interface{}, appID string) error {
+	file := d.Get("file").(string)
+	hash, err := fileSHA256(file)
+	if err != nil {
+		return err
+	}
+	_, err = getSupplementFromMetadata(m).UploadAppLogo(ctx, appID, file)
+	if err != nil {
+		return err
+	}
+	_ = d.Set("file_hash", hash)
+	return nil
+}
+
+// fileSHA256 returns the hex encoded SHA-256 hash of the file's content.
+func fileSHA256(filename string) (string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,75 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func TestAccAppLogo_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appLogo)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appLogo)
+	var hash string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appBookmark, createDoesAppExist(okta.NewBookmarkApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "app_id", fmt.Sprintf("%s.test", appBookmark), "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "logo_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "file_hash"),
+					func(s *terraform.State) error {
+						hash = s.RootModule().Resources[resourceName].Primary.Attributes["file_hash"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "logo_url"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources[resourceName].Primary.Attributes["file_hash"] == hash {
+							return fmt.Errorf("expected hash of the updated logo to differ from '%s'", hash)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file", "file_hash"},
+			},
+		},
+	})
+}
+
+func TestFileSHA256(t *testing.T) {
+	hash, err := fileSHA256("../examples/okta_app_logo/terraform_icon.png")
+	if err != nil {
+		t.Fatalf("failed to hash the file: %v", err)
+	}
+	updated, err := fileSHA256("../examples/okta_app_logo/terraform_icon_updated.png")
+	if err != nil {
+		t.Fatalf("failed to hash the file: %v", err)
+	}
+	if len(hash) != 64 || hash == updated {
+		t.Errorf("expected different SHA-256 hashes of the files, got '%s' and '%s'", hash, updated)
+	}
+	if _, err := fileSHA256("../examples/okta_app_logo/missing.png"); err == nil {
+		t.Error("expected hashing of the missing file to fail")
+	}
+}
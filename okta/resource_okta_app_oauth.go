@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 type (
@@ -81,9 +82,9 @@ func resourceAppOAuth() *schema.Resource {
 		UpdateContext: resourceAppOAuthUpdate,
 		DeleteContext: resourceAppOAuthDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: appImporter,
 		},
-		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, v interface{}) error {
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
 			// Force new if omit_secret goes from true to false
 			if d.Id() != "" {
 				oldValue, newValue := d.GetChange("omit_secret")
@@ -91,11 +92,23 @@ func resourceAppOAuth() *schema.Resource {
 					return d.ForceNew("omit_secret")
 				}
 			}
+			// the API drops the refresh token settings without the 'refresh_token' grant. They are computed, so the
+			// unchanged values may come from the state rather than the configuration, and only the changed ones are checked
+			if d.Id() != "" && !d.HasChange("refresh_token_rotation") && !d.HasChange("refresh_token_leeway") {
+				return nil
+			}
+			_, rotation := d.GetOk("refresh_token_rotation")
+			_, leeway := d.GetOk("refresh_token_leeway")
+			if (rotation || leeway) && !contains(convertInterfaceToStringSet(d.Get("grant_types")), refreshToken) {
+				return fmt.Errorf("'refresh_token_rotation' and 'refresh_token_leeway' can only be set when 'grant_types' contains '%s'", refreshToken)
+			}
 			return nil
 		},
 		// For those familiar with Terraform schemas be sure to check the base application schema and/or
 		// the examples in the documentation
 		Schema: buildAppSchema(map[string]*schema.Schema{
+			"app_settings_json":     buildAppSettingsJSONSchema(),
+			"authentication_policy": buildAuthenticationPolicySchema(),
 			"type": {
 				Type:             schema.TypeString,
 				ValidateDiagFunc: stringInSlice([]string{"web", "native", "browser", "service"}),
@@ -186,6 +199,13 @@ func resourceAppOAuth() *schema.Resource {
 				},
 				Description: "List of scopes to use for the request",
 			},
+			"wildcard_redirect": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "DISABLED",
+				ValidateDiagFunc: stringInSlice([]string{"DISABLED", "SUBDOMAIN"}),
+				Description:      "Indicates if the client is allowed to use wildcard matching of redirect_uris: DISABLED or SUBDOMAIN",
+			},
 			"redirect_uris": {
 				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -302,6 +322,30 @@ func resourceAppOAuth() *schema.Resource {
 				Description:   "*Early Access Property*. Enable Federation Broker Mode.",
 				ConflictsWith: []string{"groups", "users"},
 			},
+			"granted_scopes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: stringInSlice(validScopes),
+				},
+				Description: "Scopes of the Okta API, which are granted to the application. If set, the scopes granted outside of Terraform are revoked. Removing all the scopes revokes every grant.",
+			},
+			"refresh_token_rotation": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: stringInSlice([]string{"ROTATE", "STATIC"}),
+				Description:      "Refresh token rotation behavior, applies when 'grant_types' contains 'refresh_token'. Valid values: ROTATE, STATIC.",
+			},
+			"refresh_token_leeway": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: intBetween(0, 60),
+				RequiredWith:     []string{"refresh_token_rotation"},
+				Description:      "Grace period in seconds, during which the previous refresh token is still accepted after the rotation.",
+			},
 		}),
 	}
 }
@@ -317,7 +361,7 @@ func resourceAppOAuthCreate(ctx context.Context, d *schema.ResourceData, m inter
 	app := buildAppOAuth(d)
 	activate := d.Get("status").(string) == statusActive
 	params := &query.Params{Activate: &activate}
-	_, _, err := client.Application.CreateApplication(ctx, app, params)
+	_, _, err := client.Application.CreateApplication(ctx, oauthAppWithWildcardRedirect(d, app), params)
 	if err != nil {
 		return diag.Errorf("failed to create OAuth application: %v", err)
 	}
@@ -337,15 +381,26 @@ func resourceAppOAuthCreate(ctx context.Context, d *schema.ResourceData, m inter
 	if err != nil {
 		return diag.Errorf("failed to upload logo for OAuth application: %v", err)
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for OAuth application: %v", err)
+	}
+	if _, ok := d.GetOk("granted_scopes"); ok {
+		err = reconcileAppOAuthGrantedScopes(ctx, d, m)
+		if err != nil {
+			return diag.Errorf("failed to grant scopes to OAuth application: %v", err)
+		}
+	}
 	return resourceAppOAuthRead(ctx, d, m)
 }
 
 func resourceAppOAuthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	app := okta.NewOpenIdConnectApplication()
-	err := fetchApp(ctx, d, m, app)
+	oauthApp := sdk.NewOpenIdConnectApplication(nil)
+	err := fetchApp(ctx, d, m, oauthApp)
 	if err != nil {
 		return diag.Errorf("failed to get OAuth application: %v", err)
 	}
+	app := oauthApp.Unwrap()
 	if app.Id == "" {
 		d.SetId("")
 		return nil
@@ -370,10 +425,22 @@ func resourceAppOAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 	_ = d.Set("tos_uri", app.Settings.OauthClient.TosUri)
 	_ = d.Set("policy_uri", app.Settings.OauthClient.PolicyUri)
 	_ = d.Set("login_uri", app.Settings.OauthClient.InitiateLoginUri)
+	if oauthApp.WildcardRedirect() != "" {
+		_ = d.Set("wildcard_redirect", oauthApp.WildcardRedirect())
+	} else {
+		_ = d.Set("wildcard_redirect", "DISABLED")
+	}
+	if app.Settings.App != nil {
+		if err := setAppSettings(d, app.Settings.App); err != nil {
+			return diag.Errorf("failed to set OAuth app settings: %v", err)
+		}
+	}
 	_ = d.Set("auto_submit_toolbar", app.Visibility.AutoSubmitToolbar)
 	_ = d.Set("hide_ios", app.Visibility.Hide.IOS)
 	_ = d.Set("hide_web", app.Visibility.Hide.Web)
+	setAppLinks(d, app.Visibility.AppLinks)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
+	setAppAuthenticationPolicy(d, app.Links)
 	if app.Settings.ImplicitAssignment != nil {
 		_ = d.Set("implicit_assignment", *app.Settings.ImplicitAssignment)
 	}
@@ -383,6 +450,10 @@ func resourceAppOAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 	if app.Settings.OauthClient.IssuerMode != "" {
 		_ = d.Set("issuer_mode", app.Settings.OauthClient.IssuerMode)
 	}
+	if app.Settings.OauthClient.RefreshToken != nil {
+		_ = d.Set("refresh_token_rotation", app.Settings.OauthClient.RefreshToken.RotationType)
+		_ = d.Set("refresh_token_leeway", app.Settings.OauthClient.RefreshToken.Leeway)
+	}
 
 	// If this is ever changed omit it.
 	if d.Get("omit_secret").(bool) {
@@ -430,6 +501,23 @@ func resourceAppOAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 	if app.Settings.OauthClient.IdpInitiatedLogin != nil {
 		_ = d.Set("login_mode", app.Settings.OauthClient.IdpInitiatedLogin.Mode)
 		aggMap["login_scopes"] = convertStringSetToInterface(app.Settings.OauthClient.IdpInitiatedLogin.DefaultScope)
+	} else {
+		// API omits IdP-initiated login settings when it is disabled
+		_ = d.Set("login_mode", "DISABLED")
+		aggMap["login_scopes"] = convertStringSetToInterface(nil)
+	}
+	// scopes can also be granted by okta_app_oauth_api_scope resources, or outside of Terraform, so the grants
+	// are only read when they are managed by this resource
+	if d.Get("granted_scopes").(*schema.Set).Len() > 0 {
+		grants, err := listOAuthApiScopes(ctx, m, app.Id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		grantedScopes := make([]string, len(grants))
+		for i := range grants {
+			grantedScopes[i] = grants[i].ScopeId
+		}
+		aggMap["granted_scopes"] = convertStringSetToInterface(grantedScopes)
 	}
 	err = setNonPrimitives(d, aggMap)
 	if err != nil {
@@ -447,7 +535,20 @@ func resourceAppOAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.Errorf("failed to create OAuth application: %v", err)
 	}
 	app := buildAppOAuth(d)
-	_, _, err := client.Application.UpdateApplication(ctx, d.Id(), app)
+	err := retryOnConflict(ctx, func(merge bool) (*okta.Response, error) {
+		if merge {
+			latest := okta.NewOpenIdConnectApplication()
+			_, resp, err := client.Application.GetApplication(ctx, d.Id(), latest, nil)
+			if err != nil {
+				return resp, err
+			}
+			// redirect URIs are also appended by okta_app_oauth_redirect_uri resources
+			app.Settings.OauthClient.RedirectUris = mergeStringSet(d, "redirect_uris", latest.Settings.OauthClient.RedirectUris)
+			app.Settings.OauthClient.PostLogoutRedirectUris = mergeStringSet(d, "post_logout_redirect_uris", latest.Settings.OauthClient.PostLogoutRedirectUris)
+		}
+		_, resp, err := client.Application.UpdateApplication(ctx, d.Id(), oauthAppWithWildcardRedirect(d, app))
+		return resp, err
+	})
 	if err != nil {
 		return diag.Errorf("failed to update OAuth application: %v", err)
 	}
@@ -474,6 +575,16 @@ func resourceAppOAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 			return diag.Errorf("failed to upload logo for OAuth application: %v", err)
 		}
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for OAuth application: %v", err)
+	}
+	if d.HasChange("granted_scopes") {
+		err = reconcileAppOAuthGrantedScopes(ctx, d, m)
+		if err != nil {
+			return diag.Errorf("failed to grant scopes to OAuth application: %v", err)
+		}
+	}
 	return resourceAppOAuthRead(ctx, d, m)
 }
 
@@ -544,6 +655,7 @@ func buildAppOAuth(d *schema.ResourceData) *okta.OpenIdConnectApplication {
 		oktaGrantTypes[i] = &gt
 	}
 	app.Settings = &okta.OpenIdConnectApplicationSettings{
+		App:                buildAppSettings(d),
 		ImplicitAssignment: boolPtr(d.Get("implicit_assignment").(bool)),
 		OauthClient: &okta.OpenIdConnectApplicationSettingsClient{
 			ApplicationType:        appType,
@@ -564,6 +676,12 @@ func buildAppOAuth(d *schema.ResourceData) *okta.OpenIdConnectApplication {
 			},
 		},
 	}
+	if rotation, ok := d.GetOk("refresh_token_rotation"); ok && contains(grantTypes, refreshToken) {
+		app.Settings.OauthClient.RefreshToken = &okta.OpenIdConnectApplicationSettingsRefreshToken{
+			RotationType: rotation.(string),
+			Leeway:       int64(d.Get("refresh_token_leeway").(int)),
+		}
+	}
 	jwks := d.Get("jwks").([]interface{})
 	if len(jwks) > 0 {
 		keys := make([]*okta.JsonWebKey, len(jwks))
@@ -590,6 +708,14 @@ func buildAppOAuth(d *schema.ResourceData) *okta.OpenIdConnectApplication {
 	return app
 }
 
+// oauthAppWithWildcardRedirect adds the wildcard redirect mode to the OAuth client of the application, it's always
+// sent, since the application is replaced on update, and the mode set outside of Terraform would be lost otherwise.
+func oauthAppWithWildcardRedirect(d *schema.ResourceData, app *okta.OpenIdConnectApplication) okta.App {
+	oauthApp := sdk.NewOpenIdConnectApplication(app)
+	oauthApp.SetWildcardRedirect(d.Get("wildcard_redirect").(string))
+	return oauthApp
+}
+
 func validateGrantTypes(d *schema.ResourceData) error {
 	grantTypeList := convertInterfaceToStringSet(d.Get("grant_types"))
 	appType := d.Get("type").(string)
@@ -634,3 +760,36 @@ func validateAppOAuth(d *schema.ResourceData) error {
 	}
 	return nil
 }
+
+// reconcileAppOAuthGrantedScopes grants the missing scopes to the application and revokes the ones, which are not in
+// the 'granted_scopes'.
+func reconcileAppOAuthGrantedScopes(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	grants, err := listOAuthApiScopes(ctx, m, d.Id())
+	if err != nil {
+		return err
+	}
+	client := getOktaClientFromMetadata(m)
+	desired := d.Get("granted_scopes").(*schema.Set)
+	current := make(map[string]bool, len(grants))
+	for _, grant := range grants {
+		current[grant.ScopeId] = true
+		if desired.Contains(grant.ScopeId) {
+			continue
+		}
+		resp, err := client.Application.RevokeScopeConsentGrant(ctx, d.Id(), grant.Id)
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return fmt.Errorf("failed to revoke '%s' scope: %v", grant.ScopeId, err)
+		}
+	}
+	issuer := client.GetConfig().Okta.Client.OrgUrl
+	for _, scope := range convertInterfaceToStringSet(desired) {
+		if current[scope] {
+			continue
+		}
+		_, _, err := client.Application.GrantConsentToScope(ctx, d.Id(), *newOAuthApiScope(scope, issuer))
+		if err != nil {
+			return fmt.Errorf("failed to grant '%s' scope: %v", scope, err)
+		}
+	}
+	return nil
+}
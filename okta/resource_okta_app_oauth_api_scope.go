@@ -11,9 +11,16 @@ import (
 )
 
 var validScopes = []string{
+	"okta.apiTokens.manage", "okta.apiTokens.read",
 	"okta.apps.manage", "okta.apps.read",
+	"okta.authenticators.manage", "okta.authenticators.read",
 	"okta.authorizationServers.manage", "okta.authorizationServers.read",
+	"okta.behaviors.manage", "okta.behaviors.read",
+	"okta.brands.manage", "okta.brands.read",
+	"okta.captchas.manage", "okta.captchas.read",
 	"okta.clients.manage", "okta.clients.read", "okta.clients.register",
+	"okta.devices.manage", "okta.devices.read",
+	"okta.domains.manage", "okta.domains.read",
 	"okta.eventHooks.manage", "okta.eventHooks.read",
 	"okta.events.read",
 	"okta.factors.manage", "okta.factors.read",
@@ -22,11 +29,15 @@ var validScopes = []string{
 	"okta.inlineHooks.manage", "okta.inlineHooks.read",
 	"okta.linkedObjects.manage", "okta.linkedObjects.read",
 	"okta.logs.read",
+	"okta.networkZones.manage", "okta.networkZones.read",
+	"okta.orgs.manage", "okta.orgs.read",
+	"okta.profileMappings.manage", "okta.profileMappings.read",
 	"okta.roles.manage", "okta.roles.read",
 	"okta.schemas.manage", "okta.schemas.read",
 	"okta.sessions.manage", "okta.sessions.read",
 	"okta.templates.manage", "okta.templates.read",
 	"okta.trustedOrigins.manage", "okta.trustedOrigins.read",
+	"okta.userTypes.manage", "okta.userTypes.read",
 	"okta.users.manage", "okta.users.read", "okta.users.manage.self", "okta.users.read.self",
 	"okta.policies.manage", "okta.policies.read",
 }
@@ -39,7 +50,7 @@ func resourceAppOAuthAPIScope() *schema.Resource {
 		DeleteContext: resourceAppOAuthAPIScopeDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				scopes, _, err := getOktaClientFromMetadata(m).Application.ListScopeConsentGrants(ctx, d.Id(), nil)
+				scopes, err := listOAuthApiScopes(ctx, m, d.Id())
 				if err != nil {
 					return nil, err
 				}
@@ -66,12 +77,13 @@ func resourceAppOAuthAPIScope() *schema.Resource {
 				ForceNew:    true,
 			},
 			"issuer": {
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Type:        schema.TypeString,
-				Description: "The issuer of your Org Authorization Server, your Org URL.",
+				Description: "The issuer of your Org Authorization Server, your Org URL. By default, it's the URL of the org the provider is configured with.",
 			},
 			"scopes": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Required: true,
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
@@ -84,12 +96,8 @@ func resourceAppOAuthAPIScope() *schema.Resource {
 }
 
 func resourceAppOAuthAPIScopeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	scopes := make([]string, 0)
-
-	for _, scope := range d.Get("scopes").([]interface{}) {
-		scopes = append(scopes, scope.(string))
-	}
-	grantScopeList := getOAuthApiScopeList(scopes, d.Get("issuer").(string))
+	scopes := convertInterfaceToStringSet(d.Get("scopes"))
+	grantScopeList := getOAuthApiScopeList(scopes, oauthAPIScopeIssuer(d, m))
 	err := grantOAuthApiScopes(ctx, d, m, grantScopeList)
 	if err != nil {
 		return diag.Errorf("failed to create application scope consent grant: %v", err)
@@ -99,12 +107,12 @@ func resourceAppOAuthAPIScopeCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAppOAuthAPIScopeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	scopes, _, err := getOktaClientFromMetadata(m).Application.ListScopeConsentGrants(ctx, d.Get("app_id").(string), nil)
+	scopes, err := listOAuthApiScopes(ctx, m, d.Get("app_id").(string))
 	if err != nil {
-		return diag.Errorf("failed to get application scope consent grants: %v", err)
+		return diag.FromErr(err)
 	}
 
-	if scopes == nil {
+	if len(scopes) == 0 {
 		d.SetId("")
 		return nil
 	}
@@ -118,13 +126,13 @@ func resourceAppOAuthAPIScopeRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAppOAuthAPIScopeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	scopes, _, err := getOktaClientFromMetadata(m).Application.ListScopeConsentGrants(ctx, d.Get("app_id").(string), nil)
+	scopes, err := listOAuthApiScopes(ctx, m, d.Get("app_id").(string))
 	if err != nil {
-		return diag.Errorf("failed to get application scope consent grants: %v", err)
+		return diag.FromErr(err)
 	}
 
 	grantList, revokeList := getOAuthApiScopeUpdateLists(d, scopes)
-	grantScopeList := getOAuthApiScopeList(grantList, d.Get("issuer").(string))
+	grantScopeList := getOAuthApiScopeList(grantList, oauthAPIScopeIssuer(d, m))
 	err = grantOAuthApiScopes(ctx, d, m, grantScopeList)
 	if err != nil {
 		return diag.Errorf("failed to create application scope consent grant: %v", err)
@@ -137,7 +145,9 @@ func resourceAppOAuthAPIScopeUpdate(ctx context.Context, d *schema.ResourceData,
 
 	revokeListIds := make([]string, 0)
 	for _, scope := range revokeList {
-		revokeListIds = append(revokeListIds, scopeMap[scope])
+		if id, ok := scopeMap[scope]; ok {
+			revokeListIds = append(revokeListIds, id)
+		}
 	}
 	err = revokeOAuthApiScope(ctx, d, m, revokeListIds)
 	if err != nil {
@@ -154,8 +164,10 @@ func resourceAppOAuthAPIScopeDelete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	revokeListIds := make([]string, 0)
-	for _, scope := range d.Get("scopes").([]interface{}) {
-		revokeListIds = append(revokeListIds, scopeMap[scope.(string)])
+	for _, scope := range convertInterfaceToStringSet(d.Get("scopes")) {
+		if id, ok := scopeMap[scope]; ok {
+			revokeListIds = append(revokeListIds, id)
+		}
 	}
 	err = revokeOAuthApiScope(ctx, d, m, revokeListIds)
 	if err != nil {
@@ -166,6 +178,31 @@ func resourceAppOAuthAPIScopeDelete(ctx context.Context, d *schema.ResourceData,
 }
 
 // Resource Helpers
+// oauthAPIScopeIssuer returns the configured issuer, or the URL of the org
+func oauthAPIScopeIssuer(d *schema.ResourceData, m interface{}) string {
+	if issuer, ok := d.GetOk("issuer"); ok {
+		return issuer.(string)
+	}
+	return getOktaClientFromMetadata(m).GetConfig().Okta.Client.OrgUrl
+}
+
+// Fetches all the scopes granted to the application, returns empty list if the application does not exist.
+func listOAuthApiScopes(ctx context.Context, m interface{}, appID string) ([]*okta.OAuth2ScopeConsentGrant, error) {
+	scopes, resp, err := getOktaClientFromMetadata(m).Application.ListScopeConsentGrants(ctx, appID, nil)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to get application scope consent grants: %v", err)
+	}
+	for resp != nil && resp.HasNextPage() {
+		var nextScopes []*okta.OAuth2ScopeConsentGrant
+		resp, err = resp.Next(ctx, &nextScopes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get application scope consent grants: %v", err)
+		}
+		scopes = append(scopes, nextScopes...)
+	}
+	return scopes, nil
+}
+
 // Creates a new OAuth2ScopeConsentGrant struct
 func newOAuthApiScope(scopeId, issuer string) *okta.OAuth2ScopeConsentGrant {
 	return &okta.OAuth2ScopeConsentGrant{
@@ -186,9 +223,9 @@ func getOAuthApiScopeList(scopeIds []string, issuer string) []*okta.OAuth2ScopeC
 // Fetches current granted application scopes and returns a map with names and IDs.
 func getOAuthApiScopeIdMap(ctx context.Context, d *schema.ResourceData, m interface{}) (map[string]string, error) {
 	result := make(map[string]string)
-	currentScopes, resp, err := getOktaClientFromMetadata(m).Application.ListScopeConsentGrants(ctx, d.Get("app_id").(string), nil)
-	if err := suppressErrorOn404(resp, err); err != nil {
-		return nil, fmt.Errorf("failed to get application scope consent grants: %v", err)
+	currentScopes, err := listOAuthApiScopes(ctx, m, d.Get("app_id").(string))
+	if err != nil {
+		return nil, err
 	}
 	for _, currentScope := range currentScopes {
 		result[currentScope.ScopeId] = currentScope.Id
@@ -203,8 +240,11 @@ func setOAuthApiScopes(d *schema.ResourceData, to []*okta.OAuth2ScopeConsentGran
 		scopes[i] = scope.ScopeId
 	}
 	d.SetId(d.Get("app_id").(string))
-	_ = d.Set("issuer", d.Get("issuer").(string))
-	_ = d.Set("scopes", scopes)
+	if len(to) > 0 {
+		// Assume issuer is the same for all granted scopes, taking the first
+		_ = d.Set("issuer", to[0].Issuer)
+	}
+	_ = d.Set("scopes", convertStringSetToInterface(scopes))
 	return nil
 }
 
@@ -232,14 +272,9 @@ func revokeOAuthApiScope(ctx context.Context, d *schema.ResourceData, m interfac
 
 // Diff function to identify which scope needs to be added or removed to the application
 func getOAuthApiScopeUpdateLists(d *schema.ResourceData, from []*okta.OAuth2ScopeConsentGrant) (grantList, revokeList []string) {
-	desiredScopes := make([]string, 0)
+	desiredScopes := convertInterfaceToStringSet(d.Get("scopes"))
 	currentScopes := make([]string, 0)
 
-	// cast list of interface{} to strings
-	for _, scope := range d.Get("scopes").([]interface{}) {
-		desiredScopes = append(desiredScopes, scope.(string))
-	}
-
 	// extract scope list form []okta.OAuth2ScopeConsentGrant
 	for _, currentScope := range from {
 		currentScopes = append(currentScopes, currentScope.ScopeId)
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func resourceAppOAuthRedirectURI() *schema.Resource {
@@ -57,17 +58,9 @@ func resourceAppOAuthRedirectURIUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAppOAuthRedirectURIDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	appID := d.Get("app_id").(string)
-	app := okta.NewOpenIdConnectApplication()
-	err := fetchAppByID(ctx, appID, m, app)
-	if err != nil {
-		return diag.Errorf("failed to get application: %v", err)
-	}
-	if app.Id == "" || contains(app.Settings.OauthClient.RedirectUris, d.Id()) {
-		return diag.Errorf("application with id %s does not exist", appID)
-	}
-	app.Settings.OauthClient.RedirectUris = remove(app.Settings.OauthClient.RedirectUris, d.Id())
-	err = updateAppByID(ctx, appID, m, app)
+	err := updateRedirectURIs(ctx, d, m, func(uris []string) []string {
+		return remove(uris, d.Id())
+	})
 	if err != nil {
 		return diag.Errorf("failed to delete redirect URI: %v", err)
 	}
@@ -75,19 +68,38 @@ func resourceAppOAuthRedirectURIDelete(ctx context.Context, d *schema.ResourceDa
 }
 
 func appendRedirectURI(ctx context.Context, d *schema.ResourceData, m interface{}) error {
-	appID := d.Get("app_id").(string)
-	app := okta.NewOpenIdConnectApplication()
-	if err := fetchAppByID(ctx, appID, m, app); err != nil {
-		return err
-	}
-	if app.Id == "" {
-		return fmt.Errorf("application with id %s does not exist", appID)
-	}
-	if contains(app.Settings.OauthClient.RedirectUris, d.Id()) {
-		logger(m).Info(fmt.Sprintf("application with appID %s already has redirect URI %s", appID, d.Id()))
-		return nil
-	}
 	uri := d.Get("uri").(string)
-	app.Settings.OauthClient.RedirectUris = append(app.Settings.OauthClient.RedirectUris, uri)
-	return updateAppByID(ctx, appID, m, app)
+	return updateRedirectURIs(ctx, d, m, func(uris []string) []string {
+		// previous URI is removed, when it's changed
+		if d.Id() != "" && d.Id() != uri {
+			uris = remove(uris, d.Id())
+		}
+		if contains(uris, uri) {
+			logger(m).Info(fmt.Sprintf("application with appID %s already has redirect URI %s", d.Get("app_id").(string), uri))
+			return uris
+		}
+		return append(uris, uri)
+	})
+}
+
+// updateRedirectURIs modifies the redirect URIs of the latest version of the application. The application is read again,
+// when the update is rejected because of the concurrent modification, so the redirect URIs added by the other resources
+// in parallel are kept.
+func updateRedirectURIs(ctx context.Context, d *schema.ResourceData, m interface{}, modify func([]string) []string) error {
+	appID := d.Get("app_id").(string)
+	client := getOktaClientFromMetadata(m)
+	return retryOnConflict(ctx, func(bool) (*okta.Response, error) {
+		// the wrapper keeps the settings, which are not supported by the official SDK, e.g. the wildcard redirect
+		oauthApp := sdk.NewOpenIdConnectApplication(nil)
+		_, resp, err := client.Application.GetApplication(ctx, appID, oauthApp, nil)
+		if is404(resp) {
+			return nil, fmt.Errorf("application with id %s does not exist", appID)
+		}
+		if err != nil {
+			return resp, err
+		}
+		oauthApp.Settings.OauthClient.RedirectUris = modify(oauthApp.Settings.OauthClient.RedirectUris)
+		_, resp, err = client.Application.UpdateApplication(ctx, appID, oauthApp)
+		return resp, err
+	})
 }
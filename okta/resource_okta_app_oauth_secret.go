@@ -0,0 +1,289 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceAppOAuthSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppOAuthSecretCreate,
+		ReadContext:   resourceAppOAuthSecretRead,
+		UpdateContext: resourceAppOAuthSecretUpdate,
+		DeleteContext: resourceAppOAuthSecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				secrets, _, err := getSupplementFromMetadata(m).ListClientSecretsForApplication(ctx, d.Id())
+				if err != nil {
+					return nil, err
+				}
+				current := latestActiveClientSecret(secrets)
+				if current == nil {
+					return nil, fmt.Errorf("application with id %s does not have active client secrets", d.Id())
+				}
+				_ = d.Set("app_id", d.Id())
+				_ = d.Set("retain_previous", true)
+				_ = d.Set("revoke_unmanaged", false)
+				_ = d.Set("secret_id", current.Id)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the OAuth application.",
+			},
+			"rotate_secret_on": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value, any change of which generates a new client secret.",
+			},
+			"retain_previous": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Keep the previous client secret active after the rotation, so the consumers can roll to the new one without downtime. Set to false to deactivate and remove the previous secret.",
+			},
+			"revoke_unmanaged": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Deactivate and remove the client secrets, which are not managed by this resource, during the rotation. The latest active one becomes the previous secret on creation.",
+			},
+			"secret_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the current client secret.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Current client secret.",
+			},
+			"previous_secret_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the previous client secret, which is still active.",
+			},
+			"previous_client_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Previous client secret, which is still active.",
+			},
+			"secrets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All the client secrets of the application.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAppOAuthSecretCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := rotateAppOAuthSecret(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to create client secret: %v", err)
+	}
+	d.SetId(d.Get("app_id").(string))
+	return resourceAppOAuthSecretRead(ctx, d, m)
+}
+
+func resourceAppOAuthSecretRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	secrets, resp, err := getSupplementFromMetadata(m).ListClientSecretsForApplication(ctx, d.Get("app_id").(string))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to list client secrets: %v", err)
+	}
+	current := findClientSecret(secrets, d.Get("secret_id").(string))
+	if current == nil || current.Status != statusActive {
+		// the secret was removed or deactivated outside of Terraform, so a new one should be generated
+		d.SetId("")
+		return nil
+	}
+	if current.ClientSecret != "" {
+		_ = d.Set("client_secret", current.ClientSecret)
+	}
+	previous := findClientSecret(secrets, d.Get("previous_secret_id").(string))
+	if previous == nil || previous.Status != statusActive {
+		_ = d.Set("previous_secret_id", "")
+		_ = d.Set("previous_client_secret", "")
+	} else if previous.ClientSecret != "" {
+		_ = d.Set("previous_client_secret", previous.ClientSecret)
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"secrets": flattenClientSecrets(secrets),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set client secrets: %v", err)
+	}
+	return nil
+}
+
+func resourceAppOAuthSecretUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("rotate_secret_on") {
+		if err := rotateAppOAuthSecret(ctx, d, m); err != nil {
+			return diag.Errorf("failed to rotate client secret: %v", err)
+		}
+	} else if d.HasChange("retain_previous") && !d.Get("retain_previous").(bool) {
+		if err := removeClientSecret(ctx, m, d.Get("app_id").(string), d.Get("previous_secret_id").(string)); err != nil {
+			return diag.Errorf("failed to remove previous client secret: %v", err)
+		}
+		_ = d.Set("previous_secret_id", "")
+		_ = d.Set("previous_client_secret", "")
+	}
+	return resourceAppOAuthSecretRead(ctx, d, m)
+}
+
+// Client secrets are not removed on destroy, since the application can't stay without an active secret.
+func resourceAppOAuthSecretDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+// rotateAppOAuthSecret generates a new client secret. The secret that was current before the rotation becomes the
+// previous one (or is removed if it should not be retained), and the secret that was previous before is removed, since
+// Okta allows only two secrets per application. The secrets, which are not managed by this resource, are removed only
+// with 'revoke_unmanaged'.
+func rotateAppOAuthSecret(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	appID := d.Get("app_id").(string)
+	client := getSupplementFromMetadata(m)
+	secrets, _, err := client.ListClientSecretsForApplication(ctx, appID)
+	if err != nil {
+		return fmt.Errorf("failed to list client secrets: %v", err)
+	}
+	revokeUnmanaged := d.Get("revoke_unmanaged").(bool)
+	current := findClientSecret(secrets, d.Get("secret_id").(string))
+	if current == nil && revokeUnmanaged {
+		current = latestActiveClientSecret(secrets)
+	}
+	managed := []string{d.Get("secret_id").(string), d.Get("previous_secret_id").(string)}
+	for _, id := range clientSecretsToRemove(secrets, current, managed, revokeUnmanaged) {
+		if err := removeClientSecret(ctx, m, appID, id); err != nil {
+			return err
+		}
+	}
+	secret, _, err := client.CreateNewClientSecretForApplication(ctx, appID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to generate new client secret: %v", err)
+	}
+	retain := d.Get("retain_previous").(bool)
+	if !retain && current != nil {
+		// the new secret is active at this point, so the application is never left without one
+		if err := removeClientSecret(ctx, m, appID, current.Id); err != nil {
+			return err
+		}
+	}
+	if retain && current != nil {
+		_ = d.Set("previous_secret_id", current.Id)
+		_ = d.Set("previous_client_secret", d.Get("client_secret").(string))
+		if current.ClientSecret != "" {
+			_ = d.Set("previous_client_secret", current.ClientSecret)
+		}
+	} else {
+		_ = d.Set("previous_secret_id", "")
+		_ = d.Set("previous_client_secret", "")
+	}
+	_ = d.Set("secret_id", secret.Id)
+	_ = d.Set("client_secret", secret.ClientSecret)
+	return nil
+}
+
+// clientSecretsToRemove returns the IDs of the secrets, which are removed before the rotation: all the secrets managed
+// by the resource except the current one, and the unmanaged ones if they should be revoked.
+func clientSecretsToRemove(secrets []*sdk.ClientSecret, current *sdk.ClientSecret, managed []string, revokeUnmanaged bool) []string {
+	var ids []string
+	for _, secret := range secrets {
+		if current != nil && secret.Id == current.Id {
+			continue
+		}
+		if revokeUnmanaged || contains(managed, secret.Id) {
+			ids = append(ids, secret.Id)
+		}
+	}
+	return ids
+}
+
+func removeClientSecret(ctx context.Context, m interface{}, appID, secretID string) error {
+	if secretID == "" {
+		return nil
+	}
+	client := getSupplementFromMetadata(m)
+	_, resp, err := client.DeactivateClientSecretForApplication(ctx, appID, secretID)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return fmt.Errorf("failed to deactivate client secret: %v", err)
+	}
+	resp, err = client.DeleteClientSecretForApplication(ctx, appID, secretID)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return fmt.Errorf("failed to delete client secret: %v", err)
+	}
+	return nil
+}
+
+func findClientSecret(secrets []*sdk.ClientSecret, id string) *sdk.ClientSecret {
+	if id == "" {
+		return nil
+	}
+	for _, secret := range secrets {
+		if secret.Id == id {
+			return secret
+		}
+	}
+	return nil
+}
+
+func latestActiveClientSecret(secrets []*sdk.ClientSecret) *sdk.ClientSecret {
+	var latest *sdk.ClientSecret
+	for _, secret := range secrets {
+		if secret.Status != statusActive {
+			continue
+		}
+		if latest == nil || (secret.Created != nil && latest.Created != nil && secret.Created.After(*latest.Created)) {
+			latest = secret
+		}
+	}
+	return latest
+}
+
+func flattenClientSecrets(secrets []*sdk.ClientSecret) []interface{} {
+	arr := make([]interface{}, len(secrets))
+	for i, secret := range secrets {
+		var created string
+		if secret.Created != nil {
+			created = secret.Created.Format(time.RFC3339)
+		}
+		arr[i] = map[string]interface{}{
+			"id":          secret.Id,
+			"status":      secret.Status,
+			"secret_hash": secret.SecretHash,
+			"created":     created,
+		}
+	}
+	return arr
+}
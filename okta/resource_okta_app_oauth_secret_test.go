@@ -0,0 +1,73 @@
+package okta
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func TestClientSecretsToRemove(t *testing.T) {
+	secrets := []*sdk.ClientSecret{{Id: "current"}, {Id: "previous"}, {Id: "unmanaged"}}
+	managed := []string{"current", "previous"}
+	for _, tc := range []struct {
+		revokeUnmanaged bool
+		expected        []string
+	}{
+		{false, []string{"previous"}},
+		{true, []string{"previous", "unmanaged"}},
+	} {
+		actual := clientSecretsToRemove(secrets, secrets[0], managed, tc.revokeUnmanaged)
+		if strings.Join(actual, ",") != strings.Join(tc.expected, ",") {
+			t.Errorf("expected %v to be removed with revoke_unmanaged=%t, got %v", tc.expected, tc.revokeUnmanaged, actual)
+		}
+	}
+}
+
+func TestAccAppOAuthSecret_rotation(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuthSecret)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	rotated := mgr.GetFixtures("rotated.tf", ri, t)
+	rotationFinished := mgr.GetFixtures("rotation_finished.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOAuthSecret)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "secret_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_secret"),
+					resource.TestCheckResourceAttrSet(resourceName, "previous_secret_id"),
+					resource.TestCheckResourceAttr(resourceName, "secrets.#", "2"),
+				),
+			},
+			{
+				Config: rotated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "secret_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_secret"),
+					resource.TestCheckResourceAttrSet(resourceName, "previous_secret_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "previous_client_secret"),
+					resource.TestCheckResourceAttr(resourceName, "secrets.#", "2"),
+				),
+			},
+			{
+				Config: rotationFinished,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "secret_id"),
+					resource.TestCheckResourceAttr(resourceName, "previous_secret_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "secrets.#", "1"),
+				),
+			},
+		},
+	})
+}
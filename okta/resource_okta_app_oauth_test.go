@@ -111,6 +111,33 @@ func TestAccAppOauth_serviceNative(t *testing.T) {
 	})
 }
 
+func TestAccAppOauth_nativeAppLinks(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuth)
+	config := mgr.GetFixtures("native_app_links.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOAuth)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "type", "native"),
+					resource.TestCheckResourceAttr(resourceName, "issuer_mode", "ORG_URL"),
+					resource.TestCheckResourceAttr(resourceName, "consent_method", "REQUIRED"),
+					resource.TestCheckResourceAttr(resourceName, "hide_ios", "false"),
+					resource.TestCheckResourceAttr(resourceName, "hide_web", "true"),
+					resource.TestCheckResourceAttr(resourceName, "app_links_json", `{"oidc_client_link":true}`),
+				),
+			},
+		},
+	})
+}
+
 // Tests creation of service app and updates it to turn on federated broker
 func TestAccAppOauth_federationBroker(t *testing.T) {
 	// TODO: This is an "Early Access Feature" and needs to be enabled by Okta
@@ -154,6 +181,84 @@ func TestAccAppOauth_federationBroker(t *testing.T) {
 	})
 }
 
+func TestAccAppOauth_idpInitiatedLogin(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuth)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("idp_initiated_login.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOAuth)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "login_mode", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "login_scopes.#", "0"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "login_uri", "http://d.com/login"),
+					resource.TestCheckResourceAttr(resourceName, "login_mode", "OKTA"),
+					resource.TestCheckResourceAttr(resourceName, "login_scopes.#", "2"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "login_mode", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppOauth_wildcardRedirect(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuth)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("wildcard_redirect.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOAuth)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "wildcard_redirect", "DISABLED"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "wildcard_redirect", "SUBDOMAIN"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_uris.#", "1"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "wildcard_redirect", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
 // Tests properly errors on conditional requirements.
 func TestAccAppOauth_badGrantTypes(t *testing.T) {
 	ri := acctest.RandInt()
@@ -293,6 +398,79 @@ func TestAccAppOauth_serviceWithJWKS(t *testing.T) {
 	})
 }
 
+func TestAccAppOauth_grantedScopes(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuth)
+	config := mgr.GetFixtures("granted_scopes.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("granted_scopes_updated.tf", ri, t)
+	revokedConfig := mgr.GetFixtures("granted_scopes_revoked.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOAuth)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "granted_scopes.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "granted_scopes.*", "okta.users.read"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "granted_scopes.*", "okta.groups.read"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "granted_scopes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "granted_scopes.*", "okta.users.read"),
+				),
+			},
+			{
+				Config: revokedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "granted_scopes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppOauth_refreshToken(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuth)
+	config := mgr.GetFixtures("refresh_token.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("refresh_token_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOAuth)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "refresh_token_rotation", "ROTATE"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_token_leeway", "45"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "refresh_token_rotation", "STATIC"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_token_leeway", "10"),
+				),
+			},
+		},
+	})
+}
+
 func createDoesAppExist(app okta.App) func(string) (bool, error) {
 	return func(id string) (bool, error) {
 		client := getOktaClientFromMetadata(testAccProvider.Meta())
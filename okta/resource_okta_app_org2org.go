@@ -0,0 +1,304 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+const org2orgAppName = "okta_org2org"
+
+func resourceAppOrg2Org() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppOrg2OrgCreate,
+		ReadContext:   resourceAppOrg2OrgRead,
+		UpdateContext: resourceAppOrg2OrgUpdate,
+		DeleteContext: resourceAppOrg2OrgDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: appImporter,
+		},
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			// the connection, which was disabled outside of Terraform, is established again with the configured token
+			if d.Id() != "" && d.Get("connection_status").(string) == sdk.AppFeatureStatusDisabled &&
+				d.NewValueKnown("api_token") && d.Get("api_token").(string) != "" {
+				return d.SetNewComputed("connection_status")
+			}
+			return nil
+		},
+		Schema: buildAppSchemaWithVisibility(map[string]*schema.Schema{
+			"base_url": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Base URL of the spoke org, e.g. 'https://spoke.okta.com'",
+				ValidateDiagFunc: stringIsURL(validURLSchemes...),
+			},
+			"acs_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Assertion Consumer Service URL of the SAML IdP in the spoke org",
+				ValidateDiagFunc: stringIsURL(validURLSchemes...),
+			},
+			"audience_restriction": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Audience URI of the SAML IdP in the spoke org",
+			},
+			"api_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "API token of the spoke org, which is used for provisioning",
+			},
+			"provisioning_create_users": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Create users in the spoke org, when they are assigned to the app",
+			},
+			"provisioning_update_profiles": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Update the profiles of users in the spoke org, when they change in the hub org",
+			},
+			"provisioning_deactivate_users": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Deactivate users in the spoke org, when they are unassigned from the app",
+			},
+			"provisioning_sync_password": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Sync the passwords of users from the hub org to the spoke org",
+			},
+			"connection_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the provisioning connection to the spoke org",
+			},
+		}),
+	}
+}
+
+func resourceAppOrg2OrgCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	app := buildAppOrg2Org(d)
+	activate := d.Get("status").(string) == statusActive
+	params := &query.Params{Activate: &activate}
+	_, _, err := client.Application.CreateApplication(ctx, app, params)
+	if err != nil {
+		return diag.Errorf("failed to create Org2Org application: %v", err)
+	}
+	d.SetId(app.Id)
+	err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+	if err != nil {
+		return diag.Errorf("failed to handle groups and users for Org2Org application: %v", err)
+	}
+	err = handleAppLogo(ctx, d, m, app.Id, app.Links)
+	if err != nil {
+		return diag.Errorf("failed to upload logo for Org2Org application: %v", err)
+	}
+	err = setOrg2OrgProvisioning(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to set provisioning for Org2Org application: %v", err)
+	}
+	return resourceAppOrg2OrgRead(ctx, d, m)
+}
+
+func resourceAppOrg2OrgRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	app := okta.NewSamlApplication()
+	err := fetchApp(ctx, d, m, app)
+	if err != nil {
+		return diag.Errorf("failed to get Org2Org application: %v", err)
+	}
+	if app.Id == "" {
+		d.SetId("")
+		return nil
+	}
+	if app.Settings != nil && app.Settings.App != nil {
+		settings := *app.Settings.App
+		_ = d.Set("base_url", settings["baseUrl"])
+		_ = d.Set("acs_url", settings["acsUrl"])
+		_ = d.Set("audience_restriction", settings["audRestriction"])
+	}
+	_ = d.Set("name", app.Name)
+	_ = d.Set("status", app.Status)
+	_ = d.Set("sign_on_mode", app.SignOnMode)
+	_ = d.Set("label", app.Label)
+	_ = d.Set("auto_submit_toolbar", app.Visibility.AutoSubmitToolbar)
+	_ = d.Set("hide_ios", app.Visibility.Hide.IOS)
+	_ = d.Set("hide_web", app.Visibility.Hide.Web)
+	setAppLinks(d, app.Visibility.AppLinks)
+	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
+	err = syncGroupsAndUsers(ctx, app.Id, d, m)
+	if err != nil {
+		return diag.Errorf("failed to sync groups and users for Org2Org application: %v", err)
+	}
+	err = syncOrg2OrgProvisioning(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to get provisioning settings for Org2Org application: %v", err)
+	}
+	return nil
+}
+
+func resourceAppOrg2OrgUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	app := buildAppOrg2Org(d)
+	_, _, err := client.Application.UpdateApplication(ctx, d.Id(), app)
+	if err != nil {
+		return diag.Errorf("failed to update Org2Org application: %v", err)
+	}
+	err = setAppStatus(ctx, d, client, app.Status)
+	if err != nil {
+		return diag.Errorf("failed to set Org2Org application status: %v", err)
+	}
+	err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+	if err != nil {
+		return diag.Errorf("failed to handle groups and users for Org2Org application: %v", err)
+	}
+	if d.HasChange("logo") {
+		err = handleAppLogo(ctx, d, m, app.Id, app.Links)
+		if err != nil {
+			o, _ := d.GetChange("logo")
+			_ = d.Set("logo", o)
+			return diag.Errorf("failed to upload logo for Org2Org application: %v", err)
+		}
+	}
+	if d.HasChanges("base_url", "api_token", "connection_status", "provisioning_create_users",
+		"provisioning_update_profiles", "provisioning_deactivate_users", "provisioning_sync_password") {
+		err = setOrg2OrgProvisioning(ctx, d, m)
+		if err != nil {
+			return diag.Errorf("failed to set provisioning for Org2Org application: %v", err)
+		}
+	}
+	return resourceAppOrg2OrgRead(ctx, d, m)
+}
+
+func resourceAppOrg2OrgDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := deleteApplication(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to delete Org2Org application: %v", err)
+	}
+	return nil
+}
+
+func buildAppOrg2Org(d *schema.ResourceData) *okta.SamlApplication {
+	app := okta.NewSamlApplication()
+	app.Name = org2orgAppName
+	app.Label = d.Get("label").(string)
+	settings := okta.ApplicationSettingsApplication{
+		"baseUrl": d.Get("base_url").(string),
+	}
+	if acsURL, ok := d.GetOk("acs_url"); ok {
+		settings["acsUrl"] = acsURL.(string)
+	}
+	if audience, ok := d.GetOk("audience_restriction"); ok {
+		settings["audRestriction"] = audience.(string)
+	}
+	app.Settings = okta.NewSamlApplicationSettings()
+	app.Settings.App = &settings
+	app.Visibility = buildVisibility(d)
+	return app
+}
+
+// setOrg2OrgProvisioning configures the provisioning connection with the API token of the spoke org, and the
+// provisioning features, which can be enabled only after the connection is established. Provisioning is disabled,
+// when the API token is removed.
+func setOrg2OrgProvisioning(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	client := getSupplementFromMetadata(m)
+	token := d.Get("api_token").(string)
+	if token == "" {
+		if d.IsNewResource() {
+			return nil
+		}
+		resp, err := client.DeactivateDefaultAppConnection(ctx, d.Id())
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return fmt.Errorf("failed to deactivate provisioning connection: %v", err)
+		}
+		return nil
+	}
+	activate := true
+	connection := sdk.AppConnection{
+		BaseUrl: d.Get("base_url").(string),
+		Profile: &sdk.AppConnectionProfile{
+			AuthScheme: sdk.AppConnectionAuthSchemeToken,
+			Token:      token,
+		},
+	}
+	_, _, err := client.SetDefaultAppConnection(ctx, d.Id(), connection, &query.Params{Activate: &activate})
+	if err != nil {
+		return fmt.Errorf("failed to set provisioning connection: %v", err)
+	}
+	_, _, err = client.UpdateAppFeature(ctx, d.Id(), sdk.AppFeatureUserProvisioning, sdk.AppFeatureCapabilities{
+		Create: &sdk.AppFeatureCreate{
+			LifecycleCreate: &sdk.AppFeatureStatus{Status: appFeatureStatus(d, "provisioning_create_users")},
+		},
+		Update: &sdk.AppFeatureUpdate{
+			LifecycleDeactivate: &sdk.AppFeatureStatus{Status: appFeatureStatus(d, "provisioning_deactivate_users")},
+			Profile:             &sdk.AppFeatureStatus{Status: appFeatureStatus(d, "provisioning_update_profiles")},
+			Password:            &sdk.AppFeaturePassword{Status: appFeatureStatus(d, "provisioning_sync_password")},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update provisioning features: %v", err)
+	}
+	return nil
+}
+
+func syncOrg2OrgProvisioning(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	client := getSupplementFromMetadata(m)
+	connection, resp, err := client.GetDefaultAppConnection(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return fmt.Errorf("failed to get provisioning connection: %v", err)
+	}
+	if connection == nil || connection.Status != sdk.AppFeatureStatusEnabled {
+		// nothing is provisioned without the connection
+		_ = d.Set("connection_status", sdk.AppFeatureStatusDisabled)
+		for _, k := range []string{
+			"provisioning_create_users", "provisioning_update_profiles", "provisioning_deactivate_users",
+			"provisioning_sync_password",
+		} {
+			_ = d.Set(k, false)
+		}
+		return nil
+	}
+	_ = d.Set("connection_status", connection.Status)
+	feature, resp, err := client.GetAppFeature(ctx, d.Id(), sdk.AppFeatureUserProvisioning)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return fmt.Errorf("failed to get provisioning features: %v", err)
+	}
+	if feature == nil || feature.Capabilities == nil {
+		return nil
+	}
+	if c := feature.Capabilities.Create; c != nil && c.LifecycleCreate != nil {
+		_ = d.Set("provisioning_create_users", c.LifecycleCreate.Status == sdk.AppFeatureStatusEnabled)
+	}
+	if u := feature.Capabilities.Update; u != nil {
+		if u.LifecycleDeactivate != nil {
+			_ = d.Set("provisioning_deactivate_users", u.LifecycleDeactivate.Status == sdk.AppFeatureStatusEnabled)
+		}
+		if u.Profile != nil {
+			_ = d.Set("provisioning_update_profiles", u.Profile.Status == sdk.AppFeatureStatusEnabled)
+		}
+		if u.Password != nil {
+			_ = d.Set("provisioning_sync_password", u.Password.Status == sdk.AppFeatureStatusEnabled)
+		}
+	}
+	return nil
+}
+
+func appFeatureStatus(d *schema.ResourceData, key string) string {
+	if d.Get(key).(bool) {
+		return sdk.AppFeatureStatusEnabled
+	}
+	return sdk.AppFeatureStatusDisabled
+}
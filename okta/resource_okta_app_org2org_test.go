@@ -0,0 +1,83 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// TestAppOrg2OrgDisabledConnectionDiff verifies that the connection disabled outside of Terraform is established again,
+// when the API token is configured.
+func TestAppOrg2OrgDisabledConnectionDiff(t *testing.T) {
+	r := resourceAppOrg2Org()
+	for _, tc := range []struct {
+		token string
+		diff  bool
+	}{
+		{"token", true},
+		{"", false},
+	} {
+		raw := map[string]interface{}{"label": "test", "base_url": "https://spoke.okta.com"}
+		state := map[string]interface{}{"label": "test", "base_url": "https://spoke.okta.com"}
+		if tc.token != "" {
+			raw["api_token"] = tc.token
+			state["api_token"] = tc.token
+		}
+		d := schema.TestResourceDataRaw(t, r.Schema, state)
+		d.SetId("0oa1")
+		_ = d.Set("connection_status", "DISABLED")
+		diff, err := r.SimpleDiff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), nil)
+		if err != nil {
+			t.Fatalf("failed to diff Org2Org application: %v", err)
+		}
+		changed := diff != nil && diff.Attributes["connection_status"] != nil && diff.Attributes["connection_status"].NewComputed
+		if changed != tc.diff {
+			t.Errorf("expected the connection status change to be %t with token '%s', got %+v", tc.diff, tc.token, diff)
+		}
+	}
+}
+
+func TestAccAppOrg2OrgApplication_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOrg2Org)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOrg2Org)
+	baseURL := fmt.Sprintf("https://testAcc-%d.okta.com", ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appOrg2Org, createDoesAppExist(okta.NewSamlApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewSamlApplication())),
+					resource.TestCheckResourceAttr(resourceName, "label", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "name", org2orgAppName),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "base_url", baseURL),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", "DISABLED"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewSamlApplication())),
+					resource.TestCheckResourceAttr(resourceName, "label", buildResourceName(ri)+" Updated"),
+					resource.TestCheckResourceAttr(resourceName, "base_url", baseURL),
+					resource.TestCheckResourceAttr(resourceName, "acs_url", baseURL+"/sso/saml2/0oa1234567890abcdef"),
+					resource.TestCheckResourceAttr(resourceName, "audience_restriction", "https://www.okta.com/saml2/service-provider/spaaaaaaaaaaaaaaaaaa"),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+				),
+			},
+		},
+	})
+}
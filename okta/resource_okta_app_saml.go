@@ -2,19 +2,24 @@ package okta
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"regexp/syntax"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 const (
 	postBinding     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
 	redirectBinding = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+
+	samlNamespaceUnspecified = "urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified"
 )
 
 // Fields required if preconfigured_app is not provided
@@ -31,14 +36,16 @@ var customAppSamlRequiredFields = []string{
 }
 
 func resourceAppSaml() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceAppSamlCreate,
 		ReadContext:   resourceAppSamlRead,
 		UpdateContext: resourceAppSamlUpdate,
 		DeleteContext: resourceAppSamlDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: appImporter,
 		},
+		CustomizeDiff: customdiff.All(validatePreconfiguredAppSettings, validateAppSamlAttributeStatements, validateAppSamlSingleLogout,
+			setSamlMetadataNewComputed),
 		// For those familiar with Terraform schemas be sure to check the base application schema and/or
 		// the examples in the documentation
 		Schema: buildAppSchema(map[string]*schema.Schema{
@@ -62,6 +69,33 @@ func resourceAppSaml() *schema.Resource {
 				Description: "Certificate ID",
 				Computed:    true,
 			},
+			"active_key_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the certificate, which is currently used for signing",
+				Computed:    true,
+			},
+			"next_key_name": {
+				Type:         schema.TypeString,
+				Description:  "Name of the staged certificate. New name == new staged key, which is not used for signing until 'activate_next_key' is set.",
+				Optional:     true,
+				RequiredWith: []string{"key_years_valid"},
+			},
+			"next_key_id": {
+				Type:        schema.TypeString,
+				Description: "Staged certificate ID",
+				Computed:    true,
+			},
+			"next_key_certificate": {
+				Type:        schema.TypeString,
+				Description: "Staged certificate, which should be shared with the Service Provider before the activation",
+				Computed:    true,
+			},
+			"activate_next_key": {
+				Type:        schema.TypeBool,
+				Description: "Sign with the staged certificate instead of the current one",
+				Optional:    true,
+				Default:     false,
+			},
 			"key_years_valid": {
 				Type:             schema.TypeInt,
 				Optional:         true,
@@ -215,6 +249,11 @@ func resourceAppSaml() *schema.Resource {
 				Optional:    true,
 				Description: "Identifies the SAML authentication context class for the assertion’s authentication statement",
 			},
+			"inline_hook_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the SAML assertion inline hook",
+			},
 			"accessibility_self_service": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -246,31 +285,22 @@ func resourceAppSaml() *schema.Resource {
 			"user_name_template": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "${source.login}",
-				Description: "Username template",
+				Description: "Username template, defaults to the provider's 'user_name_template' or '${source.login}'",
 			},
 			"user_name_template_suffix": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Username template suffix",
+				Description: "Username template suffix, defaults to the provider's 'user_name_template_suffix'",
 			},
 			"user_name_template_type": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				Default:          "BUILT_IN",
-				Description:      "Username template type",
+				Description:      "Username template type, defaults to the provider's 'user_name_template_type' or 'BUILT_IN'",
 				ValidateDiagFunc: stringInSlice([]string{"NONE", "CUSTOM", "BUILT_IN"}),
 			},
-			"app_settings_json": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      "Application settings in JSON format",
-				ValidateDiagFunc: stringIsJSON,
-				StateFunc:        normalizeDataJSON,
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return new == ""
-				},
-			},
+			"app_settings_json":          buildAppSettingsJSONSchema(),
+			"authentication_policy":      buildAuthenticationPolicySchema(),
+			"preconfigured_app_settings": preconfiguredAppSettingsSchema(),
 			"acs_endpoints": {
 				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -301,9 +331,12 @@ func resourceAppSaml() *schema.Resource {
 						"namespace": {
 							Type:     schema.TypeString,
 							Optional: true,
-							Default:  "urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified",
+							Default:  samlNamespaceUnspecified,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return normalizeSamlNamespace(old) == normalizeSamlNamespace(new)
+							},
 							ValidateDiagFunc: stringInSlice([]string{
-								"urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified",
+								samlNamespaceUnspecified,
 								"urn:oasis:names:tc:SAML:2.0:attrname-format:uri",
 								"urn:oasis:names:tc:SAML:2.0:attrname-format:basic",
 							}),
@@ -317,57 +350,108 @@ func resourceAppSaml() *schema.Resource {
 							Description:      "The type of attribute statements object",
 						},
 						"values": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Okta Expression Language expressions of the attribute value, required when 'type' is 'EXPRESSION'",
+						},
+					},
+				},
+			},
+			"single_logout": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Single Logout settings of the application",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the Single Logout is enabled. When disabled, the issuer, logout URL and certificate are removed from the application",
+						},
+						"issuer": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The issuer of the Service Provider that generates the Single Logout request",
+						},
+						"logout_url": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Description:      "The location where the logout response is sent",
+							ValidateDiagFunc: stringIsURL(validURLSchemes...),
+						},
+						"certificate": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "x509 encoded certificate that the Service Provider uses to sign Single Logout requests",
 						},
 					},
 				},
 			},
 			"single_logout_issuer": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The issuer of the Service Provider that generates the Single Logout request",
-				RequiredWith: []string{"single_logout_url", "single_logout_certificate"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The issuer of the Service Provider that generates the Single Logout request",
+				Deprecated:    "Use the 'issuer' of the 'single_logout' block instead",
+				ConflictsWith: []string{"single_logout"},
+				RequiredWith:  []string{"single_logout_url", "single_logout_certificate"},
 			},
 			"single_logout_url": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Description:      "The location where the logout response is sent",
+				Deprecated:       "Use the 'logout_url' of the 'single_logout' block instead",
 				ValidateDiagFunc: stringIsURL(validURLSchemes...),
+				ConflictsWith:    []string{"single_logout"},
 				RequiredWith:     []string{"single_logout_issuer", "single_logout_certificate"},
 			},
 			"single_logout_certificate": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "x509 encoded certificate that the Service Provider uses to sign Single Logout requests",
-				RequiredWith: []string{"single_logout_issuer", "single_logout_url"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "x509 encoded certificate that the Service Provider uses to sign Single Logout requests",
+				Deprecated:    "Use the 'certificate' of the 'single_logout' block instead",
+				ConflictsWith: []string{"single_logout"},
+				RequiredWith:  []string{"single_logout_issuer", "single_logout_url"},
 			},
 		}),
 	}
+	return r
 }
 
 func resourceAppSamlCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	err := validateAppSaml(d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	app, err := buildSamlApp(d)
+	app, err := buildSamlApp(d, m)
 	if err != nil {
 		return diag.Errorf("failed to create SAML application: %v", err)
 	}
 	activate := d.Get("status").(string) == statusActive
 	params := &query.Params{Activate: &activate}
-	_, _, err = getOktaClientFromMetadata(m).Application.CreateApplication(ctx, app, params)
+	_, _, err = getOktaClientFromMetadata(m).Application.CreateApplication(ctx, samlAppWithInlineHook(d, app), params)
 	if err != nil {
 		return diag.Errorf("failed to create SAML application: %v", err)
 	}
 	// Make sure to track in terraform prior to the creation of cert in case there is an error.
 	d.SetId(app.Id)
+	// the default key is kept, so the signing can be switched back to it after the staged key is activated
+	if app.Credentials != nil && app.Credentials.Signing != nil {
+		_ = d.Set("key_id", app.Credentials.Signing.Kid)
+	}
 	err = tryCreateCertificate(ctx, d, m, app.Id)
 	if err != nil {
 		return diag.Errorf("failed to create new certificate for SAML application: %v", err)
 	}
+	err = tryCreateNextCertificate(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to create staged certificate for SAML application: %v", err)
+	}
+	if id, ok := d.GetOk("next_key_id"); ok && d.Get("activate_next_key").(bool) {
+		app.Credentials.Signing = &okta.ApplicationCredentialsSigning{Kid: id.(string)}
+		_, _, err = getOktaClientFromMetadata(m).Application.UpdateApplication(ctx, app.Id, samlAppWithInlineHook(d, app))
+		if err != nil {
+			return diag.Errorf("failed to activate staged certificate for SAML application: %v", err)
+		}
+	}
 	err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to handle groups and users for SAML application: %v", err)
@@ -376,78 +460,157 @@ func resourceAppSamlCreate(ctx context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return diag.Errorf("failed to upload logo for SAML application: %v", err)
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for SAML application: %v", err)
+	}
 	return resourceAppSamlRead(ctx, d, m)
 }
 
 func resourceAppSamlRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	app := okta.NewSamlApplication()
-	err := fetchApp(ctx, d, m, app)
+	samlApp := sdk.NewSamlApplication(nil)
+	err := fetchApp(ctx, d, m, samlApp)
 	if err != nil {
 		return diag.Errorf("failed to get SAML application: %v", err)
 	}
+	app := samlApp.Unwrap()
 	if app.Id == "" {
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("inline_hook_id", samlApp.InlineHookID())
 	if app.Settings != nil {
 		if app.Settings.SignOn != nil {
 			err = setSamlSettings(d, app.Settings.SignOn)
 			if err != nil {
 				return diag.Errorf("failed to set SAML sign-on settings: %v", err)
 			}
+			if usesDeprecatedSamlSingleLogout(d) {
+				setDeprecatedSamlSingleLogout(d, app.Settings.SignOn)
+			} else {
+				_ = d.Set("single_logout", flattenSamlSingleLogout(d, app.Settings.SignOn))
+			}
 		}
 		err = setAppSettings(d, app.Settings.App)
 		if err != nil {
 			return diag.Errorf("failed to set SAML app settings: %v", err)
 		}
+		err = setPreconfiguredAppSettings(d, app.Name, app.Settings.App)
+		if err != nil {
+			return diag.Errorf("failed to set SAML preconfigured app settings: %v", err)
+		}
 	}
 	_ = d.Set("features", convertStringSetToInterface(app.Features))
-	_ = d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
-	_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
-	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
+	setUserNameTemplate(d, m, app.Credentials.UserNameTemplate)
 	_ = d.Set("preconfigured_app", app.Name)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
-	if app.Credentials.Signing.Kid != "" && app.Status != statusInactive {
-		keyID := app.Credentials.Signing.Kid
-		_ = d.Set("key_id", keyID)
-		keyMetadata, metadataRoot, err := getSupplementFromMetadata(m).GetSAMLMetadata(ctx, d.Id(), keyID)
-		if err != nil {
-			return diag.Errorf("failed to get app's SAML metadata: %v", err)
-		}
-		var q string
-		if keyID != "" {
-			q = fmt.Sprintf("?kid=%s", keyID)
-		}
-		_ = d.Set("metadata", string(keyMetadata))
-		_ = d.Set("metadata_url", fmt.Sprintf("%s/api/v1/apps/%s/sso/saml/metadata%s",
-			getOktaClientFromMetadata(m).GetConfig().Okta.Client.OrgUrl, d.Id(), q))
-		desc := metadataRoot.IDPSSODescriptors[0]
-		syncSamlEndpointBinding(d, desc.SingleSignOnServices)
-		uri := metadataRoot.EntityID
-		key := getExternalID(uri, app.Settings.SignOn.IdpIssuer)
-		_ = d.Set("entity_url", uri)
-		_ = d.Set("entity_key", key)
-		_ = d.Set("certificate", desc.KeyDescriptors[0].KeyInfo.Certificate)
+	setAppAuthenticationPolicy(d, app.Links)
+	setSamlSigningKeys(d, app.Credentials.Signing.Kid)
+	err = setSamlMetadata(ctx, d, m, app)
+	if err != nil {
+		return diag.Errorf("failed to get app's SAML metadata: %v", err)
+	}
+	err = setSamlNextKey(ctx, d, m, app.Id)
+	if err != nil {
+		return diag.Errorf("failed to get app's staged certificate: %v", err)
 	}
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 	err = syncGroupsAndUsers(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to sync groups and users for SAML application: %v", err)
 	}
+	return certificateExpiryWarning(m, fmt.Sprintf("SAML application '%s'", app.Label), d.Get("certificate").(string))
+}
+
+// setSamlSigningKeys sets the key currently used for signing. The 'key_id' keeps the configured or generated key, while
+// the staged key is active, so the signing is switched back to it when 'activate_next_key' is unset.
+func setSamlSigningKeys(d *schema.ResourceData, kid string) {
+	if kid == "" {
+		return
+	}
+	_ = d.Set("active_key_id", kid)
+	if d.Get("activate_next_key").(bool) && kid == d.Get("next_key_id").(string) && d.Get("key_id").(string) != "" {
+		return
+	}
+	_ = d.Set("key_id", kid)
+}
+
+// setSamlMetadata sets the IdP metadata of the application, so the Service Provider can be configured from the same
+// plan. The metadata is not available for the inactive applications.
+func setSamlMetadata(ctx context.Context, d *schema.ResourceData, m interface{}, app *okta.SamlApplication) error {
+	keyID := app.Credentials.Signing.Kid
+	if keyID == "" || app.Status == statusInactive {
+		return nil
+	}
+	keyMetadata, metadataRoot, err := getSupplementFromMetadata(m).GetSAMLMetadata(ctx, app.Id, keyID)
+	if err != nil {
+		return err
+	}
+	_ = d.Set("metadata", string(keyMetadata))
+	_ = d.Set("metadata_url", fmt.Sprintf("%s/api/v1/apps/%s/sso/saml/metadata?kid=%s",
+		getOktaClientFromMetadata(m).GetConfig().Okta.Client.OrgUrl, app.Id, keyID))
+	desc := metadataRoot.IDPSSODescriptors[0]
+	syncSamlEndpointBinding(d, desc.SingleSignOnServices)
+	var issuer string
+	if app.Settings != nil && app.Settings.SignOn != nil {
+		issuer = app.Settings.SignOn.IdpIssuer
+	}
+	uri := metadataRoot.EntityID
+	_ = d.Set("entity_url", uri)
+	_ = d.Set("entity_key", getExternalID(uri, issuer))
+	_ = d.Set("certificate", desc.KeyDescriptors[0].KeyInfo.Certificate)
+	return nil
+}
+
+// samlMetadataAttributes are the attributes, which are read from the IdP metadata of the application.
+var samlMetadataAttributes = []string{
+	"metadata", "metadata_url", "certificate", "http_post_binding", "http_redirect_binding", "entity_key", "entity_url",
+}
+
+// setSamlMetadataNewComputed marks the metadata attributes as unknown, when the plan changes the metadata: the new or
+// activated staged key changes the certificate, the issuer changes the entity URL, and the metadata is not available for the inactive
+// applications. Otherwise, the Service Provider would be configured with the stale metadata.
+func setSamlMetadataNewComputed(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange("next_key_name") {
+		for _, k := range []string{"next_key_id", "next_key_certificate"} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+	}
+	keys := samlMetadataAttributes
+	switch {
+	case d.HasChange("key_name"):
+		keys = append([]string{"key_id", "active_key_id"}, keys...)
+	case d.HasChange("activate_next_key"), d.Get("activate_next_key").(bool) && d.HasChange("next_key_name"):
+		keys = append([]string{"active_key_id"}, keys...)
+	case d.HasChange("idp_issuer"), d.HasChange("status"):
+	default:
+		return nil
+	}
+	for _, k := range keys {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func resourceAppSamlUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	err := validateAppSaml(d)
+	client := getOktaClientFromMetadata(m)
+	// The staged key is generated first, so it can be activated within the same apply.
+	err := tryCreateNextCertificate(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diag.Errorf("failed to create staged certificate for SAML application: %v", err)
 	}
-	client := getOktaClientFromMetadata(m)
-	app, err := buildSamlApp(d)
+	app, err := buildSamlApp(d, m)
 	if err != nil {
 		return diag.Errorf("failed to create SAML application: %v", err)
 	}
-	_, _, err = client.Application.UpdateApplication(ctx, d.Id(), app)
+	_, _, err = client.Application.UpdateApplication(ctx, d.Id(), samlAppWithInlineHook(d, app))
 	if err != nil {
 		return diag.Errorf("failed to update SAML application: %v", err)
 	}
@@ -473,6 +636,10 @@ func resourceAppSamlUpdate(ctx context.Context, d *schema.ResourceData, m interf
 			return diag.Errorf("failed to upload logo for SAML application: %v", err)
 		}
 	}
+	err = handleAppAuthenticationPolicy(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.Errorf("failed to set authentication policy for SAML application: %v", err)
+	}
 	return resourceAppSamlRead(ctx, d, m)
 }
 
@@ -484,7 +651,7 @@ func resourceAppSamlDelete(ctx context.Context, d *schema.ResourceData, m interf
 	return nil
 }
 
-func buildSamlApp(d *schema.ResourceData) (*okta.SamlApplication, error) {
+func buildSamlApp(d *schema.ResourceData, m interface{}) (*okta.SamlApplication, error) {
 	// Abstracts away name and SignOnMode which are constant for this app type.
 	app := okta.NewSamlApplication()
 	app.Label = d.Get("label").(string)
@@ -510,23 +677,12 @@ func buildSamlApp(d *schema.ResourceData) (*okta.SamlApplication, error) {
 	}
 
 	honorForce := d.Get("honor_force_authn").(bool)
-	autoSubmit := d.Get("auto_submit_toolbar").(bool)
-	hideMobile := d.Get("hide_ios").(bool)
-	hideWeb := d.Get("hide_web").(bool)
-	a11ySelfService := d.Get("accessibility_self_service").(bool)
 	app.Settings = okta.NewSamlApplicationSettings()
-	app.Visibility = &okta.ApplicationVisibility{
-		AutoSubmitToolbar: &autoSubmit,
-		Hide: &okta.ApplicationVisibilityHide{
-			IOS: &hideMobile,
-			Web: &hideWeb,
-		},
-	}
-	if appSettings, ok := d.GetOk("app_settings_json"); ok {
-		payload := map[string]interface{}{}
-		_ = json.Unmarshal([]byte(appSettings.(string)), &payload)
-		settings := okta.ApplicationSettingsApplication(payload)
-		app.Settings.App = &settings
+	app.Visibility = buildVisibility(d)
+	if appSettings := buildPreconfiguredAppSettings(d); appSettings != nil {
+		app.Settings.App = appSettings
+	} else if appSettings := buildAppSettings(d); appSettings != nil {
+		app.Settings.App = appSettings
 	} else {
 		// we should provide empty app, even if there are no values
 		// see https://github.com/okta/terraform-provider-okta/pull/226#issuecomment-744545051
@@ -551,29 +707,29 @@ func buildSamlApp(d *schema.ResourceData) (*okta.SamlApplication, error) {
 		AuthnContextClassRef:  d.Get("authn_context_class_ref").(string),
 		Slo:                   &okta.SingleLogout{Enabled: boolPtr(false)},
 	}
-	sli := d.Get("single_logout_issuer").(string)
-	if sli != "" {
+	if usesDeprecatedSamlSingleLogout(d) {
 		app.Settings.SignOn.Slo = &okta.SingleLogout{
 			Enabled:   boolPtr(true),
-			Issuer:    sli,
+			Issuer:    d.Get("single_logout_issuer").(string),
 			LogoutUrl: d.Get("single_logout_url").(string),
 		}
 		app.Settings.SignOn.SpCertificate = &okta.SpCertificate{
 			X5c: []string{d.Get("single_logout_certificate").(string)},
 		}
+	} else if d.Get("single_logout.0.enabled").(bool) {
+		app.Settings.SignOn.Slo = &okta.SingleLogout{
+			Enabled:   boolPtr(true),
+			Issuer:    d.Get("single_logout.0.issuer").(string),
+			LogoutUrl: d.Get("single_logout.0.logout_url").(string),
+		}
+		app.Settings.SignOn.SpCertificate = &okta.SpCertificate{
+			X5c: []string{d.Get("single_logout.0.certificate").(string)},
+		}
 	}
 	app.Credentials = &okta.ApplicationCredentials{
-		UserNameTemplate: &okta.ApplicationCredentialsUsernameTemplate{
-			Template: d.Get("user_name_template").(string),
-			Type:     d.Get("user_name_template_type").(string),
-			Suffix:   d.Get("user_name_template_suffix").(string),
-		},
-	}
-	app.Accessibility = &okta.ApplicationAccessibility{
-		SelfService:      &a11ySelfService,
-		ErrorRedirectUrl: d.Get("accessibility_error_redirect_url").(string),
-		LoginRedirectUrl: d.Get("accessibility_login_redirect_url").(string),
+		UserNameTemplate: buildUserNameTemplate(d, m),
 	}
+	app.Accessibility = buildAccessibility(d)
 
 	// Assumes that sso url is already part of the acs endpoints as part of the desired state.
 	acsEndpoints := convertInterfaceToStringSet(d.Get("acs_endpoints"))
@@ -614,10 +770,26 @@ func buildSamlApp(d *schema.ResourceData) (*okta.SamlApplication, error) {
 			Kid: id.(string),
 		}
 	}
+	if id, ok := d.GetOk("next_key_id"); ok && d.Get("activate_next_key").(bool) {
+		app.Credentials.Signing = &okta.ApplicationCredentialsSigning{
+			Kid: id.(string),
+		}
+	}
 
 	return app, nil
 }
 
+// samlAppWithInlineHook adds the SAML assertion inline hook to the application. The inline hooks are sent only when
+// the hook is configured or removed, since they are not available in all the orgs.
+func samlAppWithInlineHook(d *schema.ResourceData, app *okta.SamlApplication) okta.App {
+	if _, ok := d.GetOk("inline_hook_id"); !ok && !d.HasChange("inline_hook_id") {
+		return app
+	}
+	samlApp := sdk.NewSamlApplication(app)
+	samlApp.SetInlineHookID(d.Get("inline_hook_id").(string))
+	return samlApp
+}
+
 // Keep in mind that at the time of writing this the official SDK did not support generating certs.
 func generateCertificate(ctx context.Context, d *schema.ResourceData, m interface{}, appID string) (*okta.JsonWebKey, error) {
 	requestExecutor := getRequestExecutor(m)
@@ -647,22 +819,174 @@ func tryCreateCertificate(ctx context.Context, d *schema.ResourceData, m interfa
 	return nil
 }
 
-func validateAppSaml(d *schema.ResourceData) error {
-	jwks, ok := d.GetOk("attribute_statements")
+// tryCreateNextCertificate generates the staged key, when its name is changed. The key stays in the app's key store
+// after the name is removed, since the keys can not be deleted.
+func tryCreateNextCertificate(ctx context.Context, d *schema.ResourceData, m interface{}, appID string) error {
+	if !d.HasChange("next_key_name") {
+		return nil
+	}
+	if _, ok := d.GetOk("next_key_name"); !ok {
+		_ = d.Set("next_key_id", "")
+		return nil
+	}
+	key, err := generateCertificate(ctx, d, m, appID)
+	if err != nil {
+		return err
+	}
+	_ = d.Set("next_key_id", key.Kid)
+	return nil
+}
+
+// setSamlNextKey sets the certificate of the staged key, so it can be shared with the Service Provider before the key
+// is activated.
+func setSamlNextKey(ctx context.Context, d *schema.ResourceData, m interface{}, appID string) error {
+	keyID := d.Get("next_key_id").(string)
+	if keyID == "" {
+		_ = d.Set("next_key_certificate", "")
+		return nil
+	}
+	key, resp, err := getOktaClientFromMetadata(m).Application.GetApplicationKey(ctx, appID, keyID)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return err
+	}
+	if key == nil || len(key.X5c) == 0 {
+		_ = d.Set("next_key_id", "")
+		_ = d.Set("next_key_certificate", "")
+		return nil
+	}
+	_ = d.Set("next_key_certificate", key.X5c[0])
+	return nil
+}
+
+// validateAppSamlAttributeStatements validates the combinations of the attributes of the statements during the plan.
+// The statements with unknown attributes are validated by Okta during the apply.
+func validateAppSamlAttributeStatements(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	statements, ok := d.GetOk("attribute_statements")
 	if !ok {
 		return nil
 	}
-	for i := range jwks.([]interface{}) {
-		objType := d.Get(fmt.Sprintf("attribute_statements.%d.type", i)).(string)
-		if (d.Get(fmt.Sprintf("attribute_statements.%d.filter_type", i)).(string) != "" ||
-			d.Get(fmt.Sprintf("attribute_statements.%d.filter_value", i)).(string) != "") &&
-			objType != "GROUP" {
-			return errors.New("invalid 'attribute_statements': when setting 'filter_value' or 'filter_type', value of 'type' should be set to 'GROUP'")
+	for i := range statements.([]interface{}) {
+		key := fmt.Sprintf("attribute_statements.%d", i)
+		if !d.NewValueKnown(key+".type") || !d.NewValueKnown(key+".filter_type") ||
+			!d.NewValueKnown(key+".filter_value") || !d.NewValueKnown(key+".values") {
+			continue
 		}
-		if objType == "GROUP" &&
-			len(convertInterfaceToStringArrNullable(d.Get(fmt.Sprintf("attribute_statements.%d.values", i)))) > 0 {
-			return errors.New("invalid 'attribute_statements': when setting 'values', 'type' should be set to 'EXPRESSION'")
+		err := validateSamlAttributeStatement(
+			d.Get(key+".type").(string),
+			d.Get(key+".filter_type").(string),
+			d.Get(key+".filter_value").(string),
+			convertInterfaceToStringArrNullable(d.Get(key+".values")),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid 'attribute_statements' '%s': %v", d.Get(key+".name").(string), err)
 		}
 	}
 	return nil
 }
+
+func validateSamlAttributeStatement(statementType, filterType, filterValue string, values []string) error {
+	if statementType != "GROUP" {
+		if filterType != "" || filterValue != "" {
+			return errors.New("when setting 'filter_value' or 'filter_type', value of 'type' should be set to 'GROUP'")
+		}
+		if len(values) == 0 {
+			return errors.New("'values' are required, when 'type' is 'EXPRESSION'")
+		}
+		return nil
+	}
+	if len(values) > 0 {
+		return errors.New("when setting 'values', 'type' should be set to 'EXPRESSION'")
+	}
+	if filterType == "" || filterValue == "" {
+		return errors.New("both 'filter_type' and 'filter_value' are required, when 'type' is 'GROUP'")
+	}
+	if filterType != "REGEX" {
+		return nil
+	}
+	// Okta evaluates the filter as Java regular expression, so the syntax, which is valid in Java, but is not supported
+	// by Go (e.g. lookarounds), is left for Okta to validate
+	_, err := regexp.Compile(filterValue)
+	var reErr *syntax.Error
+	if err != nil && errors.As(err, &reErr) && reErr.Code != syntax.ErrInvalidPerlOp {
+		return fmt.Errorf("'filter_value' is not a valid regular expression: %v", err)
+	}
+	return nil
+}
+
+func validateAppSamlSingleLogout(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// the deprecated top-level fields are the settings of the enabled Single Logout
+	attrs := map[string]string{
+		"issuer":      "single_logout_issuer",
+		"logout_url":  "single_logout_url",
+		"certificate": "single_logout_certificate",
+	}
+	enabled := false
+	for _, attr := range attrs {
+		if !d.NewValueKnown(attr) || d.Get(attr).(string) != "" {
+			enabled = true
+		}
+	}
+	if !enabled {
+		if len(d.Get("single_logout").([]interface{})) == 0 || !d.NewValueKnown("single_logout.0") {
+			return nil
+		}
+		enabled = d.Get("single_logout.0.enabled").(bool)
+		for k := range attrs {
+			attrs[k] = "single_logout.0." + k
+		}
+	}
+	for k, attr := range attrs {
+		if !d.NewValueKnown(attr) {
+			continue
+		}
+		v := d.Get(attr).(string)
+		if enabled && v == "" {
+			return fmt.Errorf("'%s' of the 'single_logout' is required, when Single Logout is enabled", k)
+		}
+		if !enabled && v != "" {
+			return fmt.Errorf("'%s' of the 'single_logout' can not be set, when Single Logout is disabled", k)
+		}
+	}
+	return nil
+}
+
+// usesDeprecatedSamlSingleLogout reports whether the Single Logout is configured with the deprecated top-level fields
+// instead of the 'single_logout' block.
+func usesDeprecatedSamlSingleLogout(d *schema.ResourceData) bool {
+	return d.Get("single_logout_issuer").(string) != "" || d.Get("single_logout_url").(string) != "" ||
+		d.Get("single_logout_certificate").(string) != ""
+}
+
+// setDeprecatedSamlSingleLogout sets the Single Logout settings of the application to the deprecated top-level fields.
+func setDeprecatedSamlSingleLogout(d *schema.ResourceData, signOn *okta.SamlApplicationSettingsSignOn) {
+	var issuer, logoutURL, certificate string
+	if signOn.Slo != nil && signOn.Slo.Enabled != nil && *signOn.Slo.Enabled {
+		issuer, logoutURL = signOn.Slo.Issuer, signOn.Slo.LogoutUrl
+		if signOn.SpCertificate != nil && len(signOn.SpCertificate.X5c) > 0 {
+			certificate = signOn.SpCertificate.X5c[0]
+		}
+	}
+	_ = d.Set("single_logout_issuer", issuer)
+	_ = d.Set("single_logout_url", logoutURL)
+	_ = d.Set("single_logout_certificate", certificate)
+}
+
+// flattenSamlSingleLogout returns the Single Logout settings of the application. The disabled settings are kept only
+// if they are configured, so the absent block does not cause a diff.
+func flattenSamlSingleLogout(d *schema.ResourceData, signOn *okta.SamlApplicationSettingsSignOn) []interface{} {
+	if signOn.Slo != nil && signOn.Slo.Enabled != nil && *signOn.Slo.Enabled {
+		slo := map[string]interface{}{
+			"enabled":    true,
+			"issuer":     signOn.Slo.Issuer,
+			"logout_url": signOn.Slo.LogoutUrl,
+		}
+		if signOn.SpCertificate != nil && len(signOn.SpCertificate.X5c) > 0 {
+			slo["certificate"] = signOn.SpCertificate.X5c[0]
+		}
+		return []interface{}{slo}
+	}
+	if len(d.Get("single_logout").([]interface{})) == 0 {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{"enabled": false}}
+}
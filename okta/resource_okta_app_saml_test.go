@@ -4,15 +4,95 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/okta/okta-sdk-golang/v2/okta"
 )
 
 // Ensure conditional require logic causes this plan to fail
+func TestValidateSamlAttributeStatement(t *testing.T) {
+	for _, tc := range []struct {
+		statementType, filterType, filterValue string
+		values                                 []string
+		valid                                  bool
+	}{
+		{"EXPRESSION", "", "", []string{"user.email"}, true},
+		{"EXPRESSION", "", "", []string{`Arrays.flatten(getFilteredGroups({"00g1a2b3c4d5e6f7g8h9"}, "group.name", 100))`}, true},
+		{"EXPRESSION", "", "", nil, false},
+		{"EXPRESSION", "REGEX", ".*", []string{"user.email"}, false},
+		{"GROUP", "STARTS_WITH", "app_", nil, true},
+		{"GROUP", "REGEX", "^app_(dev|prod)$", nil, true},
+		{"GROUP", "REGEX", "^(?!admin).*", nil, true},
+		{"GROUP", "REGEX", "^app_(dev", nil, false},
+		{"GROUP", "EQUALS", "", nil, false},
+		{"GROUP", "", "admins", nil, false},
+		{"GROUP", "EQUALS", "admins", []string{"user.email"}, false},
+	} {
+		err := validateSamlAttributeStatement(tc.statementType, tc.filterType, tc.filterValue, tc.values)
+		if tc.valid && err != nil {
+			t.Errorf("expected %+v to be valid, got: %v", tc, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("expected %+v to be invalid", tc)
+		}
+	}
+}
+
+func TestBuildSamlAppDeprecatedSingleLogout(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAppSaml().Schema, map[string]interface{}{
+		"label":                     "test",
+		"preconfigured_app":         "example_app",
+		"single_logout_issuer":      "https://example.com",
+		"single_logout_url":         "https://example.com/logout",
+		"single_logout_certificate": "MIIC",
+	})
+	app, err := buildSamlApp(d, &Config{})
+	if err != nil {
+		t.Fatalf("failed to build SAML application: %v", err)
+	}
+	slo := app.Settings.SignOn.Slo
+	if slo == nil || slo.Enabled == nil || !*slo.Enabled || slo.Issuer != "https://example.com" || slo.LogoutUrl != "https://example.com/logout" {
+		t.Errorf("expected the deprecated fields to enable the Single Logout, got %+v", slo)
+	}
+	if cert := app.Settings.SignOn.SpCertificate; cert == nil || len(cert.X5c) != 1 || cert.X5c[0] != "MIIC" {
+		t.Errorf("expected the deprecated certificate to be sent, got %+v", cert)
+	}
+}
+
+func TestSamlStagedKeyActivation(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAppSaml().Schema, map[string]interface{}{
+		"label":             "test",
+		"preconfigured_app": "example_app",
+		"activate_next_key": true,
+	})
+	_ = d.Set("key_id", "key1")
+	_ = d.Set("next_key_id", "key2")
+	setSamlSigningKeys(d, "key2")
+	if d.Get("key_id").(string) != "key1" || d.Get("active_key_id").(string) != "key2" {
+		t.Fatalf("expected the original key to be kept, got key_id '%s', active_key_id '%s'", d.Get("key_id"), d.Get("active_key_id"))
+	}
+	app, err := buildSamlApp(d, &Config{})
+	if err != nil {
+		t.Fatalf("failed to build SAML application: %v", err)
+	}
+	if app.Credentials.Signing.Kid != "key2" {
+		t.Errorf("expected the staged key to be used for signing, got '%s'", app.Credentials.Signing.Kid)
+	}
+	_ = d.Set("activate_next_key", false)
+	app, err = buildSamlApp(d, &Config{})
+	if err != nil {
+		t.Fatalf("failed to build SAML application: %v", err)
+	}
+	if app.Credentials.Signing.Kid != "key1" {
+		t.Errorf("expected the signing to be switched back to the original key, got '%s'", app.Credentials.Signing.Kid)
+	}
+}
+
 func TestAccAppSaml_conditionalRequire(t *testing.T) {
 	ri := acctest.RandInt()
 	config := buildTestSamlConfigMissingFields(ri)
@@ -106,9 +186,10 @@ func TestAccAppSaml_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "attribute_statements.0.filter_type", "REGEX"),
 					resource.TestCheckResourceAttr(resourceName, "attribute_statements.0.filter_value", ".*"),
 					resource.TestCheckResourceAttr(resourceName, "acs_endpoints.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "single_logout_issuer", "https://dunshire.okta.com"),
-					resource.TestCheckResourceAttr(resourceName, "single_logout_url", "https://dunshire.okta.com/logout"),
-					resource.TestCheckResourceAttr(resourceName, "single_logout_certificate", "MIIFnDCCA4QCCQDBSLbiON2T1zANBgkqhkiG9w0BAQsFADCBjzELMAkGA1UEBhMCVVMxDjAMBgNV\r\nBAgMBU1haW5lMRAwDgYDVQQHDAdDYXJpYm91MRcwFQYDVQQKDA5Tbm93bWFrZXJzIEluYzEUMBIG\r\nA1UECwwLRW5naW5lZXJpbmcxDTALBgNVBAMMBFNub3cxIDAeBgkqhkiG9w0BCQEWEWVtYWlsQGV4\r\nYW1wbGUuY29tMB4XDTIwMTIwMzIyNDY0M1oXDTMwMTIwMTIyNDY0M1owgY8xCzAJBgNVBAYTAlVT\r\nMQ4wDAYDVQQIDAVNYWluZTEQMA4GA1UEBwwHQ2FyaWJvdTEXMBUGA1UECgwOU25vd21ha2VycyBJ\r\nbmMxFDASBgNVBAsMC0VuZ2luZWVyaW5nMQ0wCwYDVQQDDARTbm93MSAwHgYJKoZIhvcNAQkBFhFl\r\nbWFpbEBleGFtcGxlLmNvbTCCAiIwDQYJKoZIhvcNAQEBBQADggIPADCCAgoCggIBANMmWDjXPdoa\r\nPyzIENqeY9njLan2FqCbQPSestWUUcb6NhDsJVGSQ7XR+ozQA5TaJzbP7cAJUj8vCcbqMZsgOQAu\r\nO/pzYyQEKptLmrGvPn7xkJ1A1xLkp2NY18cpDTeUPueJUoidZ9EJwEuyUZIktzxNNU1pA1lGijiu\r\n2XNxs9d9JR/hm3tCu9Im8qLVB4JtX80YUa6QtlRjWR/H8a373AYCOASdoB3c57fIPD8ATDNy2w/c\r\nfCVGiyKDMFB+GA/WTsZpOP3iohRp8ltAncSuzypcztb2iE+jijtTsiC9kUA2abAJqqpoCJubNShi\r\nVff4822czpziS44MV2guC9wANi8u3Uyl5MKsU95j01jzadKRP5S+2f0K+n8n4UoV9fnqZFyuGAKd\r\nCJi9K6NlSAP+TgPe/JP9FOSuxQOHWJfmdLHdJD+evoKi9E55sr5lRFK0xU1Fj5Ld7zjC0pXPhtJf\r\nsgjEZzD433AsHnRzvRT1KSNCPkLYomznZo5n9rWYgCQ8HcytlQDTesmKE+s05E/VSWNtH84XdDrt\r\nieXwfwhHfaABSu+WjZYxi9CXdFCSvXhsgufUcK4FbYAHl/ga/cJxZc52yFC7Pcq0u9O2BSCjYPdQ\r\nDAHs9dhT1RhwVLM8RmoAzgxyyzau0gxnAlgSBD9FMW6dXqIHIp8yAAg9cRXhYRTNAgMBAAEwDQYJ\r\nKoZIhvcNAQELBQADggIBADofEC1SvG8qa7pmKCjB/E9Sxhk3mvUO9Gq43xzwVb721Ng3VYf4vGU3\r\nwLUwJeLt0wggnj26NJweN5T3q9T8UMxZhHSWvttEU3+S1nArRB0beti716HSlOCDx4wTmBu/D1MG\r\nt/kZYFJw+zuzvAcbYct2pK69AQhD8xAIbQvqADJI7cCK3yRry+aWtppc58P81KYabUlCfFXfhJ9E\r\nP72ffN4jVHpX3lxxYh7FKAdiKbY2FYzjsc7RdgKI1R3iAAZUCGBTvezNzaetGzTUjjl/g1tcVYij\r\nltH9ZOQBPlUMI88lxUxqgRTerpPmAJH00CACx4JFiZrweLM1trZyy06wNDQgLrqHr3EOagBF/O2h\r\nhfTehNdVr6iq3YhKWBo4/+RL0RCzHMh4u86VbDDnDn4Y6HzLuyIAtBFoikoKM6UHTOa0Pqv2bBr5\r\nwbkRkVUxl9yJJw/HmTCdfnsM9dTOJUKzEglnGF2184Gg+qJDZB6fSf0EAO1F6sTqiSswl+uHQZiy\r\nDaZzyU7Gg5seKOZ20zTRaX3Ihj9Zij/ORnrARE7eM/usKMECp+7syUwAUKxDCZkGiUdskmOhhBGL\r\nJtbyK3F2UvoJoLsm3pIcvMak9KwMjSTGJB47ABUP1+w+zGcNk0D5Co3IJ6QekiLfWJyQ+kKsWLKt\r\nzOYQQatrnBagM7MI2/T4\r\n"),
+					resource.TestCheckResourceAttr(resourceName, "single_logout.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "single_logout.0.issuer", "https://dunshire.okta.com"),
+					resource.TestCheckResourceAttr(resourceName, "single_logout.0.logout_url", "https://dunshire.okta.com/logout"),
+					resource.TestCheckResourceAttr(resourceName, "single_logout.0.certificate", "MIIFnDCCA4QCCQDBSLbiON2T1zANBgkqhkiG9w0BAQsFADCBjzELMAkGA1UEBhMCVVMxDjAMBgNV\r\nBAgMBU1haW5lMRAwDgYDVQQHDAdDYXJpYm91MRcwFQYDVQQKDA5Tbm93bWFrZXJzIEluYzEUMBIG\r\nA1UECwwLRW5naW5lZXJpbmcxDTALBgNVBAMMBFNub3cxIDAeBgkqhkiG9w0BCQEWEWVtYWlsQGV4\r\nYW1wbGUuY29tMB4XDTIwMTIwMzIyNDY0M1oXDTMwMTIwMTIyNDY0M1owgY8xCzAJBgNVBAYTAlVT\r\nMQ4wDAYDVQQIDAVNYWluZTEQMA4GA1UEBwwHQ2FyaWJvdTEXMBUGA1UECgwOU25vd21ha2VycyBJ\r\nbmMxFDASBgNVBAsMC0VuZ2luZWVyaW5nMQ0wCwYDVQQDDARTbm93MSAwHgYJKoZIhvcNAQkBFhFl\r\nbWFpbEBleGFtcGxlLmNvbTCCAiIwDQYJKoZIhvcNAQEBBQADggIPADCCAgoCggIBANMmWDjXPdoa\r\nPyzIENqeY9njLan2FqCbQPSestWUUcb6NhDsJVGSQ7XR+ozQA5TaJzbP7cAJUj8vCcbqMZsgOQAu\r\nO/pzYyQEKptLmrGvPn7xkJ1A1xLkp2NY18cpDTeUPueJUoidZ9EJwEuyUZIktzxNNU1pA1lGijiu\r\n2XNxs9d9JR/hm3tCu9Im8qLVB4JtX80YUa6QtlRjWR/H8a373AYCOASdoB3c57fIPD8ATDNy2w/c\r\nfCVGiyKDMFB+GA/WTsZpOP3iohRp8ltAncSuzypcztb2iE+jijtTsiC9kUA2abAJqqpoCJubNShi\r\nVff4822czpziS44MV2guC9wANi8u3Uyl5MKsU95j01jzadKRP5S+2f0K+n8n4UoV9fnqZFyuGAKd\r\nCJi9K6NlSAP+TgPe/JP9FOSuxQOHWJfmdLHdJD+evoKi9E55sr5lRFK0xU1Fj5Ld7zjC0pXPhtJf\r\nsgjEZzD433AsHnRzvRT1KSNCPkLYomznZo5n9rWYgCQ8HcytlQDTesmKE+s05E/VSWNtH84XdDrt\r\nieXwfwhHfaABSu+WjZYxi9CXdFCSvXhsgufUcK4FbYAHl/ga/cJxZc52yFC7Pcq0u9O2BSCjYPdQ\r\nDAHs9dhT1RhwVLM8RmoAzgxyyzau0gxnAlgSBD9FMW6dXqIHIp8yAAg9cRXhYRTNAgMBAAEwDQYJ\r\nKoZIhvcNAQELBQADggIBADofEC1SvG8qa7pmKCjB/E9Sxhk3mvUO9Gq43xzwVb721Ng3VYf4vGU3\r\nwLUwJeLt0wggnj26NJweN5T3q9T8UMxZhHSWvttEU3+S1nArRB0beti716HSlOCDx4wTmBu/D1MG\r\nt/kZYFJw+zuzvAcbYct2pK69AQhD8xAIbQvqADJI7cCK3yRry+aWtppc58P81KYabUlCfFXfhJ9E\r\nP72ffN4jVHpX3lxxYh7FKAdiKbY2FYzjsc7RdgKI1R3iAAZUCGBTvezNzaetGzTUjjl/g1tcVYij\r\nltH9ZOQBPlUMI88lxUxqgRTerpPmAJH00CACx4JFiZrweLM1trZyy06wNDQgLrqHr3EOagBF/O2h\r\nhfTehNdVr6iq3YhKWBo4/+RL0RCzHMh4u86VbDDnDn4Y6HzLuyIAtBFoikoKM6UHTOa0Pqv2bBr5\r\nwbkRkVUxl9yJJw/HmTCdfnsM9dTOJUKzEglnGF2184Gg+qJDZB6fSf0EAO1F6sTqiSswl+uHQZiy\r\nDaZzyU7Gg5seKOZ20zTRaX3Ihj9Zij/ORnrARE7eM/usKMECp+7syUwAUKxDCZkGiUdskmOhhBGL\r\nJtbyK3F2UvoJoLsm3pIcvMak9KwMjSTGJB47ABUP1+w+zGcNk0D5Co3IJ6QekiLfWJyQ+kKsWLKt\r\nzOYQQatrnBagM7MI2/T4\r\n"),
 					resource.TestCheckResourceAttrSet(resourceName, "logo_url"),
 				),
 			},
@@ -132,6 +213,148 @@ func TestAccAppSaml_crud(t *testing.T) {
 	})
 }
 
+func TestAccAppSaml_singleLogout(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appSaml)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	disabled := mgr.GetFixtures("single_logout_disabled.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appSaml)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appSaml, createDoesAppExist(okta.NewSamlApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewSamlApplication())),
+					resource.TestCheckResourceAttr(resourceName, "single_logout.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_logout.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "single_logout.0.issuer", "https://dunshire.okta.com"),
+				),
+			},
+			{
+				Config: disabled,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewSamlApplication())),
+					resource.TestCheckResourceAttr(resourceName, "single_logout.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_logout.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "single_logout.0.issuer", ""),
+					resource.TestCheckResourceAttr(resourceName, "single_logout.0.logout_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "single_logout.0.certificate", ""),
+				),
+			},
+		},
+	})
+}
+
+// The metadata of the application changes along with the signing key
+func TestAccAppSaml_keyRotation(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appSaml)
+	config := mgr.GetFixtures("key_rotation.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("key_rotation_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appSaml)
+	var keyID, certificate string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appSaml, createDoesAppExist(okta.NewSamlApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "metadata"),
+					resource.TestCheckResourceAttrSet(resourceName, "entity_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "http_post_binding"),
+					resource.TestCheckResourceAttrSet(resourceName, "http_redirect_binding"),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+						keyID, certificate = attrs["key_id"], attrs["certificate"]
+						if certificate == "" {
+							return errors.New("certificate is not set")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: func(s *terraform.State) error {
+					attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+					if attrs["key_id"] == keyID || attrs["certificate"] == certificate {
+						return errors.New("expected key ID and certificate to change along with the key")
+					}
+					if !strings.Contains(attrs["metadata_url"], attrs["key_id"]) {
+						return fmt.Errorf("expected metadata URL to refer to the new key, got: %s", attrs["metadata_url"])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// The staged key is shared with the Service Provider first, and then it is activated
+func TestAccAppSaml_stagedKeyRotation(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appSaml)
+	stagedConfig := mgr.GetFixtures("key_rotation_staged.tf", ri, t)
+	activatedConfig := mgr.GetFixtures("key_rotation_activated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appSaml)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appSaml, createDoesAppExist(okta.NewSamlApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: stagedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "next_key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "next_key_certificate"),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+						if attrs["key_id"] == attrs["next_key_id"] {
+							return errors.New("expected staged key not to be used for signing")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: activatedConfig,
+				Check: func(s *terraform.State) error {
+					attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+					if attrs["active_key_id"] != attrs["next_key_id"] {
+						return fmt.Errorf("expected staged key '%s' to be used for signing, got '%s'", attrs["next_key_id"], attrs["active_key_id"])
+					}
+					if attrs["key_id"] == attrs["next_key_id"] {
+						return errors.New("expected the original key to be kept")
+					}
+					if attrs["certificate"] != attrs["next_key_certificate"] {
+						return errors.New("expected metadata certificate to be the staged one")
+					}
+					return nil
+				},
+			},
+			{
+				// the signing is switched back to the original key
+				Config: stagedConfig,
+				Check: func(s *terraform.State) error {
+					attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+					if attrs["active_key_id"] != attrs["key_id"] {
+						return fmt.Errorf("expected original key '%s' to be used for signing, got '%s'", attrs["key_id"], attrs["active_key_id"])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
 // Add and remove groups/users
 func TestAccAppSaml_userGroups(t *testing.T) {
 	ri := acctest.RandInt()
@@ -171,6 +394,60 @@ func TestAccAppSaml_userGroups(t *testing.T) {
 	})
 }
 
+func TestAccAppSaml_inlineHook(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appSaml)
+	config := mgr.GetFixtures("inline_hook.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("inline_hook_removed.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appSaml)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appSaml, createDoesAppExist(okta.NewSamlApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewSamlApplication())),
+					resource.TestCheckResourceAttrPair(resourceName, "inline_hook_id", "okta_inline_hook.test", "id"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewSamlApplication())),
+					resource.TestCheckResourceAttr(resourceName, "inline_hook_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppSaml_preconfiguredAppSettings(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appSaml)
+	config := mgr.GetFixtures("preconfigured_settings.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appSaml)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appSaml, createDoesAppExist(okta.NewSamlApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewSamlApplication())),
+					resource.TestCheckResourceAttr(resourceName, "preconfigured_app", "amazon_aws"),
+					resource.TestCheckResourceAttr(resourceName, "preconfigured_app_settings.0.amazon_aws.0.session_duration", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "preconfigured_app_settings.0.amazon_aws.0.join_all_roles", "true"),
+				),
+			},
+		},
+	})
+}
+
 func buildTestSamlConfigMissingFields(rInt int) string {
 	name := buildResourceName(rInt)
 
@@ -16,7 +16,7 @@ func resourceAppSecurePasswordStore() *schema.Resource {
 		UpdateContext: resourceAppSecurePasswordStoreUpdate,
 		DeleteContext: resourceAppSecurePasswordStoreDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: appImporter,
 		},
 
 		// For those familiar with Terraform schemas be sure to check the base application schema and/or
@@ -96,17 +96,20 @@ func resourceAppSecurePasswordStore() *schema.Resource {
 			"shared_password": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Shared password, required for certain schemes.",
+				Description: "Shared password, required for certain schemes. It may refer to the AWS Secrets Manager or Vault secret.",
 			},
 		}),
 	}
 }
 
 func resourceAppSecurePasswordStoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	app := buildAppSecurePasswordStore(d)
+	app, err := buildAppSecurePasswordStore(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to create secure password store application: %v", err)
+	}
 	activate := d.Get("status").(string) == statusActive
 	params := &query.Params{Activate: &activate}
-	_, _, err := getOktaClientFromMetadata(m).Application.CreateApplication(ctx, app, params)
+	_, _, err = getOktaClientFromMetadata(m).Application.CreateApplication(ctx, app, params)
 	if err != nil {
 		return diag.Errorf("failed to create secure password store application: %v", err)
 	}
@@ -136,17 +139,18 @@ func resourceAppSecurePasswordStoreRead(ctx context.Context, d *schema.ResourceD
 	_ = d.Set("credentials_scheme", app.Credentials.Scheme)
 	_ = d.Set("reveal_password", app.Credentials.RevealPassword)
 	_ = d.Set("shared_username", app.Credentials.UserName)
-	_ = d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
-	_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
-	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
+	setUserNameTemplate(d, m, app.Credentials.UserNameTemplate)
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 	return nil
 }
 
 func resourceAppSecurePasswordStoreUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
-	app := buildAppSecurePasswordStore(d)
-	_, _, err := client.Application.UpdateApplication(ctx, d.Id(), app)
+	app, err := buildAppSecurePasswordStore(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to update secure password store application: %v", err)
+	}
+	_, _, err = client.Application.UpdateApplication(ctx, d.Id(), app)
 	if err != nil {
 		return diag.Errorf("failed to update secure password store application: %v", err)
 	}
@@ -165,7 +169,7 @@ func resourceAppSecurePasswordStoreDelete(ctx context.Context, d *schema.Resourc
 	return nil
 }
 
-func buildAppSecurePasswordStore(d *schema.ResourceData) *okta.SecurePasswordStoreApplication {
+func buildAppSecurePasswordStore(ctx context.Context, d *schema.ResourceData, m interface{}) (*okta.SecurePasswordStoreApplication, error) {
 	// Abstracts away name and SignOnMode which are constant for this app type.
 	app := okta.NewSecurePasswordStoreApplication()
 	app.Label = d.Get("label").(string)
@@ -183,8 +187,13 @@ func buildAppSecurePasswordStore(d *schema.ResourceData) *okta.SecurePasswordSto
 			OptionalField3Value: d.Get("optional_field3_value").(string),
 		},
 	}
-	app.Credentials = buildSchemeCreds(d)
+	creds, err := buildSchemeCreds(ctx, d, m)
+	if err != nil {
+		return nil, err
+	}
+	app.Credentials = creds
+	app.Accessibility = buildAccessibility(d)
 	app.Visibility = buildVisibility(d)
 
-	return app
+	return app, nil
 }
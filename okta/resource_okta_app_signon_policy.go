@@ -0,0 +1,94 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceAppSignOnPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppSignOnPolicyCreate,
+		ReadContext:   resourceAppSignOnPolicyRead,
+		UpdateContext: resourceAppSignOnPolicyUpdate,
+		DeleteContext: resourceAppSignOnPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Policy Name",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Policy Description",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Policy Priority, this attribute can be set to a valid priority. To avoid endless diff situation we error if an invalid priority is provided. API defaults it to the last (lowest) if not there.",
+				// Suppress diff if config is empty.
+				DiffSuppressFunc: createValueDiffSuppression("0"),
+			},
+			"status":      buildStatusSchema("Policy Status: ACTIVE or INACTIVE."),
+			"export_json": exportJSONSchema,
+		},
+	}
+}
+
+func resourceAppSignOnPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	template := buildAppSignOnPolicy(d)
+	err := createPolicy(ctx, d, m, template)
+	if err != nil {
+		return diag.Errorf("failed to create app sign-on policy: %v", err)
+	}
+	return resourceAppSignOnPolicyRead(ctx, d, m)
+}
+
+func resourceAppSignOnPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	policy, err := getPolicy(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to get app sign-on policy: %v", err)
+	}
+	if policy == nil {
+		return nil
+	}
+	_ = d.Set("name", policy.Name)
+	_ = d.Set("description", policy.Description)
+	_ = d.Set("status", policy.Status)
+	_ = d.Set("priority", policy.Priority)
+	return nil
+}
+
+func resourceAppSignOnPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	template := buildAppSignOnPolicy(d)
+	err := updatePolicy(ctx, d, m, template)
+	if err != nil {
+		return diag.Errorf("failed to update app sign-on policy: %v", err)
+	}
+	return resourceAppSignOnPolicyRead(ctx, d, m)
+}
+
+func resourceAppSignOnPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := deletePolicy(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to delete app sign-on policy: %v", err)
+	}
+	return nil
+}
+
+func buildAppSignOnPolicy(d *schema.ResourceData) sdk.Policy {
+	template := sdk.AccessPolicy()
+	template.Name = d.Get("name").(string)
+	template.Description = d.Get("description").(string)
+	template.Status = d.Get("status").(string)
+	if priority, ok := d.GetOk("priority"); ok {
+		template.Priority = int64(priority.(int))
+	}
+	return template
+}
@@ -0,0 +1,364 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceAppSignOnPolicyRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppSignOnPolicyRuleCreate,
+		ReadContext:   resourceAppSignOnPolicyRuleRead,
+		UpdateContext: resourceAppSignOnPolicyRuleUpdate,
+		DeleteContext: resourceAppSignOnPolicyRuleDelete,
+		Importer:      createPolicyRuleImporter(),
+		Schema: buildRuleSchema(map[string]*schema.Schema{
+			"groups_included": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of Group IDs to Include",
+			},
+			"groups_excluded": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of Group IDs to Exclude",
+			},
+			"users_included": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of User IDs to Include",
+			},
+			"user_types_included": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of User Type IDs to Include",
+			},
+			"user_types_excluded": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of User Type IDs to Exclude",
+			},
+			"device_is_registered": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the device needs to be registered",
+			},
+			"device_is_managed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the device needs to be managed, requires 'device_is_registered' to be set",
+			},
+			"platform_include": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        platformIncludeResource,
+				Description: "Platforms to include",
+			},
+			"risk_score": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice([]string{"ANY", "LOW", "MEDIUM", "HIGH"}),
+				Description:      "Risk level: ANY, LOW, MEDIUM or HIGH",
+			},
+			"custom_expression": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringIsExpression,
+				Description:      "Okta Expression Language expression, which should evaluate to true for the rule to match",
+			},
+			"access": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ALLOW",
+				ValidateDiagFunc: stringInSlice([]string{"ALLOW", "DENY"}),
+				Description:      "Allow or deny access based on the rule conditions: ALLOW or DENY",
+			},
+			"factor_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "2FA",
+				ValidateDiagFunc: stringInSlice([]string{"1FA", "2FA"}),
+				Description:      "The number of factors required to satisfy this assurance level: 1FA or 2FA",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ASSURANCE",
+				Description: "The verification method type",
+			},
+			"re_authentication_frequency": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "PT2H",
+				Description: "The duration after which the end user must re-authenticate, in ISO 8601 format, e.g. 'PT2H'",
+			},
+			"inactivity_period": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The inactivity duration after which the end user must re-authenticate, in ISO 8601 format, e.g. 'PT1H'",
+			},
+			"constraints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: stringIsJSON,
+					StateFunc:        normalizeDataJSON,
+				},
+				Description: "List of JSON encoded constraints on the authenticators",
+			},
+		}),
+	}
+}
+
+func resourceAppSignOnPolicyRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateAppSignOnPolicyRule(d); err != nil {
+		return diag.FromErr(err)
+	}
+	logger(m).Info("creating app sign-on policy rule", "policy_id", d.Get("policyid").(string))
+	template, err := buildAppSignOnPolicyRule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rule, _, err := getSupplementFromMetadata(m).CreateAccessPolicyRule(ctx, d.Get("policyid").(string), template)
+	if err != nil {
+		return diag.Errorf("failed to create app sign-on policy rule: %v", err)
+	}
+	// We want to put this under Terraform's control even if priority is invalid.
+	d.SetId(rule.ID)
+	err = validatePriority(template.Priority, rule.Priority)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = policyRuleActivate(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to set app sign-on policy rule status: %v", err)
+	}
+	return resourceAppSignOnPolicyRuleRead(ctx, d, m)
+}
+
+func resourceAppSignOnPolicyRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger(m).Info("reading app sign-on policy rule", "id", d.Id(), "policy_id", d.Get("policyid").(string))
+	rule, resp, err := getSupplementFromMetadata(m).GetAccessPolicyRule(ctx, d.Get("policyid").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get app sign-on policy rule: %v", err)
+	}
+	if rule == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", rule.Name)
+	_ = d.Set("status", rule.Status)
+	_ = d.Set("priority", rule.Priority)
+	err = syncAppSignOnPolicyRuleConditions(d, rule.Conditions)
+	if err != nil {
+		return diag.Errorf("failed to set app sign-on policy rule conditions: %v", err)
+	}
+	err = syncAppSignOnPolicyRuleActions(d, rule.Actions)
+	if err != nil {
+		return diag.Errorf("failed to set app sign-on policy rule actions: %v", err)
+	}
+	return nil
+}
+
+func resourceAppSignOnPolicyRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateAppSignOnPolicyRule(d); err != nil {
+		return diag.FromErr(err)
+	}
+	logger(m).Info("updating app sign-on policy rule", "id", d.Id(), "policy_id", d.Get("policyid").(string))
+	template, err := buildAppSignOnPolicyRule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rule, _, err := getSupplementFromMetadata(m).UpdateAccessPolicyRule(ctx, d.Get("policyid").(string), d.Id(), template)
+	if err != nil {
+		return diag.Errorf("failed to update app sign-on policy rule: %v", err)
+	}
+	err = validatePriority(template.Priority, rule.Priority)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = policyRuleActivate(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to set app sign-on policy rule status: %v", err)
+	}
+	return resourceAppSignOnPolicyRuleRead(ctx, d, m)
+}
+
+func resourceAppSignOnPolicyRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := deleteRule(ctx, d, m, false)
+	if err != nil {
+		return diag.Errorf("failed to delete app sign-on policy rule: %v", err)
+	}
+	return nil
+}
+
+func buildAppSignOnPolicyRule(d *schema.ResourceData) (sdk.AccessPolicyRule, error) {
+	rule := sdk.AccessPolicyRule{
+		Name:   d.Get("name").(string),
+		Status: d.Get("status").(string),
+		Type:   sdk.AccessPolicyType,
+	}
+	if priority, ok := d.GetOk("priority"); ok {
+		rule.Priority = int64(priority.(int))
+	}
+	var constraints []map[string]interface{}
+	for _, v := range d.Get("constraints").([]interface{}) {
+		var constraint map[string]interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &constraint); err != nil {
+			return rule, fmt.Errorf("failed to unmarshal 'constraints': %v", err)
+		}
+		constraints = append(constraints, constraint)
+	}
+	rule.Actions = &sdk.AccessPolicyRuleActions{
+		AppSignOn: &sdk.AccessPolicyRuleAppSignOn{
+			Access: d.Get("access").(string),
+			VerificationMethod: &sdk.AccessPolicyRuleVerificationMethod{
+				Constraints:      constraints,
+				FactorMode:       d.Get("factor_mode").(string),
+				InactivityPeriod: d.Get("inactivity_period").(string),
+				ReauthenticateIn: d.Get("re_authentication_frequency").(string),
+				Type:             d.Get("type").(string),
+			},
+		},
+	}
+	rule.Conditions = &sdk.AccessPolicyRuleConditions{
+		Network: getNetwork(d),
+		People: &okta.PolicyPeopleCondition{
+			Groups: &okta.GroupCondition{
+				Include: convertInterfaceToStringSetNullable(d.Get("groups_included")),
+				Exclude: convertInterfaceToStringSetNullable(d.Get("groups_excluded")),
+			},
+			Users: &okta.UserCondition{
+				Include: convertInterfaceToStringSetNullable(d.Get("users_included")),
+				Exclude: convertInterfaceToStringSetNullable(d.Get("users_excluded")),
+			},
+		},
+	}
+	if d.Get("device_is_registered").(bool) {
+		rule.Conditions.Device = &sdk.AccessPolicyRuleDevice{
+			Registered: boolPtr(true),
+			Managed:    boolPtr(d.Get("device_is_managed").(bool)),
+		}
+	}
+	if platform := buildPlatformInclude(d); platform != nil {
+		rule.Conditions.Platform = &okta.PlatformPolicyRuleCondition{}
+		for _, include := range platform.Include {
+			rule.Conditions.Platform.Include = append(rule.Conditions.Platform.Include, &okta.PlatformConditionEvaluatorPlatform{
+				Os: &okta.PlatformConditionEvaluatorPlatformOperatingSystem{
+					Expression: include.Os.Expression,
+					Type:       include.Os.Type,
+				},
+				Type: include.Type,
+			})
+		}
+	}
+	if riskScore, ok := d.GetOk("risk_score"); ok {
+		rule.Conditions.RiskScore = &okta.RiskScorePolicyRuleCondition{Level: riskScore.(string)}
+	}
+	if expression, ok := d.GetOk("custom_expression"); ok {
+		rule.Conditions.ElCondition = &sdk.AccessPolicyRuleElCondition{Condition: expression.(string)}
+	}
+	include := convertInterfaceToStringSetNullable(d.Get("user_types_included"))
+	exclude := convertInterfaceToStringSetNullable(d.Get("user_types_excluded"))
+	if include != nil || exclude != nil {
+		rule.Conditions.UserType = &sdk.AccessPolicyRuleUserType{Include: include, Exclude: exclude}
+	}
+	return rule, nil
+}
+
+func syncAppSignOnPolicyRuleConditions(d *schema.ResourceData, conditions *sdk.AccessPolicyRuleConditions) error {
+	if conditions == nil {
+		return nil
+	}
+	m := map[string]interface{}{}
+	if conditions.Network != nil {
+		_ = d.Set("network_connection", conditions.Network.Connection)
+		m["network_includes"] = convertStringArrToInterface(conditions.Network.Include)
+		m["network_excludes"] = convertStringArrToInterface(conditions.Network.Exclude)
+	}
+	if people := conditions.People; people != nil {
+		if people.Groups != nil {
+			m["groups_included"] = convertStringSetToInterface(people.Groups.Include)
+			m["groups_excluded"] = convertStringSetToInterface(people.Groups.Exclude)
+		}
+		if people.Users != nil {
+			m["users_included"] = convertStringSetToInterface(people.Users.Include)
+			m["users_excluded"] = convertStringSetToInterface(people.Users.Exclude)
+		}
+	}
+	if conditions.UserType != nil {
+		m["user_types_included"] = convertStringSetToInterface(conditions.UserType.Include)
+		m["user_types_excluded"] = convertStringSetToInterface(conditions.UserType.Exclude)
+	}
+	if device := conditions.Device; device != nil {
+		_ = d.Set("device_is_registered", device.Registered != nil && *device.Registered)
+		_ = d.Set("device_is_managed", device.Managed != nil && *device.Managed)
+	} else {
+		_ = d.Set("device_is_registered", false)
+		_ = d.Set("device_is_managed", false)
+	}
+	if conditions.RiskScore != nil {
+		_ = d.Set("risk_score", conditions.RiskScore.Level)
+	}
+	if conditions.ElCondition != nil {
+		_ = d.Set("custom_expression", conditions.ElCondition.Condition)
+	}
+	if conditions.Platform != nil {
+		platform := &sdk.IdpDiscoveryRulePlatform{}
+		for _, include := range conditions.Platform.Include {
+			os := &sdk.IdpDiscoveryRulePlatformOS{}
+			if include.Os != nil {
+				os.Type = include.Os.Type
+				os.Expression = include.Os.Expression
+			}
+			platform.Include = append(platform.Include, &sdk.IdpDiscoveryRulePlatformInclude{Os: os, Type: include.Type})
+		}
+		m["platform_include"] = flattenPlatformInclude(platform)
+	}
+	return setNonPrimitives(d, m)
+}
+
+func syncAppSignOnPolicyRuleActions(d *schema.ResourceData, actions *sdk.AccessPolicyRuleActions) error {
+	if actions == nil || actions.AppSignOn == nil {
+		return nil
+	}
+	_ = d.Set("access", actions.AppSignOn.Access)
+	method := actions.AppSignOn.VerificationMethod
+	if method == nil {
+		return nil
+	}
+	_ = d.Set("factor_mode", method.FactorMode)
+	_ = d.Set("inactivity_period", method.InactivityPeriod)
+	_ = d.Set("re_authentication_frequency", method.ReauthenticateIn)
+	_ = d.Set("type", method.Type)
+	constraints := make([]interface{}, len(method.Constraints))
+	for i := range method.Constraints {
+		b, err := json.Marshal(method.Constraints[i])
+		if err != nil {
+			return fmt.Errorf("failed to marshal constraint: %v", err)
+		}
+		constraints[i] = string(b)
+	}
+	return setNonPrimitives(d, map[string]interface{}{"constraints": constraints})
+}
+
+func validateAppSignOnPolicyRule(d *schema.ResourceData) error {
+	if d.Get("device_is_managed").(bool) && !d.Get("device_is_registered").(bool) {
+		return fmt.Errorf("'device_is_managed' can only be set to true, when 'device_is_registered' is set to true")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaAppSignOnPolicyRule_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appSignOnPolicyRule)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appSignOnPolicyRule)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createRuleCheckDestroy(appSignOnPolicyRule),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "access", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "factor_mode", "2FA"),
+					resource.TestCheckResourceAttr(resourceName, "re_authentication_frequency", "PT2H"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "groups_included.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "device_is_registered", "true"),
+					resource.TestCheckResourceAttr(resourceName, "factor_mode", "1FA"),
+					resource.TestCheckResourceAttr(resourceName, "re_authentication_frequency", "PT43800H"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func deleteAppSignOnPolicies(client *testClient) error {
+	return deletePolicyByType(sdk.AccessPolicyType, client)
+}
+
+func TestAccOktaAppSignOnPolicy_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appSignOnPolicy)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	appConfig := mgr.GetFixtures("app.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appSignOnPolicy)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createPolicyCheckDestroy(appSignOnPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensurePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform Acceptance Test App Sign-On Policy"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensurePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform Acceptance Test App Sign-On Policy Updated"),
+				),
+			},
+			{
+				Config: appConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensurePolicyExists(resourceName),
+					resource.TestCheckResourceAttrPair(fmt.Sprintf("%s.test", appOAuth), "authentication_policy", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
@@ -16,9 +16,14 @@ func resourceAppSwa() *schema.Resource {
 		UpdateContext: resourceAppSwaUpdate,
 		DeleteContext: resourceAppSwaDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: appImporter,
 		},
 		Schema: buildAppSwaSchema(map[string]*schema.Schema{
+			"app_settings_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Application settings in JSON format. They are managed with the other attributes of the application.",
+			},
 			"preconfigured_app": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -56,7 +61,7 @@ func resourceAppSwa() *schema.Resource {
 
 func resourceAppSwaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
-	app := buildAppSwa(d)
+	app := buildAppSwa(d, m)
 	activate := d.Get("status").(string) == statusActive
 	params := &query.Params{Activate: &activate}
 	_, _, err := client.Application.CreateApplication(ctx, app, params)
@@ -90,9 +95,11 @@ func resourceAppSwaRead(ctx context.Context, d *schema.ResourceData, m interface
 	_ = d.Set("username_field", app.Settings.App.UsernameField)
 	_ = d.Set("url", app.Settings.App.Url)
 	_ = d.Set("url_regex", app.Settings.App.LoginUrlRegex)
-	_ = d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
-	_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
-	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
+	err = setAppSettingsFromStruct(d, app.Settings.App)
+	if err != nil {
+		return diag.Errorf("failed to set SWA app settings: %v", err)
+	}
+	setUserNameTemplate(d, m, app.Credentials.UserNameTemplate)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 	err = syncGroupsAndUsers(ctx, app.Id, d, m)
@@ -104,7 +111,7 @@ func resourceAppSwaRead(ctx context.Context, d *schema.ResourceData, m interface
 
 func resourceAppSwaUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
-	app := buildAppSwa(d)
+	app := buildAppSwa(d, m)
 	_, _, err := client.Application.UpdateApplication(ctx, d.Id(), app)
 	if err != nil {
 		return diag.Errorf("failed to update SWA application: %v", err)
@@ -136,7 +143,7 @@ func resourceAppSwaDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	return nil
 }
 
-func buildAppSwa(d *schema.ResourceData) *okta.SwaApplication {
+func buildAppSwa(d *schema.ResourceData, m interface{}) *okta.SwaApplication {
 	// Abstracts away name and SignOnMode which are constant for this app type.
 	app := okta.NewSwaApplication()
 	app.Label = d.Get("label").(string)
@@ -154,13 +161,10 @@ func buildAppSwa(d *schema.ResourceData) *okta.SwaApplication {
 			LoginUrlRegex: d.Get("url_regex").(string),
 		},
 	}
+	app.Accessibility = buildAccessibility(d)
 	app.Visibility = buildVisibility(d)
 	app.Credentials = &okta.ApplicationCredentials{
-		UserNameTemplate: &okta.ApplicationCredentialsUsernameTemplate{
-			Suffix:   d.Get("user_name_template_suffix").(string),
-			Template: d.Get("user_name_template").(string),
-			Type:     d.Get("user_name_template_type").(string),
-		},
+		UserNameTemplate: buildUserNameTemplate(d, m),
 	}
 	return app
 }
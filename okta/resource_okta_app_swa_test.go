@@ -78,6 +78,9 @@ func TestAccAppSwaApplication_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "button_field", "btn-login-updated"),
 					resource.TestCheckResourceAttr(resourceName, "password_field", "txtbox-password-updated"),
 					resource.TestCheckResourceAttr(resourceName, "username_field", "txtbox-username-updated"),
+					resource.TestCheckResourceAttr(resourceName, "accessibility_self_service", "true"),
+					resource.TestCheckResourceAttr(resourceName, "accessibility_error_redirect_url", "https://example.com/error.html"),
+					resource.TestCheckResourceAttr(resourceName, "accessibility_login_redirect_url", "https://example.com/login.html"),
 				),
 			},
 		},
@@ -16,7 +16,7 @@ func resourceAppThreeField() *schema.Resource {
 		UpdateContext: resourceAppThreeFieldUpdate,
 		DeleteContext: resourceAppThreeFieldDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: appImporter,
 		},
 
 		// For those familiar with Terraform schemas be sure to check the base application schema and/or
@@ -96,9 +96,7 @@ func resourceAppThreeFieldRead(ctx context.Context, d *schema.ResourceData, m in
 	_ = d.Set("extra_field_value", app.Settings.App.ExtraFieldValue)
 	_ = d.Set("url", app.Settings.App.TargetURL)
 	_ = d.Set("url_regex", app.Settings.App.LoginUrlRegex)
-	_ = d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
-	_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
-	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
+	setUserNameTemplate(d, m, app.Credentials.UserNameTemplate)
 	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 	return nil
@@ -149,6 +147,7 @@ func buildAppThreeField(d *schema.ResourceData) *okta.SwaThreeFieldApplication {
 			LoginUrlRegex:      d.Get("url_regex").(string),
 		},
 	}
+	app.Accessibility = buildAccessibility(d)
 	app.Visibility = buildVisibility(d)
 
 	return app
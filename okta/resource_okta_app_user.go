@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -45,26 +46,32 @@ func resourceAppUser() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "App to associate user with",
+				ForceNew:    true,
 			},
 			"user_id": {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "User associated with the application",
+				ForceNew:    true,
 			},
 			"username": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Username of the user in the application. If it's not set, it's generated from the username template of the application.",
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Sensitive: true,
-				Optional:  true,
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Optional:    true,
+				Description: "Password of the user in the application, it may refer to the AWS Secrets Manager or Vault secret",
 			},
 			"profile": {
 				Type:             schema.TypeString,
 				ValidateDiagFunc: stringIsJSON,
 				StateFunc:        normalizeDataJSON,
 				Optional:         true,
+				Description:      "App user profile in JSON format. Only the attributes set here are managed.",
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return new == ""
 				},
@@ -80,10 +87,14 @@ func resourceAppUser() *schema.Resource {
 }
 
 func resourceAppUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	appUser, err := getAppUser(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to assign user to application: %v", err)
+	}
 	u, _, err := getOktaClientFromMetadata(m).Application.AssignUserToApplication(
 		ctx,
 		d.Get("app_id").(string),
-		*getAppUser(d),
+		*appUser,
 	)
 	if err != nil {
 		return diag.Errorf("failed to assign user to application: %v", err)
@@ -93,11 +104,15 @@ func resourceAppUserCreate(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceAppUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	_, _, err := getOktaClientFromMetadata(m).Application.UpdateApplicationUser(
+	appUser, err := getAppUser(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to update application's user: %v", err)
+	}
+	_, _, err = getOktaClientFromMetadata(m).Application.UpdateApplicationUser(
 		ctx,
 		d.Get("app_id").(string),
 		d.Get("user_id").(string),
-		*getAppUser(d),
+		*appUser,
 	)
 	if err != nil {
 		return diag.Errorf("failed to update application's user: %v", err)
@@ -119,13 +134,10 @@ func resourceAppUserRead(ctx context.Context, d *schema.ResourceData, m interfac
 	if err != nil {
 		return diag.Errorf("failed to get application's user: %v", err)
 	}
-	var rawProfile string
-	if u.Profile != nil {
-		p, _ := json.Marshal(u.Profile)
-		rawProfile = string(p)
+	_ = d.Set("profile", assignmentProfileJSON(u.Profile, d.Get("profile").(string)))
+	if u.Credentials != nil {
+		_ = d.Set("username", u.Credentials.UserName)
 	}
-	_ = d.Set("profile", rawProfile)
-	_ = d.Set("username", u.Credentials.UserName)
 	return nil
 }
 
@@ -136,33 +148,37 @@ func resourceAppUserDelete(ctx context.Context, d *schema.ResourceData, m interf
 		return nil
 	}
 
-	_, err := getOktaClientFromMetadata(m).Application.DeleteApplicationUser(
+	resp, err := getOktaClientFromMetadata(m).Application.DeleteApplicationUser(
 		ctx,
 		d.Get("app_id").(string),
 		d.Get("user_id").(string),
 		nil,
 	)
-	if err != nil {
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to delete application's user: %v", err)
 	}
 	return nil
 }
 
-func getAppUser(d *schema.ResourceData) *okta.AppUser {
+func getAppUser(ctx context.Context, d *schema.ResourceData, m interface{}) (*okta.AppUser, error) {
 	var profile interface{}
 
 	rawProfile := d.Get("profile").(string)
 	// JSON is already validated
 	_ = json.Unmarshal([]byte(rawProfile), &profile)
 
+	password, err := resolveSecret(ctx, m, d.Get("password").(string))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve password: %v", err)
+	}
 	return &okta.AppUser{
 		Id: d.Get("user_id").(string),
 		Credentials: &okta.AppUserCredentials{
 			UserName: d.Get("username").(string),
 			Password: &okta.AppUserPasswordCredential{
-				Value: d.Get("password").(string),
+				Value: password,
 			},
 		},
 		Profile: profile,
-	}
+	}, nil
 }
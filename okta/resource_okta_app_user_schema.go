@@ -44,10 +44,11 @@ func resourceAppUserSchema() *schema.Resource {
 					Type:     schema.TypeString,
 					Optional: true,
 					// Accepting an empty value to allow for zero value (when provisioning is off)
-					ValidateDiagFunc: stringInSlice([]string{"PROFILE_MASTER", "OKTA", ""}),
+					ValidateDiagFunc: stringInSlice([]string{"PROFILE_MASTER", "OKTA", "OVERRIDE", ""}),
 					Description:      "SubSchema profile manager, if not set it will inherit its setting.",
 					Default:          "PROFILE_MASTER",
 				},
+				"master_override_priority": masterOverridePrioritySchema,
 			}),
 		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
@@ -84,7 +85,7 @@ func resourceAppUserSchemaResourceV1() *schema.Resource {
 			ValidateDiagFunc: stringInSlice([]string{"SELF", "NONE", ""}),
 			ForceNew:         true, // since the `scope` is read-only attribute, the resource should be recreated
 		},
-	}, userSchemaSchema, userBaseSchemaSchema, userTypeSchema, userPatternSchema)}
+	}, userSchemaSchemaV1, userBaseSchemaSchema, userTypeSchema, userPatternSchema)}
 }
 
 func resourceAppUserSchemaResourceV0() *schema.Resource {
@@ -100,7 +101,7 @@ func resourceAppUserSchemaResourceV0() *schema.Resource {
 			ValidateDiagFunc: stringInSlice([]string{"SELF", "NONE", ""}),
 			ForceNew:         true, // since the `scope` is read-only attribute, the resource should be recreated
 		},
-	}, userSchemaSchema, userBaseSchemaSchema)}
+	}, userSchemaSchemaV1, userBaseSchemaSchema)}
 }
 
 func resourceAppUserSchemaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -186,5 +187,5 @@ func validateAppUserSchema(d *schema.ResourceData) error {
 			}
 		}
 	}
-	return nil
+	return validateMasterOverridePriority(d)
 }
@@ -17,6 +17,7 @@ func TestAccAppUserSchemas_crud(t *testing.T) {
 	mgr := newFixtureManager(appUserSchema)
 	config := mgr.GetFixtures("basic.tf", ri, t)
 	updated := mgr.GetFixtures("updated.tf", ri, t)
+	masterOverride := mgr.GetFixtures("master_override.tf", ri, t)
 	resourceName := fmt.Sprintf("%s.test", appUserSchema)
 
 	resource.Test(t, resource.TestCase{
@@ -57,6 +58,16 @@ func TestAccAppUserSchemas_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "scope", "SELF"),
 				),
 			},
+			{
+				Config: masterOverride,
+				Check: resource.ComposeTestCheckFunc(
+					testAppUserSchemasExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "master", "OVERRIDE"),
+					resource.TestCheckResourceAttr(resourceName, "master_override_priority.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "master_override_priority.0.type", "APP"),
+					resource.TestCheckResourceAttrPair(resourceName, "master_override_priority.0.value", "okta_app_oauth.test", "id"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,219 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func resourceAppWsFederation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppWsFederationCreate,
+		ReadContext:   resourceAppWsFederationRead,
+		UpdateContext: resourceAppWsFederationUpdate,
+		DeleteContext: resourceAppWsFederationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: appImporter,
+		},
+		Schema: buildAppSchemaWithVisibility(map[string]*schema.Schema{
+			"site_url": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Launch URL for the Web Application",
+				ValidateDiagFunc: stringIsURL(validURLSchemes...),
+			},
+			"reply_url": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The ReplyTo URL to which responses are directed",
+				ValidateDiagFunc: stringIsURL(validURLSchemes...),
+			},
+			"reply_override": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable web application to override ReplyTo URL with reply param",
+			},
+			"realm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The URI of the WS-Federation Relying Party",
+			},
+			"audience_restriction": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The assertion containing a bearer subject confirmation MUST contain an Audience Restriction including the service provider's unique identifier as an Audience",
+			},
+			"name_id_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name ID Format",
+			},
+			"authn_context_class_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Authentication Context Class Reference",
+			},
+			"username_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies additional username attribute statements to include in the SAML Assertion",
+			},
+			"group_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An expression that will be used to filter groups",
+			},
+			"group_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The group name to include in the SAML Assertion attribute statement",
+			},
+			"group_value_format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Specifies the SAML assertion attribute value for filtered groups",
+				ValidateDiagFunc: stringInSlice([]string{"windowsDomainQualifiedName", "samAccountName", "dn"}),
+			},
+			"attribute_statements": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Custom attribute statements, e.g. 'givenname|${user.firstName}|,surname|${user.lastName}|'",
+			},
+		}),
+	}
+}
+
+func resourceAppWsFederationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	app := buildAppWsFederation(d)
+	activate := d.Get("status").(string) == statusActive
+	params := &query.Params{Activate: &activate}
+	_, _, err := client.Application.CreateApplication(ctx, app, params)
+	if err != nil {
+		return diag.Errorf("failed to create WS-Federation application: %v", err)
+	}
+	d.SetId(app.Id)
+	err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+	if err != nil {
+		return diag.Errorf("failed to handle groups and users for WS-Federation application: %v", err)
+	}
+	err = handleAppLogo(ctx, d, m, app.Id, app.Links)
+	if err != nil {
+		return diag.Errorf("failed to upload logo for WS-Federation application: %v", err)
+	}
+	return resourceAppWsFederationRead(ctx, d, m)
+}
+
+func resourceAppWsFederationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	app := okta.NewWsFederationApplication()
+	err := fetchApp(ctx, d, m, app)
+	if err != nil {
+		return diag.Errorf("failed to get WS-Federation application: %v", err)
+	}
+	if app.Id == "" {
+		d.SetId("")
+		return nil
+	}
+	if app.Settings != nil && app.Settings.App != nil {
+		settings := app.Settings.App
+		_ = d.Set("site_url", settings.SiteURL)
+		_ = d.Set("reply_url", settings.WReplyURL)
+		if settings.WReplyOverride != nil {
+			_ = d.Set("reply_override", *settings.WReplyOverride)
+		}
+		_ = d.Set("realm", settings.Realm)
+		_ = d.Set("audience_restriction", settings.AudienceRestriction)
+		_ = d.Set("name_id_format", settings.NameIDFormat)
+		_ = d.Set("authn_context_class_ref", settings.AuthnContextClassRef)
+		_ = d.Set("username_attribute", settings.UsernameAttribute)
+		_ = d.Set("group_filter", settings.GroupFilter)
+		_ = d.Set("group_name", settings.GroupName)
+		_ = d.Set("group_value_format", settings.GroupValueFormat)
+		_ = d.Set("attribute_statements", settings.AttributeStatements)
+	}
+	_ = d.Set("name", app.Name)
+	_ = d.Set("status", app.Status)
+	_ = d.Set("sign_on_mode", app.SignOnMode)
+	_ = d.Set("label", app.Label)
+	_ = d.Set("auto_submit_toolbar", app.Visibility.AutoSubmitToolbar)
+	_ = d.Set("hide_ios", app.Visibility.Hide.IOS)
+	_ = d.Set("hide_web", app.Visibility.Hide.Web)
+	setAppLinks(d, app.Visibility.AppLinks)
+	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
+	err = syncGroupsAndUsers(ctx, app.Id, d, m)
+	if err != nil {
+		return diag.Errorf("failed to sync groups and users for WS-Federation application: %v", err)
+	}
+	return nil
+}
+
+func resourceAppWsFederationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	app := buildAppWsFederation(d)
+	_, _, err := client.Application.UpdateApplication(ctx, d.Id(), app)
+	if err != nil {
+		return diag.Errorf("failed to update WS-Federation application: %v", err)
+	}
+	err = setAppStatus(ctx, d, client, app.Status)
+	if err != nil {
+		return diag.Errorf("failed to set WS-Federation application status: %v", err)
+	}
+	err = handleAppGroupsAndUsers(ctx, app.Id, d, m)
+	if err != nil {
+		return diag.Errorf("failed to handle groups and users for WS-Federation application: %v", err)
+	}
+	if d.HasChange("logo") {
+		err = handleAppLogo(ctx, d, m, app.Id, app.Links)
+		if err != nil {
+			o, _ := d.GetChange("logo")
+			_ = d.Set("logo", o)
+			return diag.Errorf("failed to upload logo for WS-Federation application: %v", err)
+		}
+	}
+	return resourceAppWsFederationRead(ctx, d, m)
+}
+
+func resourceAppWsFederationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := deleteApplication(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to delete WS-Federation application: %v", err)
+	}
+	return nil
+}
+
+func buildAppWsFederation(d *schema.ResourceData) *okta.WsFederationApplication {
+	// Abstracts away name and SignOnMode which are constant for this app type.
+	app := okta.NewWsFederationApplication()
+	app.Label = d.Get("label").(string)
+
+	replyOverride := d.Get("reply_override").(bool)
+	app.Settings = &okta.WsFederationApplicationSettings{
+		App: &okta.WsFederationApplicationSettingsApplication{
+			SiteURL:              d.Get("site_url").(string),
+			WReplyURL:            d.Get("reply_url").(string),
+			WReplyOverride:       &replyOverride,
+			Realm:                d.Get("realm").(string),
+			AudienceRestriction:  d.Get("audience_restriction").(string),
+			NameIDFormat:         d.Get("name_id_format").(string),
+			AuthnContextClassRef: d.Get("authn_context_class_ref").(string),
+			UsernameAttribute:    d.Get("username_attribute").(string),
+			GroupFilter:          d.Get("group_filter").(string),
+			GroupName:            d.Get("group_name").(string),
+			GroupValueFormat:     d.Get("group_value_format").(string),
+			AttributeStatements:  d.Get("attribute_statements").(string),
+		},
+	}
+	app.Visibility = buildVisibility(d)
+
+	return app
+}
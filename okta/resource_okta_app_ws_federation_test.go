@@ -0,0 +1,52 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func TestAccAppWsFederationApplication_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appWsFederation)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appWsFederation)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appWsFederation, createDoesAppExist(okta.NewWsFederationApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewWsFederationApplication())),
+					resource.TestCheckResourceAttr(resourceName, "label", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "sign_on_mode", "WS_FEDERATION"),
+					resource.TestCheckResourceAttr(resourceName, "site_url", "https://sharepoint.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "reply_url", "https://sharepoint.example.com/_trust/"),
+					resource.TestCheckResourceAttr(resourceName, "reply_override", "false"),
+					resource.TestCheckResourceAttr(resourceName, "realm", fmt.Sprintf("urn:sharepoint:%s", buildResourceName(ri))),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewWsFederationApplication())),
+					resource.TestCheckResourceAttr(resourceName, "label", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "reply_override", "true"),
+					resource.TestCheckResourceAttr(resourceName, "group_filter", "app1.*"),
+					resource.TestCheckResourceAttr(resourceName, "group_value_format", "dn"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_statements", "givenname|${user.firstName}|,surname|${user.lastName}|"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+				),
+			},
+		},
+	})
+}
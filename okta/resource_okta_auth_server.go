@@ -137,16 +137,15 @@ func resourceAuthServerUpdate(ctx context.Context, d *schema.ResourceData, m int
 
 func handleAuthServerLifecycle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
-	if d.Get("status").(string) == statusActive {
+	err := changeStatus("", d.Get("status").(string), func() error {
 		_, err := client.AuthorizationServer.ActivateAuthorizationServer(ctx, d.Id())
-		if err != nil {
-			return diag.Errorf("failed to activate authorization server: %v", err)
-		}
-		return nil
-	}
-	_, err := client.AuthorizationServer.DeactivateAuthorizationServer(ctx, d.Id())
+		return err
+	}, func() error {
+		_, err := client.AuthorizationServer.DeactivateAuthorizationServer(ctx, d.Id())
+		return err
+	})
 	if err != nil {
-		return diag.Errorf("failed to deactivate authorization server: %v", err)
+		return diag.Errorf("failed to change authorization server status: %v", err)
 	}
 	return nil
 }
@@ -55,10 +55,7 @@ func resourceAuthServerClaimDefault() *schema.Resource {
 				Description: "Auth server claim list of scopes",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
-			"status": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+			"status": buildComputedStatusSchema("Status of the claim"),
 			"value": {
 				Type:     schema.TypeString,
 				Optional: true,
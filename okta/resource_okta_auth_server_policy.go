@@ -92,15 +92,15 @@ func resourceAuthServerPolicyUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("failed to update auth server policy: %v", err)
 	}
 	oldStatus, newStatus := d.GetChange("status")
-	if oldStatus != newStatus {
-		if newStatus == statusActive {
-			_, err = getSupplementFromMetadata(m).ActivateAuthorizationServerPolicy(ctx, d.Get("auth_server_id").(string), d.Id())
-		} else {
-			_, err = getSupplementFromMetadata(m).DeactivateAuthorizationServerPolicy(ctx, d.Get("auth_server_id").(string), d.Id())
-		}
-		if err != nil {
-			return diag.Errorf("failed to change authorization server policy status: %v", err)
-		}
+	err = changeStatus(oldStatus.(string), newStatus.(string), func() error {
+		_, err := getSupplementFromMetadata(m).ActivateAuthorizationServerPolicy(ctx, d.Get("auth_server_id").(string), d.Id())
+		return err
+	}, func() error {
+		_, err := getSupplementFromMetadata(m).DeactivateAuthorizationServerPolicy(ctx, d.Get("auth_server_id").(string), d.Id())
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("failed to change authorization server policy status: %v", err)
 	}
 	return resourceAuthServerPolicyRead(ctx, d, m)
 }
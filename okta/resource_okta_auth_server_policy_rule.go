@@ -83,24 +83,28 @@ func resourceAuthServerPolicyRule() *schema.Resource {
 				Optional: true,
 			},
 			"user_whitelist": {
-				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Optional: true,
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Specifies a set of Users to be included",
 			},
 			"user_blacklist": {
-				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Optional: true,
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Specifies a set of Users to be excluded",
 			},
 			"group_whitelist": {
-				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Optional: true,
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Specifies a set of Groups whose Users are to be included. Can be set to Group ID or to the following: 'EVERYONE'",
 			},
 			"group_blacklist": {
-				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Optional: true,
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Specifies a set of Groups whose Users are to be excluded",
 			},
 		},
 	}
@@ -188,18 +192,17 @@ func resourceAuthServerPolicyRuleUpdate(ctx context.Context, d *schema.ResourceD
 
 func handleAuthServerPolicyRuleLifecycle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getSupplementFromMetadata(m)
-	if d.Get("status").(string) == statusActive {
+	err := changeStatus("", d.Get("status").(string), func() error {
 		_, err := client.ActivateAuthorizationServerPolicyRule(ctx, d.Get("auth_server_id").(string),
 			d.Get("policy_id").(string), d.Id())
-		if err != nil {
-			return diag.Errorf("failed to activate authorization server: %v", err)
-		}
-		return nil
-	}
-	_, err := client.DeactivateAuthorizationServerPolicyRule(ctx, d.Get("auth_server_id").(string),
-		d.Get("policy_id").(string), d.Id())
+		return err
+	}, func() error {
+		_, err := client.DeactivateAuthorizationServerPolicyRule(ctx, d.Get("auth_server_id").(string),
+			d.Get("policy_id").(string), d.Id())
+		return err
+	})
 	if err != nil {
-		return diag.Errorf("failed to deactivate authorization server: %v", err)
+		return diag.Errorf("failed to change authorization server policy rule status: %v", err)
 	}
 	return nil
 }
@@ -249,7 +252,12 @@ func buildAuthServerPolicyRule(d *schema.ResourceData) *sdk.AuthorizationServerP
 	}
 }
 
+// setPeopleAssignments sets the user and group conditions of the rule. The API omits the conditions, which are not set,
+// e.g. the rule that issues tokens only to the members of the groups doesn't have the users condition.
 func setPeopleAssignments(d *schema.ResourceData, c *okta.GroupRulePeopleCondition) error {
+	if c == nil {
+		c = &okta.GroupRulePeopleCondition{}
+	}
 	if c.Groups != nil {
 		err := setNonPrimitives(d, map[string]interface{}{
 			"group_whitelist": convertStringSetToInterface(c.Groups.Include),
@@ -264,6 +272,9 @@ func setPeopleAssignments(d *schema.ResourceData, c *okta.GroupRulePeopleConditi
 			"group_blacklist": convertStringSetToInterface([]string{}),
 		})
 	}
+	if c.Users == nil {
+		c.Users = &okta.GroupRuleUserCondition{}
+	}
 	return setNonPrimitives(d, map[string]interface{}{
 		"user_whitelist": convertStringSetToInterface(c.Users.Include),
 		"user_blacklist": convertStringSetToInterface(c.Users.Exclude),
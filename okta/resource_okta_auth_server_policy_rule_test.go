@@ -25,6 +25,8 @@ func TestAccOktaAuthServerPolicyRule_create(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
 					resource.TestCheckResourceAttr(resourceName, "name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "group_whitelist.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_whitelist.#", "0"),
 				),
 			},
 			{
@@ -32,6 +34,8 @@ func TestAccOktaAuthServerPolicyRule_create(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
 					resource.TestCheckResourceAttr(resourceName, "name", "test_updated"),
+					resource.TestCheckResourceAttr(resourceName, "group_whitelist.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_whitelist.#", "1"),
 				),
 			},
 		},
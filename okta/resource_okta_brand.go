@@ -0,0 +1,127 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceBrand() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBrandUpdate,
+		ReadContext:   resourceBrandRead,
+		UpdateContext: resourceBrandUpdate,
+		DeleteContext: resourceBrandDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("brand_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"brand_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the brand. If not set, the default brand of the organization is managed",
+			},
+			"custom_privacy_policy_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringIsURL(validURLSchemes...),
+				Description:      "Custom privacy policy URL, it replaces the Okta privacy policy in the footer of the Okta-hosted pages",
+			},
+			"remove_powered_by_okta": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Removes 'Powered by Okta' from the Okta-hosted sign-in page and 'Okta' from the footer of the Okta-hosted pages",
+			},
+		},
+	}
+}
+
+func resourceBrandRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	brand, resp, err := getSupplementFromMetadata(m).GetBrand(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get brand: %v", err)
+	}
+	if brand == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("brand_id", brand.Id)
+	_ = d.Set("custom_privacy_policy_url", brand.CustomPrivacyPolicyUrl)
+	_ = d.Set("remove_powered_by_okta", brand.RemovePoweredByOkta)
+	return nil
+}
+
+// resourceBrandUpdate changes the settings of the existing brand, the brands can't be created.
+func resourceBrandUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.Id() == "" {
+		id := d.Get("brand_id").(string)
+		if id == "" {
+			brand, err := findDefaultBrand(ctx, m)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			id = brand.Id
+		}
+		d.SetId(id)
+	}
+	_, _, err := getSupplementFromMetadata(m).UpdateBrand(ctx, d.Id(), buildBrand(d))
+	if err != nil {
+		return diag.Errorf("failed to update brand: %v", err)
+	}
+	return resourceBrandRead(ctx, d, m)
+}
+
+// Brand can not be removed
+func resourceBrandDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+func buildBrand(d *schema.ResourceData) sdk.Brand {
+	url := d.Get("custom_privacy_policy_url").(string)
+	return sdk.Brand{
+		AgreeToCustomPrivacyPolicy: url != "",
+		CustomPrivacyPolicyUrl:     url,
+		RemovePoweredByOkta:        d.Get("remove_powered_by_okta").(bool),
+	}
+}
+
+// findDefaultBrand returns the default brand of the organization, which is the only one, until the multibrand
+// customization is enabled.
+func findDefaultBrand(ctx context.Context, m interface{}) (*sdk.Brand, error) {
+	brands, _, err := getSupplementFromMetadata(m).ListBrands(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list brands: %v", err)
+	}
+	if len(brands) == 0 {
+		return nil, errors.New("organization does not have any brands")
+	}
+	for _, brand := range brands {
+		if brand.IsDefault {
+			return brand, nil
+		}
+	}
+	if len(brands) == 1 {
+		return brands[0], nil
+	}
+	// older orgs don't mark the default brand, it's the one without custom domains
+	for _, brand := range brands {
+		domains, _, err := getSupplementFromMetadata(m).ListBrandDomains(ctx, brand.Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list brand's domains: %v", err)
+		}
+		if len(domains) == 0 {
+			return brand, nil
+		}
+	}
+	return nil, errors.New("failed to find the default brand of the organization, set 'brand_id' explicitly")
+}
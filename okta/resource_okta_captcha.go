@@ -0,0 +1,94 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceCaptcha() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCaptchaCreate,
+		ReadContext:   resourceCaptchaRead,
+		UpdateContext: resourceCaptchaUpdate,
+		DeleteContext: resourceCaptchaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the CAPTCHA instance",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringInSlice([]string{"HCAPTCHA", "RECAPTCHA_V2"}),
+				Description:      "Type of the CAPTCHA provider: HCAPTCHA or RECAPTCHA_V2",
+			},
+			"site_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Site key issued by the CAPTCHA provider",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Secret key issued by the CAPTCHA provider",
+			},
+		},
+	}
+}
+
+func resourceCaptchaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	captcha, _, err := getSupplementFromMetadata(m).CreateCaptcha(ctx, buildCaptcha(d))
+	if err != nil {
+		return diag.Errorf("failed to create CAPTCHA: %v", err)
+	}
+	d.SetId(captcha.Id)
+	return resourceCaptchaRead(ctx, d, m)
+}
+
+func resourceCaptchaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	captcha, resp, err := getSupplementFromMetadata(m).GetCaptcha(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get CAPTCHA: %v", err)
+	}
+	if captcha == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", captcha.Name)
+	_ = d.Set("type", captcha.Type)
+	_ = d.Set("site_key", captcha.SiteKey)
+	return nil
+}
+
+func resourceCaptchaUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, _, err := getSupplementFromMetadata(m).UpdateCaptcha(ctx, d.Id(), buildCaptcha(d))
+	if err != nil {
+		return diag.Errorf("failed to update CAPTCHA: %v", err)
+	}
+	return resourceCaptchaRead(ctx, d, m)
+}
+
+func resourceCaptchaDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteCaptcha(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete CAPTCHA: %v", err)
+	}
+	return nil
+}
+
+func buildCaptcha(d *schema.ResourceData) sdk.Captcha {
+	return sdk.Captcha{
+		Name:      d.Get("name").(string),
+		Type:      d.Get("type").(string),
+		SiteKey:   d.Get("site_key").(string),
+		SecretKey: d.Get("secret_key").(string),
+	}
+}
@@ -0,0 +1,95 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// There is a single CAPTCHA binding in the org, so the ID of the resource is constant.
+const captchaOrgWideSettingsID = "captcha_org_wide_settings"
+
+func resourceCaptchaOrgWideSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCaptchaOrgWideSettingsCreate,
+		ReadContext:   resourceCaptchaOrgWideSettingsRead,
+		UpdateContext: resourceCaptchaOrgWideSettingsUpdate,
+		DeleteContext: resourceCaptchaOrgWideSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.SetId(captchaOrgWideSettingsID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"captcha_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the CAPTCHA instance",
+			},
+			"enabled_for": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: stringInSlice([]string{"SSR", "SIGN_IN", "CHANGE_PASSWORD"}),
+				},
+				Description: "Pages, where the CAPTCHA is enabled: SSR (self-service registration), SIGN_IN and CHANGE_PASSWORD (password recovery)",
+			},
+		},
+	}
+}
+
+func resourceCaptchaOrgWideSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, _, err := getSupplementFromMetadata(m).UpdateOrgCaptchaSettings(ctx, buildOrgCaptchaSettings(d))
+	if err != nil {
+		return diag.Errorf("failed to set org-wide CAPTCHA settings: %v", err)
+	}
+	d.SetId(captchaOrgWideSettingsID)
+	return resourceCaptchaOrgWideSettingsRead(ctx, d, m)
+}
+
+func resourceCaptchaOrgWideSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	settings, resp, err := getSupplementFromMetadata(m).GetOrgCaptchaSettings(ctx)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get org-wide CAPTCHA settings: %v", err)
+	}
+	if settings == nil || settings.CaptchaId == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("captcha_id", *settings.CaptchaId)
+	err = setNonPrimitives(d, map[string]interface{}{
+		"enabled_for": convertStringSetToInterface(settings.EnabledPages),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set org-wide CAPTCHA settings: %v", err)
+	}
+	return nil
+}
+
+func resourceCaptchaOrgWideSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, _, err := getSupplementFromMetadata(m).UpdateOrgCaptchaSettings(ctx, buildOrgCaptchaSettings(d))
+	if err != nil {
+		return diag.Errorf("failed to update org-wide CAPTCHA settings: %v", err)
+	}
+	return resourceCaptchaOrgWideSettingsRead(ctx, d, m)
+}
+
+func resourceCaptchaOrgWideSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteOrgCaptchaSettings(ctx)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete org-wide CAPTCHA settings: %v", err)
+	}
+	return nil
+}
+
+func buildOrgCaptchaSettings(d *schema.ResourceData) sdk.OrgCaptchaSettings {
+	return sdk.OrgCaptchaSettings{
+		CaptchaId:    stringPtr(d.Get("captcha_id").(string)),
+		EnabledPages: convertInterfaceToStringSet(d.Get("enabled_for")),
+	}
+}
@@ -0,0 +1,53 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaCaptchaOrgWideSettings(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(captchaOrgWideSettings)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", captchaOrgWideSettings)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(captchaOrgWideSettings, doesCaptchaOrgWideSettingsExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "captcha_id", fmt.Sprintf("%s.test", captcha), "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_for.#", "1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "captcha_id", fmt.Sprintf("%s.test", captcha), "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_for.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func doesCaptchaOrgWideSettingsExist(string) (bool, error) {
+	settings, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetOrgCaptchaSettings(context.Background())
+	if exists, err := doesResourceExist(response, err); !exists || err != nil {
+		return exists, err
+	}
+	return settings.CaptchaId != nil, nil
+}
@@ -0,0 +1,75 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func sweepCaptchas(client *testClient) error {
+	var errorList []error
+	captchas, _, err := client.apiSupplement.ListCaptchas(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, captcha := range captchas {
+		if !strings.HasPrefix(captcha.Name, testResourcePrefix) {
+			continue
+		}
+		if _, err := client.apiSupplement.DeleteCaptcha(context.Background(), captcha.Id); err != nil {
+			errorList = append(errorList, err)
+		}
+	}
+	return condenseError(errorList)
+}
+
+func TestAccOktaCaptcha(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(captcha)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", captcha)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(captcha, doesCaptchaExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					saveResourceID(resourceName, &id),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "type", "HCAPTCHA"),
+					resource.TestCheckResourceAttr(resourceName, "site_key", "random_key"),
+					resource.TestCheckResourceAttr(resourceName, "secret_key", "random_key"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &id),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)+"_updated"),
+					resource.TestCheckResourceAttr(resourceName, "type", "RECAPTCHA_V2"),
+					resource.TestCheckResourceAttr(resourceName, "site_key", "random_key_updated"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
+		},
+	})
+}
+
+func doesCaptchaExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetCaptcha(context.Background(), id)
+	return doesResourceExist(response, err)
+}
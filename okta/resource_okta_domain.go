@@ -0,0 +1,157 @@
+package okta
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDomainCreate,
+		ReadContext:   resourceDomainRead,
+		UpdateContext: resourceDomainUpdate,
+		DeleteContext: resourceDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Custom domain name, e.g. 'login.example.com'",
+			},
+			"brand_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the brand the domain is associated with. If not set, the domain is associated with the default brand",
+			},
+			"certificate_source_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: stringInSlice([]string{domainCertificateManual, domainCertificateOktaManaged}),
+				Description:      "Certificate source type: MANUAL, when the certificate is uploaded with 'okta_domain_certificate', or OKTA_MANAGED, when the certificate is issued and renewed by Okta. Default is MANUAL",
+			},
+			"validation_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the domain",
+			},
+			"dns_records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "TXT and CNAME records to be registered for the domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiration": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "TXT record expiration",
+						},
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record name",
+						},
+						"record_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Record type can be TXT or CNAME",
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "DNS verification value",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	domain, _, err := getSupplementFromMetadata(m).CreateDomain(ctx, buildDomain(d))
+	if err != nil {
+		return diag.Errorf("failed to create domain: %v", err)
+	}
+	d.SetId(domain.Id)
+	return resourceDomainRead(ctx, d, m)
+}
+
+func resourceDomainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	domain, resp, err := getSupplementFromMetadata(m).GetDomain(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get domain: %v", err)
+	}
+	if domain == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", domain.Domain)
+	_ = d.Set("brand_id", domain.BrandId)
+	_ = d.Set("certificate_source_type", domain.CertificateSourceType)
+	_ = d.Set("validation_status", domain.ValidationStatus)
+	err = setNonPrimitives(d, map[string]interface{}{
+		"dns_records": flattenDNSRecords(domain.DnsRecords),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set DNS records: %v", err)
+	}
+	return nil
+}
+
+func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("brand_id") {
+		_, _, err := getSupplementFromMetadata(m).UpdateDomain(ctx, d.Id(), d.Get("brand_id").(string))
+		if err != nil {
+			return diag.Errorf("failed to associate domain with the brand: %v", err)
+		}
+	}
+	return resourceDomainRead(ctx, d, m)
+}
+
+func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteDomain(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete domain: %v", err)
+	}
+	return nil
+}
+
+func buildDomain(d *schema.ResourceData) sdk.Domain {
+	sourceType := d.Get("certificate_source_type").(string)
+	if sourceType == "" {
+		sourceType = domainCertificateManual
+	}
+	return sdk.Domain{
+		Domain:                d.Get("name").(string),
+		BrandId:               d.Get("brand_id").(string),
+		CertificateSourceType: sourceType,
+	}
+}
+
+func flattenDNSRecords(records []*sdk.DNSRecord) []interface{} {
+	arr := make([]interface{}, len(records))
+	for i, record := range records {
+		var expiration string
+		if record.Expiration != nil {
+			expiration = record.Expiration.Format(time.RFC3339)
+		}
+		arr[i] = map[string]interface{}{
+			"expiration":  expiration,
+			"fqdn":        record.Fqdn,
+			"record_type": record.RecordType,
+			"values":      convertStringArrToInterface(record.Values),
+		}
+	}
+	return arr
+}
@@ -0,0 +1,136 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// Certificate source types of the custom domain. The certificates of the OKTA_MANAGED domains are issued and renewed
+// by Okta, so they can't be uploaded.
+const (
+	domainCertificateManual      = "MANUAL"
+	domainCertificateOktaManaged = "OKTA_MANAGED"
+)
+
+func resourceDomainCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDomainCertificateCreate,
+		ReadContext:   resourceDomainCertificateRead,
+		UpdateContext: resourceDomainCertificateUpdate,
+		DeleteContext: resourceDomainCertificateDelete,
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the custom domain, which certificate source type is MANUAL",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "PEM",
+				ValidateDiagFunc: stringInSlice([]string{"PEM"}),
+				Description:      "Certificate type, only PEM is supported",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "PEM-encoded certificate",
+			},
+			"certificate_chain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "PEM-encoded certificate chain",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded private key of the certificate",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subject of the certificate in use",
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Fingerprint of the certificate in use",
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration of the certificate in use",
+			},
+		},
+	}
+}
+
+func resourceDomainCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	domainID := d.Get("domain_id").(string)
+	if err := uploadDomainCertificate(ctx, d, m); err != nil {
+		return diag.Errorf("failed to create domain certificate: %v", err)
+	}
+	d.SetId(domainID)
+	return resourceDomainCertificateRead(ctx, d, m)
+}
+
+func resourceDomainCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	domain, resp, err := getSupplementFromMetadata(m).GetDomain(ctx, d.Get("domain_id").(string))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get domain: %v", err)
+	}
+	if domain == nil || domain.CertificateSourceType == domainCertificateOktaManaged {
+		// the certificate is not user-managed anymore, if the domain was switched to the Okta-managed certificates
+		d.SetId("")
+		return nil
+	}
+	if domain.PublicCertificate != nil {
+		_ = d.Set("subject", domain.PublicCertificate.Subject)
+		_ = d.Set("fingerprint", domain.PublicCertificate.Fingerprint)
+		_ = d.Set("expiration", domain.PublicCertificate.Expiration)
+	}
+	return nil
+}
+
+// Every change of the certificate uploads a new revision of it, which replaces the previous one.
+func resourceDomainCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := uploadDomainCertificate(ctx, d, m); err != nil {
+		return diag.Errorf("failed to update domain certificate: %v", err)
+	}
+	return resourceDomainCertificateRead(ctx, d, m)
+}
+
+// The certificate is not removed on destroy, since the custom domain can't stay without one.
+func resourceDomainCertificateDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+func uploadDomainCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	domainID := d.Get("domain_id").(string)
+	client := getSupplementFromMetadata(m)
+	domain, _, err := client.GetDomain(ctx, domainID)
+	if err != nil {
+		return fmt.Errorf("failed to get domain: %v", err)
+	}
+	if domain.CertificateSourceType == domainCertificateOktaManaged {
+		return fmt.Errorf("certificate of the domain '%s' is managed by Okta, set 'certificate_source_type' of the domain to '%s' to upload the certificate",
+			domain.Domain, domainCertificateManual)
+	}
+	_, err = client.CreateDomainCertificate(ctx, domainID, buildDomainCertificate(d))
+	return err
+}
+
+func buildDomainCertificate(d *schema.ResourceData) sdk.DomainCertificate {
+	return sdk.DomainCertificate{
+		Certificate:      d.Get("certificate").(string),
+		CertificateChain: d.Get("certificate_chain").(string),
+		PrivateKey:       d.Get("private_key").(string),
+		Type:             d.Get("type").(string),
+	}
+}
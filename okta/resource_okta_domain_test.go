@@ -0,0 +1,51 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaDomain(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(oktaDomain)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", oktaDomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("testacc-%d.example.com", ri)),
+					resource.TestCheckResourceAttrPair(resourceName, "brand_id", fmt.Sprintf("data.%s.test", oktaBrand), "id"),
+					resource.TestCheckResourceAttr(resourceName, "dns_records.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDomainDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != oktaDomain {
+			continue
+		}
+		_, resp, err := getSupplementFromMetadata(testAccProvider.Meta()).GetDomain(context.Background(), rs.Primary.ID)
+		if is404(resp) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get domain: %v", err)
+		}
+		return fmt.Errorf("domain still exists")
+	}
+	return nil
+}
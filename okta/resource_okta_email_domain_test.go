@@ -0,0 +1,61 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaEmailDomain(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(emailDomain)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", emailDomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckEmailDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "domain", fmt.Sprintf("testacc-%d.example.com", ri)),
+					resource.TestCheckResourceAttrPair(resourceName, "brand_id", fmt.Sprintf("data.%s.test", oktaBrand), "id"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Test Acc"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", "no-reply"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_validation_records.#"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Test Acc Updated"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", "notifications"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailDomainDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != emailDomain {
+			continue
+		}
+		domain, resp, err := getSupplementFromMetadata(testAccProvider.Meta()).GetEmailDomain(context.Background(), rs.Primary.ID)
+		if is404(resp) || (err == nil && domain.ValidationStatus == "DELETED") {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get email domain: %v", err)
+		}
+		return fmt.Errorf("email domain still exists")
+	}
+	return nil
+}
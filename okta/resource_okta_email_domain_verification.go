@@ -0,0 +1,85 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const statusVerified = "VERIFIED"
+
+func resourceEmailDomainVerification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEmailDomainVerificationCreate,
+		ReadContext:   resourceEmailDomainVerificationRead,
+		DeleteContext: resourceEmailDomainVerificationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"email_domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Email domain ID",
+			},
+		},
+	}
+}
+
+func resourceEmailDomainVerificationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Get("email_domain_id").(string)
+	err := retryUntilVerified(ctx, d.Timeout(schema.TimeoutCreate), func() (string, error) {
+		domain, _, err := getSupplementFromMetadata(m).VerifyEmailDomain(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return domain.ValidationStatus, nil
+	})
+	if err != nil {
+		return diag.Errorf("failed to verify email domain: %v", err)
+	}
+	d.SetId(id)
+	return resourceEmailDomainVerificationRead(ctx, d, m)
+}
+
+func resourceEmailDomainVerificationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	domain, resp, err := getSupplementFromMetadata(m).GetEmailDomain(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get email domain: %v", err)
+	}
+	if domain == nil || domain.ValidationStatus != statusVerified {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("email_domain_id", domain.Id)
+	return nil
+}
+
+// resourceEmailDomainVerificationDelete only removes the resource from the state, since the verification can not be
+// reverted. Remove the 'okta_email_domain' to stop sending emails from the domain.
+func resourceEmailDomainVerificationDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+// retryUntilVerified repeats the verification until it succeeds or the timeout is reached, since the DNS records,
+// which are created in the same plan, may not be propagated yet.
+func retryUntilVerified(ctx context.Context, timeout time.Duration, verify func() (string, error)) error {
+	bOff := backoff.NewExponentialBackOff()
+	bOff.MaxElapsedTime = timeout
+	bOff.MaxInterval = 30 * time.Second
+	return backoff.Retry(func() error {
+		status, err := verify()
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if status != statusVerified {
+			return fmt.Errorf("status is '%s', make sure the DNS records are created and propagated", status)
+		}
+		return nil
+	}, backoff.WithContext(bOff, ctx))
+}
@@ -0,0 +1,34 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetryUntilVerified(t *testing.T) {
+	var calls int
+	err := retryUntilVerified(context.Background(), time.Minute, func() (string, error) {
+		calls++
+		if calls < 3 {
+			return "POLLING", nil
+		}
+		return statusVerified, nil
+	})
+	if err != nil {
+		t.Fatalf("expected verification to succeed, got: %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+
+	calls = 0
+	err = retryUntilVerified(context.Background(), time.Minute, func() (string, error) {
+		calls++
+		return "", errors.New("not found")
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("expected the error to stop the retries, got %v after %d calls", err, calls)
+	}
+}
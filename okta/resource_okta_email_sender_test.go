@@ -0,0 +1,33 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaEmailSender(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(emailSender)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", emailSender)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "from_name", "Test Acc"),
+					resource.TestCheckResourceAttr(resourceName, "from_address", fmt.Sprintf("no-reply@testacc-%d.example.com", ri)),
+					resource.TestCheckResourceAttr(resourceName, "subdomain", "mail"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_records.#"),
+				),
+			},
+		},
+	})
+}
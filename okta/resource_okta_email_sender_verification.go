@@ -0,0 +1,63 @@
+package okta
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceEmailSenderVerification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEmailSenderVerificationCreate,
+		ReadContext:   resourceEmailSenderVerificationRead,
+		DeleteContext: resourceEmailSenderVerificationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"sender_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Custom email sender ID",
+			},
+		},
+	}
+}
+
+func resourceEmailSenderVerificationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Get("sender_id").(string)
+	err := retryUntilVerified(ctx, d.Timeout(schema.TimeoutCreate), func() (string, error) {
+		sender, _, err := getSupplementFromMetadata(m).VerifyEmailSender(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return sender.Status, nil
+	})
+	if err != nil {
+		return diag.Errorf("failed to verify custom email sender: %v", err)
+	}
+	d.SetId(id)
+	return resourceEmailSenderVerificationRead(ctx, d, m)
+}
+
+func resourceEmailSenderVerificationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sender, resp, err := getSupplementFromMetadata(m).GetEmailSender(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get custom email sender: %v", err)
+	}
+	if sender == nil || sender.Status != statusVerified {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("sender_id", sender.Id)
+	return nil
+}
+
+// resourceEmailSenderVerificationDelete only removes the resource from the state, since the verification can not be
+// reverted. Remove the 'okta_email_sender' to send the emails from the default address again.
+func resourceEmailSenderVerificationDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
@@ -261,15 +261,11 @@ func eventSet(e *okta.EventSubscriptions) *schema.Set {
 }
 
 func setEventHookStatus(ctx context.Context, d *schema.ResourceData, client *okta.Client, status string) error {
-	desiredStatus := d.Get("status").(string)
-	if status == desiredStatus {
-		return nil
-	}
-	var err error
-	if desiredStatus == statusInactive {
-		_, _, err = client.EventHook.DeactivateEventHook(ctx, d.Id())
-	} else {
-		_, _, err = client.EventHook.ActivateEventHook(ctx, d.Id())
-	}
-	return err
+	return changeStatus(status, d.Get("status").(string), func() error {
+		_, _, err := client.EventHook.ActivateEventHook(ctx, d.Id())
+		return err
+	}, func() error {
+		_, _, err := client.EventHook.DeactivateEventHook(ctx, d.Id())
+		return err
+	})
 }
@@ -0,0 +1,188 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func resourceGroupMemberships() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGroupMembershipsCreate,
+		ReadContext:   resourceGroupMembershipsRead,
+		UpdateContext: resourceGroupMembershipsUpdate,
+		DeleteContext: resourceGroupMembershipsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGroupMembershipsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of a Okta Group",
+			},
+			"users": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the users, which are the members of the group. The other members of the group are not managed.",
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	groupID := d.Get("group_id").(string)
+	members, err := listGroupMemberIDs(ctx, m, groupID)
+	if err != nil {
+		return diag.Errorf("failed to list group members: %v", err)
+	}
+	add := missingMembers(convertInterfaceToStringSet(d.Get("users")), members)
+	err = updateGroupMemberships(ctx, m, groupID, add, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(groupID)
+	return resourceGroupMembershipsRead(ctx, d, m)
+}
+
+// resourceGroupMembershipsRead removes the users, which are not the members of the group anymore, from the state.
+func resourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	members, err := listGroupMemberIDs(ctx, m, d.Get("group_id").(string))
+	if err != nil {
+		return diag.Errorf("failed to list group members: %v", err)
+	}
+	if members == nil {
+		d.SetId("")
+		return nil
+	}
+	memberSet := groupMemberSet(members)
+	var users []string
+	for _, id := range convertInterfaceToStringSet(d.Get("users")) {
+		if memberSet[id] {
+			users = append(users, id)
+		}
+	}
+	_ = d.Set("users", convertStringSetToInterface(users))
+	return nil
+}
+
+func resourceGroupMembershipsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	groupID := d.Get("group_id").(string)
+	members, err := listGroupMemberIDs(ctx, m, groupID)
+	if err != nil {
+		return diag.Errorf("failed to list group members: %v", err)
+	}
+	oldUsers, newUsers := d.GetChange("users")
+	add := missingMembers(convertInterfaceToStringSet(newUsers), members)
+	memberSet := groupMemberSet(members)
+	var remove []string
+	for _, id := range convertInterfaceToStringSet(oldUsers) {
+		if !newUsers.(*schema.Set).Contains(id) && memberSet[id] {
+			remove = append(remove, id)
+		}
+	}
+	err = updateGroupMemberships(ctx, m, groupID, add, remove)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceGroupMembershipsRead(ctx, d, m)
+}
+
+func resourceGroupMembershipsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := updateGroupMemberships(ctx, m, d.Get("group_id").(string), nil, convertInterfaceToStringSet(d.Get("users")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceGroupMembershipsImport imports all the members of the group, since the managed ones are not known.
+func resourceGroupMembershipsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	members, err := listGroupMemberIDs(ctx, m, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	_ = d.Set("group_id", d.Id())
+	_ = d.Set("users", convertStringSetToInterface(members))
+	return []*schema.ResourceData{d}, nil
+}
+
+// listGroupMemberIDs returns nil, if the group does not exist.
+func listGroupMemberIDs(ctx context.Context, m interface{}, groupID string) ([]string, error) {
+	client := getOktaClientFromMetadata(m)
+	users, resp, err := client.Group.ListGroupUsers(ctx, groupID, &query.Params{Limit: defaultPaginationLimit})
+	if is404(resp) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(users))
+	for {
+		for _, user := range users {
+			ids = append(ids, user.Id)
+		}
+		if !resp.HasNextPage() {
+			return ids, nil
+		}
+		users = nil
+		resp, err = resp.Next(ctx, &users)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// groupMemberSet is used instead of 'contains', since the groups can have thousands of members.
+func groupMemberSet(members []string) map[string]bool {
+	set := make(map[string]bool, len(members))
+	for _, id := range members {
+		set[id] = true
+	}
+	return set
+}
+
+func missingMembers(users, members []string) []string {
+	memberSet := groupMemberSet(members)
+	var missing []string
+	for _, id := range users {
+		if !memberSet[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
+
+// updateGroupMemberships adds and removes the users concurrently, limited by the 'parallelism' of the provider.
+func updateGroupMemberships(ctx context.Context, m interface{}, groupID string, add, remove []string) error {
+	client := getOktaClientFromMetadata(m)
+	var funcs []func() error
+	for _, id := range add {
+		userID := id
+		funcs = append(funcs, func() error {
+			if err := responseErr(client.Group.AddUserToGroup(ctx, groupID, userID)); err != nil {
+				return fmt.Errorf("failed to add user '%s': %v", userID, err)
+			}
+			return nil
+		})
+	}
+	for _, id := range remove {
+		userID := id
+		funcs = append(funcs, func() error {
+			if err := suppressErrorOn404(client.Group.RemoveUserFromGroup(ctx, groupID, userID)); err != nil {
+				return fmt.Errorf("failed to remove user '%s': %v", userID, err)
+			}
+			return nil
+		})
+	}
+	if len(funcs) == 0 {
+		return nil
+	}
+	return getPromiseError(promiseAll(ctx, getParallelismFromMetadata(m), funcs...), "failed to update group memberships")
+}
@@ -22,8 +22,10 @@ func resourceGroupRole() *schema.Resource {
 		DeleteContext: resourceGroupRoleDelete,
 		Importer:      &schema.ResourceImporter{StateContext: resourceGroupRoleImporter},
 		CustomizeDiff: customdiff.All(
+			validateGroupRoleTargets,
+			forceNewOnLastAdditiveTarget,
 			customdiff.ForceNewIf("target_group_list", func(_ context.Context, d *schema.ResourceDiff, m interface{}) bool {
-				if d.HasChange("target_group_list") {
+				if d.HasChange("target_group_list") && !d.Get("additive_targets").(bool) {
 					// to avoid exception when removing last group target from a role assignment,
 					// the API consumer should delete the role assignment and recreate it.
 					if len(convertInterfaceToStringSet(d.Get("target_group_list"))) == 0 {
@@ -33,7 +35,7 @@ func resourceGroupRole() *schema.Resource {
 				return false
 			}),
 			customdiff.ForceNewIf("target_app_list", func(_ context.Context, d *schema.ResourceDiff, m interface{}) bool {
-				if d.HasChange("target_app_list") {
+				if d.HasChange("target_app_list") && !d.Get("additive_targets").(bool) {
 					// to avoid exception when removing last app target from a role assignment,
 					// the API consumer should delete the role assignment and recreate it.
 					if len(convertInterfaceToStringSet(d.Get("target_app_list"))) == 0 {
@@ -88,6 +90,12 @@ func resourceGroupRole() *schema.Resource {
 				Optional:    true,
 				Description: "List of apps ids for the targets of the admin role.",
 			},
+			"additive_targets": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Manage only the targets in 'target_group_list' and 'target_app_list', the other targets of the admin role are kept.",
+			},
 		},
 	}
 }
@@ -155,13 +163,13 @@ func resourceGroupRoleRead(ctx context.Context, d *schema.ResourceData, m interf
 				if err != nil {
 					return diag.Errorf("unable to list group targets for role %s and group %s: %v", rolesAssigned[i].Id, groupID, err)
 				}
-				_ = d.Set("target_group_list", groupIDs)
+				_ = d.Set("target_group_list", managedTargets(d, groupIDs, d.Get("target_group_list")))
 			} else if rolesAssigned[i].Type == "APP_ADMIN" {
-				apps, err := listGroupAppsTargets(ctx, d, m)
+				apps, err := listGroupAppsTargets(ctx, m, groupID, rolesAssigned[i].Id)
 				if err != nil {
 					return diag.Errorf("unable to list app targets for role %s and group %s: %v", rolesAssigned[i].Id, groupID, err)
 				}
-				_ = d.Set("target_app_list", apps)
+				_ = d.Set("target_app_list", managedTargets(d, apps, d.Get("target_app_list")))
 			}
 			_ = d.Set("role_type", rolesAssigned[i].Type)
 			return nil
@@ -184,6 +192,8 @@ func resourceGroupRoleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			return diag.FromErr(err)
 		}
 		targetsToAdd, targetsToRemove := splitTargets(expectedGroupIDs, existingGroupIDs)
+		oldGroupIDs, _ := d.GetChange("target_group_list")
+		targetsToRemove = managedTargets(d, targetsToRemove, oldGroupIDs)
 		err = addGroupTargetsToRole(ctx, client, groupID, roleID, targetsToAdd)
 		if err != nil {
 			return diag.Errorf("failed to add group target to role assignment %s for group %s: %v", roleID, groupID, err)
@@ -195,11 +205,13 @@ func resourceGroupRoleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	}
 	if d.HasChange("target_app_list") && roleType == "APP_ADMIN" {
 		expectedApps := convertInterfaceToStringSet(d.Get("target_app_list"))
-		existingApps, err := listGroupAppsTargets(ctx, d, m)
+		existingApps, err := listGroupAppsTargets(ctx, m, groupID, roleID)
 		if err != nil {
 			return diag.Errorf("unable to list app targets for role %s and group %s: %v", d.Id(), groupID, err)
 		}
 		targetsToAdd, targetsToRemove := splitTargets(expectedApps, existingApps)
+		oldApps, _ := d.GetChange("target_app_list")
+		targetsToRemove = managedTargets(d, targetsToRemove, oldApps)
 		err = addGroupAppTargetsToRole(ctx, client, groupID, roleID, targetsToAdd)
 		if err != nil {
 			return diag.Errorf("unable to add app target to role assignment %s for group %s: %v", roleID, groupID, err)
@@ -250,6 +262,12 @@ func resourceGroupRoleImporter(ctx context.Context, d *schema.ResourceData, m in
 				return nil, fmt.Errorf("unable to get admin assignment %s for group %s: %v", role.Id, groupID, err)
 			}
 			_ = d.Set("target_group_list", groupIDs)
+		} else if role.Type == "APP_ADMIN" {
+			apps, err := listGroupAppsTargets(ctx, m, groupID, role.Id)
+			if err != nil {
+				return nil, fmt.Errorf("unable to list app targets for role %s and group %s: %v", role.Id, groupID, err)
+			}
+			_ = d.Set("target_app_list", apps)
 		}
 		return []*schema.ResourceData{d}, nil
 
@@ -281,11 +299,11 @@ func listGroupTargetsIDs(ctx context.Context, m interface{}, groupID, roleID str
 	return resIDs, nil
 }
 
-func listGroupAppsTargets(ctx context.Context, d *schema.ResourceData, m interface{}) ([]string, error) {
+func listGroupAppsTargets(ctx context.Context, m interface{}, groupID, roleID string) ([]string, error) {
 	var resApps []string
 	apps, resp, err := getOktaClientFromMetadata(m).Group.
 		ListApplicationTargetsForApplicationAdministratorRoleForGroup(
-			ctx, d.Get("group_id").(string), d.Id(), &query.Params{Limit: defaultPaginationLimit, Status: "ACTIVE"})
+			ctx, groupID, roleID, &query.Params{Limit: defaultPaginationLimit, Status: "ACTIVE"})
 	if err != nil {
 		return nil, err
 	}
@@ -381,6 +399,89 @@ func removeGroupAppTargets(ctx context.Context, client *okta.Client, groupID, ro
 	return nil
 }
 
+// managedTargets returns only the targets, which are managed by the resource, when it's configured with
+// 'additive_targets'. Otherwise, all the targets are managed and returned as is.
+func managedTargets(d *schema.ResourceData, targets []string, managedSet interface{}) []string {
+	if !d.Get("additive_targets").(bool) {
+		return targets
+	}
+	managed := convertInterfaceToStringSetNullable(managedSet)
+	var res []string
+	for i := range targets {
+		if contains(managed, targets[i]) {
+			res = append(res, targets[i])
+		}
+	}
+	return res
+}
+
+// validateGroupRoleTargets rejects the targets, which are not supported by the role type, instead of ignoring them.
+func validateGroupRoleTargets(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("role_type") {
+		return nil
+	}
+	roleType := d.Get("role_type").(string)
+	if len(convertInterfaceToStringSet(d.Get("target_group_list"))) > 0 && !supportsGroupTargets(roleType) {
+		return fmt.Errorf("'target_group_list' is supported only by the GROUP_MEMBERSHIP_ADMIN, HELP_DESK_ADMIN and USER_ADMIN roles, got %s", roleType)
+	}
+	if len(convertInterfaceToStringSet(d.Get("target_app_list"))) > 0 && roleType != "APP_ADMIN" {
+		return fmt.Errorf("'target_app_list' is supported only by the APP_ADMIN role, got %s", roleType)
+	}
+	return nil
+}
+
+// forceNewOnLastAdditiveTarget recreates the role assignment, when the change of the managed targets removes the
+// last target of the admin role with 'additive_targets', since the API does not allow to remove the last target.
+// The targets added outside of Terraform are not in the state, so they are listed to find out whether any are kept.
+func forceNewOnLastAdditiveTarget(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.Get("additive_targets").(bool) {
+		return nil
+	}
+	groupID, _ := d.GetChange("group_id")
+	if d.HasChange("target_group_list") {
+		oldValue, newValue := d.GetChange("target_group_list")
+		newGroups := convertInterfaceToStringSet(newValue)
+		if len(newGroups) == 0 {
+			groupIDs, err := listGroupTargetsIDs(ctx, m, groupID.(string), d.Id())
+			if err != nil {
+				return err
+			}
+			if len(keptTargets(groupIDs, convertInterfaceToStringSet(oldValue), newGroups)) == 0 {
+				if err := d.ForceNew("target_group_list"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if d.HasChange("target_app_list") {
+		oldValue, newValue := d.GetChange("target_app_list")
+		oldApps := convertInterfaceToStringSet(oldValue)
+		if len(oldApps) > 0 {
+			apps, err := listGroupAppsTargets(ctx, m, groupID.(string), d.Id())
+			if err != nil {
+				return fmt.Errorf("unable to list app targets for role %s and group %s: %v", d.Id(), groupID, err)
+			}
+			// removing the app, which is the only one left assigned to the role, unassigns the role from the group
+			if len(keptTargets(apps, oldApps, convertInterfaceToStringSet(newValue))) == 0 {
+				return d.ForceNew("target_app_list")
+			}
+		}
+	}
+	return nil
+}
+
+// keptTargets returns the targets of the admin role, which are kept after the managed targets are changed from
+// 'oldTargets' to 'newTargets': the ones, which are not managed, and the ones, which are still configured.
+func keptTargets(targets, oldTargets, newTargets []string) []string {
+	var kept []string
+	for i := range targets {
+		if !contains(oldTargets, targets[i]) || contains(newTargets, targets[i]) {
+			kept = append(kept, targets[i])
+		}
+	}
+	return kept
+}
+
 func supportsGroupTargets(roleType string) bool {
 	return contains([]string{"GROUP_MEMBERSHIP_ADMIN", "HELP_DESK_ADMIN", "USER_ADMIN"}, roleType)
 }
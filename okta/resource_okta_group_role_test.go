@@ -2,10 +2,12 @@ package okta
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccOktaGroupAdminRole_crud(t *testing.T) {
@@ -39,6 +41,15 @@ func TestAccOktaGroupAdminRole_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName2, "target_app_list.#", "1"),
 				),
 			},
+			{
+				ResourceName: resourceName2,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName2]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["group_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
 			{
 				Config: groupTargetsUpdated,
 				Check: resource.ComposeTestCheckFunc(
@@ -58,3 +69,74 @@ func TestAccOktaGroupAdminRole_crud(t *testing.T) {
 		},
 	})
 }
+
+func TestAccOktaGroupAdminRole_unsupportedTargets(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(groupRole)
+	config := mgr.GetFixtures("group_targets_invalid.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(oktaGroup, doesGroupExist),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`'target_group_list' is supported only by`),
+			},
+		},
+	})
+}
+
+func TestAccOktaGroupAdminRole_additiveTargets(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", groupRole)
+	mgr := newFixtureManager(groupRole)
+	config := mgr.GetFixtures("group_targets_additive.tf", ri, t)
+	updated := mgr.GetFixtures("group_targets_additive_updated.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(oktaGroup, doesGroupExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "additive_targets", "true"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_list.#", "2"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "additive_targets", "true"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_list.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestKeptTargets(t *testing.T) {
+	tests := []struct {
+		targets  []string
+		old      []string
+		new      []string
+		expected int
+	}{
+		// the only managed target is removed and there are no other targets
+		{[]string{"a"}, []string{"a"}, nil, 0},
+		// the target added outside of Terraform is kept
+		{[]string{"a", "b"}, []string{"a"}, nil, 1},
+		// the managed target is still configured
+		{[]string{"a", "b"}, []string{"a", "b"}, []string{"b"}, 1},
+		// all the managed targets are replaced
+		{[]string{"a", "b"}, []string{"a", "b"}, []string{"c"}, 0},
+	}
+	for _, test := range tests {
+		if actual := keptTargets(test.targets, test.old, test.new); len(actual) != test.expected {
+			t.Errorf("expected %d kept targets of %v, when changing %v to %v, got %v", test.expected, test.targets, test.old, test.new, actual)
+		}
+	}
+}
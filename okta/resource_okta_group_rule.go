@@ -39,10 +39,23 @@ func resourceGroupRule() *schema.Resource {
 				Optional: true,
 			},
 			"expression_value": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringIsExpression,
 			},
 			"status": statusSchema,
+			"users_excluded": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the users, which are never assigned by the rule, even if they match the expression",
+			},
+			"users_included": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the users, which are assigned by the rule along with the users matching the expression",
+			},
 			"remove_assigned_users": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -90,8 +103,15 @@ func resourceGroupRuleRead(ctx context.Context, d *schema.ResourceData, m interf
 		_ = d.Set("expression_type", g.Conditions.Expression.Type)
 		_ = d.Set("expression_value", g.Conditions.Expression.Value)
 	}
+	var excluded, included []string
+	if g.Conditions != nil && g.Conditions.People != nil && g.Conditions.People.Users != nil {
+		excluded = g.Conditions.People.Users.Exclude
+		included = g.Conditions.People.Users.Include
+	}
 	err = setNonPrimitives(d, map[string]interface{}{
 		"group_assignments": convertStringSetToInterface(g.Actions.AssignUserToGroups.GroupIds),
+		"users_excluded":    convertStringSetToInterface(excluded),
+		"users_included":    convertStringSetToInterface(included),
 	})
 	if err != nil {
 		return diag.Errorf("failed to set group rule properties: %v", err)
@@ -136,7 +156,7 @@ func resourceGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func hasGroupRuleChange(d *schema.ResourceData) bool {
-	for _, k := range []string{"expression_type", "expression_value", "name", "group_assignments"} {
+	for _, k := range []string{"expression_type", "expression_value", "name", "group_assignments", "users_excluded", "users_included"} {
 		if d.HasChange(k) {
 			return true
 		}
@@ -165,7 +185,7 @@ func resourceGroupRuleDelete(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func buildGroupRule(d *schema.ResourceData) *okta.GroupRule {
-	return &okta.GroupRule{
+	rule := &okta.GroupRule{
 		Actions: &okta.GroupRuleAction{
 			AssignUserToGroups: &okta.GroupRuleGroupAssignment{
 				GroupIds: convertInterfaceToStringSet(d.Get("group_assignments")),
@@ -180,6 +200,17 @@ func buildGroupRule(d *schema.ResourceData) *okta.GroupRule {
 		Name: d.Get("name").(string),
 		Type: "group_rule",
 	}
+	excluded := convertInterfaceToStringSetNullable(d.Get("users_excluded"))
+	included := convertInterfaceToStringSetNullable(d.Get("users_included"))
+	if len(excluded) > 0 || len(included) > 0 {
+		rule.Conditions.People = &okta.GroupRulePeopleCondition{
+			Users: &okta.GroupRuleUserCondition{
+				Exclude: excluded,
+				Include: included,
+			},
+		}
+	}
+	return rule
 }
 
 func handleGroupRuleLifecycle(ctx context.Context, d *schema.ResourceData, m interface{}) error {
@@ -84,6 +84,42 @@ func TestAccOktaGroupRule_crud(t *testing.T) {
 	})
 }
 
+func TestAccOktaGroupRule_usersExcluded(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", groupRule)
+	mgr := newFixtureManager(groupRule)
+	config := mgr.GetFixtures("users_excluded.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("users_excluded_updated.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(groupRule, doesGroupRuleExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "users_excluded.#", "2"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "users_excluded.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"remove_assigned_users"},
+			},
+		},
+	})
+}
+
 func TestAccOktaGroupRule_invalidHandle(t *testing.T) {
 	ri := acctest.RandInt()
 	groupResource := fmt.Sprintf("%s.test", oktaGroup)
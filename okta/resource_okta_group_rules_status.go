@@ -0,0 +1,153 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGroupRulesStatus() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGroupRulesStatusCreate,
+		ReadContext:   resourceGroupRulesStatusRead,
+		UpdateContext: resourceGroupRulesStatusUpdate,
+		DeleteContext: resourceGroupRulesStatusDelete,
+		Schema: map[string]*schema.Schema{
+			"rule_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of group rule IDs, status of which is managed together",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringInSlice([]string{statusActive, statusInactive}),
+				Description:      "Status of all the group rules",
+			},
+			"invalid_rule_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Group rules that are in the INVALID status, which can not be changed",
+			},
+		},
+	}
+}
+
+func resourceGroupRulesStatusCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ids := convertInterfaceToStringSet(d.Get("rule_ids"))
+	if err := setGroupRulesStatus(ctx, m, ids, d.Get("status").(string)); err != nil {
+		return diag.Errorf("failed to change group rules status: %v", err)
+	}
+	sort.Strings(ids)
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(strings.Join(ids, ",")))))
+	return resourceGroupRulesStatusRead(ctx, d, m)
+}
+
+func resourceGroupRulesStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	desiredStatus := d.Get("status").(string)
+	status := desiredStatus
+	var ids, invalidIDs []string
+	for _, id := range convertInterfaceToStringSet(d.Get("rule_ids")) {
+		rule, resp, err := client.Group.GetGroupRule(ctx, id, nil)
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return diag.Errorf("failed to get group rule: %v", err)
+		}
+		if rule == nil {
+			continue
+		}
+		ids = append(ids, id)
+		if rule.Status == statusInvalid {
+			invalidIDs = append(invalidIDs, id)
+			continue
+		}
+		// if any of the rules drifted, the status is reported as the drifted one so that all the rules are
+		// toggled again on the next apply
+		if rule.Status != desiredStatus {
+			status = rule.Status
+		}
+	}
+	if len(ids) == 0 {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("status", status)
+	err := setNonPrimitives(d, map[string]interface{}{
+		"rule_ids":         convertStringSetToInterface(ids),
+		"invalid_rule_ids": convertStringSetToInterface(invalidIDs),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set group rules status properties: %v", err)
+	}
+	return nil
+}
+
+func resourceGroupRulesStatusUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var ids []string
+	if d.HasChange("status") {
+		ids = convertInterfaceToStringSet(d.Get("rule_ids"))
+	} else {
+		// only the rules that were added should be changed
+		oldIDs, newIDs := d.GetChange("rule_ids")
+		ids = convertInterfaceToStringSet(newIDs.(*schema.Set).Difference(oldIDs.(*schema.Set)))
+	}
+	if err := setGroupRulesStatus(ctx, m, ids, d.Get("status").(string)); err != nil {
+		return diag.Errorf("failed to change group rules status: %v", err)
+	}
+	return resourceGroupRulesStatusRead(ctx, d, m)
+}
+
+// Group rules are left in their current status on destroy.
+func resourceGroupRulesStatusDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+// setGroupRulesStatus changes the status of all the given group rules. In case any of the rules fails to change,
+// the rules that were already changed are reverted, so the rules are either all toggled or left untouched.
+func setGroupRulesStatus(ctx context.Context, m interface{}, ids []string, status string) error {
+	client := getOktaClientFromMetadata(m)
+	toggle := func(id, status string) error {
+		var err error
+		if status == statusActive {
+			_, err = client.Group.ActivateGroupRule(ctx, id)
+		} else {
+			_, err = client.Group.DeactivateGroupRule(ctx, id)
+		}
+		return err
+	}
+	// the statuses of the changed rules before the change, so they can be restored on failure
+	previous := make(map[string]string)
+	for _, id := range ids {
+		rule, _, err := client.Group.GetGroupRule(ctx, id, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get group rule '%s': %v", id, err)
+		}
+		if rule.Status == status || rule.Status == statusInvalid {
+			continue
+		}
+		err = toggle(id, status)
+		if err == nil {
+			previous[id] = rule.Status
+			continue
+		}
+		for _, changedID := range ids {
+			previousStatus, ok := previous[changedID]
+			if !ok {
+				continue
+			}
+			if rollbackErr := toggle(changedID, previousStatus); rollbackErr != nil {
+				logger(m).Error("failed to revert group rule status", "id", changedID, "error", rollbackErr)
+			}
+		}
+		return fmt.Errorf("failed to change status of group rule '%s': %v", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func TestAccOktaGroupRulesStatus_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", groupRulesStatus)
+	mgr := newFixtureManager(groupRulesStatus)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	deactivated := mgr.GetFixtures("basic_deactivated.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(groupRule, doesGroupRuleExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "rule_ids.#", "2"),
+					ensureGroupRulesStatus(resourceName, statusActive),
+				),
+			},
+			{
+				Config: deactivated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+					resource.TestCheckResourceAttr(resourceName, "rule_ids.#", "2"),
+					ensureGroupRulesStatus(resourceName, statusInactive),
+				),
+			},
+		},
+	})
+}
+
+func ensureGroupRulesStatus(name, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		client := getOktaClientFromMetadata(testAccProvider.Meta())
+		for k, id := range rs.Primary.Attributes {
+			if k == "rule_ids.#" || !strings.HasPrefix(k, "rule_ids.") {
+				continue
+			}
+			rule, _, err := client.Group.GetGroupRule(context.Background(), id, nil)
+			if err != nil {
+				return err
+			}
+			if rule.Status != status {
+				return fmt.Errorf("group rule '%s' has status '%s', expected '%s'", id, rule.Status, status)
+			}
+		}
+		return nil
+	}
+}
+
+// TestSetGroupRulesStatusRollback verifies that the rules changed before the failure are restored to their previous
+// statuses.
+func TestSetGroupRulesStatusRollback(t *testing.T) {
+	statuses := map[string]string{"rule1": statusActive, "rule2": statusInactive, "rule3": statusActive}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/groups/rules/"), "/")
+		status, ok := statuses[parts[0]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": parts[0], "status": status})
+		case parts[0] == "rule3":
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"errorCode": "E0000001", "errorSummary": "Api validation failed"})
+		case strings.HasSuffix(r.URL.Path, "/lifecycle/activate"):
+			statuses[parts[0]] = statusActive
+		case strings.HasSuffix(r.URL.Path, "/lifecycle/deactivate"):
+			statuses[parts[0]] = statusInactive
+		}
+	}))
+	defer server.Close()
+	_, client, err := okta.NewClient(context.Background(),
+		okta.WithOrgUrl(server.URL),
+		okta.WithToken("token"),
+		okta.WithTestingDisableHttpsCheck(true),
+		okta.WithCache(false),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	m := &Config{oktaClient: client, logger: hclog.NewNullLogger()}
+	if err := setGroupRulesStatus(context.Background(), m, []string{"rule1", "rule2", "rule3"}, statusInactive); err == nil {
+		t.Fatal("expected an error, when the status of a group rule can not be changed")
+	}
+	for id, expected := range map[string]string{"rule1": statusActive, "rule2": statusInactive, "rule3": statusActive} {
+		if statuses[id] != expected {
+			t.Errorf("expected status of '%s' to be '%s', actual: '%s'", id, expected, statuses[id])
+		}
+	}
+}
@@ -99,6 +99,7 @@ func resourceIdpRead(ctx context.Context, d *schema.ResourceData, m interface{})
 		return nil
 	}
 	_ = d.Set("name", idp.Name)
+	_ = d.Set("status", idp.Status)
 	_ = d.Set("type", idp.Type)
 	_ = d.Set("max_clock_skew", idp.Policy.MaxClockSkew)
 	_ = d.Set("provisioning_action", idp.Policy.Provisioning.Action)
@@ -3,6 +3,7 @@ package okta
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -115,6 +116,7 @@ func resourceIdpSamlRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return nil
 	}
 	_ = d.Set("name", idp.Name)
+	_ = d.Set("status", idp.Status)
 	_ = d.Set("acs_type", idp.Protocol.Endpoints.Acs.Type)
 	_ = d.Set("max_clock_skew", idp.Policy.MaxClockSkew)
 	_ = d.Set("provisioning_action", idp.Policy.Provisioning.Action)
@@ -149,7 +151,22 @@ func resourceIdpSamlRead(ctx context.Context, d *schema.ResourceData, m interfac
 	if err != nil {
 		return diag.Errorf("failed to set SAML identity provider properties: %v", err)
 	}
-	return nil
+	return idpCertificateExpiryWarning(ctx, m, idp)
+}
+
+func idpCertificateExpiryWarning(ctx context.Context, m interface{}, idp *okta.IdentityProvider) diag.Diagnostics {
+	if c, ok := m.(*Config); !ok || c.certWarningDays <= 0 || idp.Protocol.Credentials.Trust.Kid == "" {
+		return nil
+	}
+	key, _, err := getOktaClientFromMetadata(m).IdentityProvider.GetIdentityProviderKey(ctx, idp.Protocol.Credentials.Trust.Kid)
+	if err != nil {
+		logger(m).Warn("failed to get signing key of SAML identity provider", "id", idp.Id, "error", err)
+		return nil
+	}
+	if len(key.X5c) == 0 {
+		return nil
+	}
+	return certificateExpiryWarning(m, fmt.Sprintf("SAML identity provider '%s'", idp.Name), key.X5c[0])
 }
 
 func resourceIdpSamlUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
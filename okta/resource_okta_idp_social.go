@@ -97,6 +97,7 @@ func resourceIdpSocialRead(ctx context.Context, d *schema.ResourceData, m interf
 		return nil
 	}
 	_ = d.Set("name", idp.Name)
+	_ = d.Set("status", idp.Status)
 	_ = d.Set("max_clock_skew", idp.Policy.MaxClockSkew)
 	_ = d.Set("provisioning_action", idp.Policy.Provisioning.Action)
 	_ = d.Set("deprovisioned_action", idp.Policy.Provisioning.Conditions.Deprovisioned.Action)
@@ -2,12 +2,15 @@ package okta
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 var headerSchema = &schema.Resource{
@@ -32,6 +35,7 @@ func resourceInlineHook() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateInlineHookOAuth,
 		// For those familiar with Terraform schemas be sure to check the base hook schema and/or
 		// the examples in the documentation
 		Schema: map[string]*schema.Schema{
@@ -63,8 +67,9 @@ func resourceInlineHook() *schema.Resource {
 				Elem:     headerSchema,
 			},
 			"auth": {
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"oauth"},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -111,7 +116,7 @@ func resourceInlineHook() *schema.Resource {
 					var errs diag.Diagnostics
 					m := i.(map[string]interface{})
 					if t, ok := m["type"]; ok {
-						dErr := stringInSlice([]string{"HTTP"})(t, cty.GetAttrPath("type"))
+						dErr := stringInSlice([]string{"HTTP", "OAUTH"})(t, cty.GetAttrPath("type"))
 						if dErr != nil {
 							errs = append(errs, dErr...)
 						}
@@ -139,13 +144,57 @@ func resourceInlineHook() *schema.Resource {
 					return errs
 				},
 			},
+			"oauth": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"auth"},
+				Description:   "OAuth 2.0 client credentials the hook uses to obtain an access token for the channel",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: stringInSlice([]string{"client_secret_post", "private_key_jwt"}),
+							Description:      "Client authentication method: client_secret_post or private_key_jwt",
+						},
+						"client_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Client ID of the OAuth 2.0 application",
+						},
+						"client_secret": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Client secret, required for the client_secret_post auth type",
+						},
+						"key_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the key used to sign the client assertion, required for the private_key_jwt auth type",
+						},
+						"token_url": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: stringIsURL(validURLSchemes...),
+							Description:      "Token endpoint of the authorization server",
+						},
+						"scope": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Space separated list of the scopes to request",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func resourceInlineHookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	hook := buildInlineHook(d)
-	newHook, _, err := getOktaClientFromMetadata(m).InlineHook.CreateInlineHook(ctx, hook)
+	newHook, _, err := getSupplementFromMetadata(m).CreateInlineHook(ctx, hook)
 	if err != nil {
 		return diag.Errorf("failed to create inline hook: %v", err)
 	}
@@ -158,7 +207,7 @@ func resourceInlineHookCreate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceInlineHookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	hook, resp, err := getOktaClientFromMetadata(m).InlineHook.GetInlineHook(ctx, d.Id())
+	hook, resp, err := getSupplementFromMetadata(m).GetInlineHook(ctx, d.Id())
 	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to get inline hook: %v", err)
 	}
@@ -174,6 +223,7 @@ func resourceInlineHookRead(ctx context.Context, d *schema.ResourceData, m inter
 		"channel": flattenInlineHookChannel(hook.Channel),
 		"headers": flattenInlineHookHeaders(hook.Channel),
 		"auth":    flattenInlineHookAuth(d, hook.Channel),
+		"oauth":   flattenInlineHookOAuth(d, hook.Channel),
 	})
 	if err != nil {
 		return diag.Errorf("failed to set inline hook properties: %v", err)
@@ -184,7 +234,7 @@ func resourceInlineHookRead(ctx context.Context, d *schema.ResourceData, m inter
 func resourceInlineHookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	hook := buildInlineHook(d)
-	newHook, _, err := client.InlineHook.UpdateInlineHook(ctx, d.Id(), hook)
+	newHook, _, err := getSupplementFromMetadata(m).UpdateInlineHook(ctx, d.Id(), hook)
 	if err != nil {
 		return diag.Errorf("failed to update inline hook: %v", err)
 	}
@@ -208,17 +258,19 @@ func resourceInlineHookDelete(ctx context.Context, d *schema.ResourceData, m int
 	return nil
 }
 
-func buildInlineHook(d *schema.ResourceData) okta.InlineHook {
-	return okta.InlineHook{
-		Name:    d.Get("name").(string),
-		Status:  d.Get("status").(string),
-		Type:    d.Get("type").(string),
-		Version: d.Get("version").(string),
+func buildInlineHook(d *schema.ResourceData) sdk.InlineHook {
+	return sdk.InlineHook{
+		InlineHook: &okta.InlineHook{
+			Name:    d.Get("name").(string),
+			Status:  d.Get("status").(string),
+			Type:    d.Get("type").(string),
+			Version: d.Get("version").(string),
+		},
 		Channel: buildInlineChannel(d),
 	}
 }
 
-func buildInlineChannel(d *schema.ResourceData) *okta.InlineHookChannel {
+func buildInlineChannel(d *schema.ResourceData) *sdk.InlineHookChannel {
 	var headerList []*okta.InlineHookChannelConfigHeaders
 	if raw, ok := d.GetOk("headers"); ok {
 		for _, header := range raw.(*schema.Set).List() {
@@ -250,19 +302,33 @@ func buildInlineChannel(d *schema.ResourceData) *okta.InlineHookChannel {
 	if !ok {
 		rawChannel["type"] = "HTTP"
 	}
-	return &okta.InlineHookChannel{
-		Config: &okta.InlineHookChannelConfig{
+	config := &sdk.InlineHookChannelConfig{
+		InlineHookChannelConfig: &okta.InlineHookChannelConfig{
 			Uri:        rawChannel["uri"].(string),
 			AuthScheme: auth,
 			Headers:    headerList,
 			Method:     rawChannel["method"].(string),
 		},
-		Type:    rawChannel["type"].(string),
-		Version: rawChannel["version"].(string),
+	}
+	if _, ok := d.GetOk("oauth"); ok {
+		rawChannel["type"] = "OAUTH"
+		config.AuthType = d.Get("oauth.0.auth_type").(string)
+		config.ClientId = d.Get("oauth.0.client_id").(string)
+		config.ClientSecret = d.Get("oauth.0.client_secret").(string)
+		config.HookKeyId = d.Get("oauth.0.key_id").(string)
+		config.TokenUrl = d.Get("oauth.0.token_url").(string)
+		config.Scope = d.Get("oauth.0.scope").(string)
+	}
+	return &sdk.InlineHookChannel{
+		InlineHookChannel: &okta.InlineHookChannel{
+			Type:    rawChannel["type"].(string),
+			Version: rawChannel["version"].(string),
+		},
+		Config: config,
 	}
 }
 
-func flattenInlineHookAuth(d *schema.ResourceData, c *okta.InlineHookChannel) map[string]interface{} {
+func flattenInlineHookAuth(d *schema.ResourceData, c *sdk.InlineHookChannel) map[string]interface{} {
 	auth := map[string]interface{}{}
 	if c.Config.AuthScheme != nil {
 		auth = map[string]interface{}{
@@ -275,7 +341,24 @@ func flattenInlineHookAuth(d *schema.ResourceData, c *okta.InlineHookChannel) ma
 	return auth
 }
 
-func flattenInlineHookChannel(c *okta.InlineHookChannel) map[string]interface{} {
+func flattenInlineHookOAuth(d *schema.ResourceData, c *sdk.InlineHookChannel) []interface{} {
+	if c.Type != "OAUTH" {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"auth_type": c.Config.AuthType,
+			"client_id": c.Config.ClientId,
+			// Read only
+			"client_secret": d.Get("oauth.0.client_secret"),
+			"key_id":        c.Config.HookKeyId,
+			"token_url":     c.Config.TokenUrl,
+			"scope":         c.Config.Scope,
+		},
+	}
+}
+
+func flattenInlineHookChannel(c *sdk.InlineHookChannel) map[string]interface{} {
 	return map[string]interface{}{
 		"type":    c.Type,
 		"version": c.Version,
@@ -284,7 +367,7 @@ func flattenInlineHookChannel(c *okta.InlineHookChannel) map[string]interface{}
 	}
 }
 
-func flattenInlineHookHeaders(c *okta.InlineHookChannel) *schema.Set {
+func flattenInlineHookHeaders(c *sdk.InlineHookChannel) *schema.Set {
 	headers := make([]interface{}, len(c.Config.Headers))
 	for i, header := range c.Config.Headers {
 		headers[i] = map[string]interface{}{
@@ -296,15 +379,39 @@ func flattenInlineHookHeaders(c *okta.InlineHookChannel) *schema.Set {
 }
 
 func setInlineHookStatus(ctx context.Context, d *schema.ResourceData, client *okta.Client, status string) error {
-	desiredStatus := d.Get("status").(string)
-	if status == desiredStatus {
+	return changeStatus(status, d.Get("status").(string), func() error {
+		_, _, err := client.InlineHook.ActivateInlineHook(ctx, d.Id())
+		return err
+	}, func() error {
+		_, _, err := client.InlineHook.DeactivateInlineHook(ctx, d.Id())
+		return err
+	})
+}
+
+// validateInlineHookOAuth checks that the credentials match the OAuth 2.0 client authentication method, and that the
+// OAUTH channel type is only used along with the 'oauth' block.
+func validateInlineHookOAuth(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	channelType := d.Get("channel").(map[string]interface{})["type"]
+	oauth, ok := d.GetOk("oauth")
+	if !ok {
+		if channelType == "OAUTH" {
+			return errors.New("'oauth' block is required for the OAUTH channel")
+		}
 		return nil
 	}
-	var err error
-	if desiredStatus == statusInactive {
-		_, _, err = client.InlineHook.DeactivateInlineHook(ctx, d.Id())
-	} else {
-		_, _, err = client.InlineHook.ActivateInlineHook(ctx, d.Id())
+	if channelType != nil && channelType != "OAUTH" {
+		return fmt.Errorf("channel type should be OAUTH when the 'oauth' block is set, got '%v'", channelType)
+	}
+	rawOAuth := oauth.([]interface{})[0].(map[string]interface{})
+	switch rawOAuth["auth_type"] {
+	case "client_secret_post":
+		if rawOAuth["client_secret"] == "" && d.NewValueKnown("oauth.0.client_secret") {
+			return errors.New("'client_secret' is required for the client_secret_post auth type")
+		}
+	case "private_key_jwt":
+		if rawOAuth["key_id"] == "" && d.NewValueKnown("oauth.0.key_id") {
+			return errors.New("'key_id' is required for the private_key_jwt auth type")
+		}
 	}
-	return err
+	return nil
 }
@@ -0,0 +1,220 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+const (
+	logStreamTypeEventBridge = "aws_eventbridge"
+	logStreamTypeSplunk      = "splunk_cloud_logstreaming"
+)
+
+func resourceLogStream() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLogStreamCreate,
+		ReadContext:   resourceLogStreamRead,
+		UpdateContext: resourceLogStreamUpdate,
+		DeleteContext: resourceLogStreamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name of the log stream",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the log stream: aws_eventbridge or splunk_cloud_logstreaming",
+			},
+			"status": buildStatusSchema("Status of the log stream: ACTIVE or INACTIVE"),
+			"aws_eventbridge": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aws_eventbridge", "splunk_cloud"},
+				Description:  "Settings of the AWS EventBridge log stream, they can't be changed once the log stream is created",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "AWS account ID",
+						},
+						"event_source_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Name of the partner event source in AWS",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "AWS region, e.g. us-east-1",
+						},
+					},
+				},
+			},
+			"splunk_cloud": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aws_eventbridge", "splunk_cloud"},
+				Description:  "Settings of the Splunk Cloud log stream",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"edition": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: stringInSlice([]string{"aws", "aws_govcloud", "gcp"}),
+							Description:      "Edition of the Splunk Cloud instance: aws, aws_govcloud or gcp",
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Host of the Splunk Cloud instance, e.g. acme.splunkcloud.com",
+						},
+						"token": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "HTTP Event Collector token",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceLogStreamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	stream, _, err := getSupplementFromMetadata(m).CreateLogStream(ctx, buildLogStream(d))
+	if err != nil {
+		return diag.Errorf("failed to create log stream: %v", err)
+	}
+	d.SetId(stream.Id)
+	err = setLogStreamStatus(ctx, d, m, stream.Status)
+	if err != nil {
+		return diag.Errorf("failed to change log stream status: %v", err)
+	}
+	return resourceLogStreamRead(ctx, d, m)
+}
+
+func resourceLogStreamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	stream, resp, err := getSupplementFromMetadata(m).GetLogStream(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get log stream: %v", err)
+	}
+	if stream == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", stream.Name)
+	_ = d.Set("type", stream.Type)
+	_ = d.Set("status", stream.Status)
+	err = setNonPrimitives(d, flattenLogStreamSettings(d, stream))
+	if err != nil {
+		return diag.Errorf("failed to set log stream properties: %v", err)
+	}
+	return nil
+}
+
+func resourceLogStreamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getSupplementFromMetadata(m)
+	if d.HasChanges("name", "splunk_cloud") {
+		_, _, err := client.UpdateLogStream(ctx, d.Id(), buildLogStream(d))
+		if err != nil {
+			return diag.Errorf("failed to update log stream: %v", err)
+		}
+	}
+	oldStatus, _ := d.GetChange("status")
+	err := setLogStreamStatus(ctx, d, m, oldStatus.(string))
+	if err != nil {
+		return diag.Errorf("failed to change log stream status: %v", err)
+	}
+	return resourceLogStreamRead(ctx, d, m)
+}
+
+func resourceLogStreamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getSupplementFromMetadata(m)
+	if d.Get("status").(string) == statusActive {
+		_, resp, err := client.DeactivateLogStream(ctx, d.Id())
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return diag.Errorf("failed to deactivate log stream before removing: %v", err)
+		}
+	}
+	resp, err := client.DeleteLogStream(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete log stream: %v", err)
+	}
+	return nil
+}
+
+func setLogStreamStatus(ctx context.Context, d *schema.ResourceData, m interface{}, status string) error {
+	client := getSupplementFromMetadata(m)
+	return changeStatus(status, d.Get("status").(string), func() error {
+		_, _, err := client.ActivateLogStream(ctx, d.Id())
+		return err
+	}, func() error {
+		_, _, err := client.DeactivateLogStream(ctx, d.Id())
+		return err
+	})
+}
+
+func buildLogStream(d *schema.ResourceData) sdk.LogStream {
+	stream := sdk.LogStream{
+		Name:     d.Get("name").(string),
+		Settings: &sdk.LogStreamSettings{},
+	}
+	if v, ok := d.GetOk("aws_eventbridge.0"); ok {
+		settings := v.(map[string]interface{})
+		stream.Type = logStreamTypeEventBridge
+		stream.Settings.AccountId = settings["account_id"].(string)
+		stream.Settings.EventSourceName = settings["event_source_name"].(string)
+		stream.Settings.Region = settings["region"].(string)
+	}
+	if v, ok := d.GetOk("splunk_cloud.0"); ok {
+		settings := v.(map[string]interface{})
+		stream.Type = logStreamTypeSplunk
+		stream.Settings.Edition = settings["edition"].(string)
+		stream.Settings.Host = settings["host"].(string)
+		stream.Settings.Token = settings["token"].(string)
+	}
+	return stream
+}
+
+// flattenLogStreamSettings returns the settings block of the log stream's type. The Splunk token is never returned by
+// the API, so it's kept from the state.
+func flattenLogStreamSettings(d *schema.ResourceData, stream *sdk.LogStream) map[string]interface{} {
+	blocks := map[string]interface{}{
+		"aws_eventbridge": nil,
+		"splunk_cloud":    nil,
+	}
+	if stream.Settings == nil {
+		return blocks
+	}
+	switch stream.Type {
+	case logStreamTypeEventBridge:
+		blocks["aws_eventbridge"] = []interface{}{map[string]interface{}{
+			"account_id":        stream.Settings.AccountId,
+			"event_source_name": stream.Settings.EventSourceName,
+			"region":            stream.Settings.Region,
+		}}
+	case logStreamTypeSplunk:
+		blocks["splunk_cloud"] = []interface{}{map[string]interface{}{
+			"edition": stream.Settings.Edition,
+			"host":    stream.Settings.Host,
+			"token":   d.Get("splunk_cloud.0.token").(string),
+		}}
+	}
+	return blocks
+}
@@ -0,0 +1,103 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func sweepLogStreams(client *testClient) error {
+	var errorList []error
+	streams, _, err := client.apiSupplement.ListLogStreams(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, stream := range streams {
+		if !strings.HasPrefix(stream.Name, testResourcePrefix) {
+			continue
+		}
+		if stream.Status == statusActive {
+			if _, _, err := client.apiSupplement.DeactivateLogStream(context.Background(), stream.Id); err != nil {
+				errorList = append(errorList, err)
+				continue
+			}
+		}
+		if _, err := client.apiSupplement.DeleteLogStream(context.Background(), stream.Id); err != nil {
+			errorList = append(errorList, err)
+		}
+	}
+	return condenseError(errorList)
+}
+
+func TestAccOktaLogStream_eventBridge(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(logStream)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", logStream)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(logStream, doesLogStreamExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					saveResourceID(resourceName, &id),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "type", logStreamTypeEventBridge),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "aws_eventbridge.0.account_id", "123456789012"),
+					resource.TestCheckResourceAttr(resourceName, "aws_eventbridge.0.region", "us-east-1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &id),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)+"_updated"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOktaLogStream_splunk(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(logStream)
+	config := mgr.GetFixtures("splunk.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", logStream)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(logStream, doesLogStreamExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", logStreamTypeSplunk),
+					resource.TestCheckResourceAttr(resourceName, "splunk_cloud.0.edition", "aws"),
+					resource.TestCheckResourceAttr(resourceName, "splunk_cloud.0.host", fmt.Sprintf("testacc-%d.splunkcloud.com", ri)),
+				),
+			},
+		},
+	})
+}
+
+func doesLogStreamExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetLogStream(context.Background(), id)
+	return doesResourceExist(response, err)
+}
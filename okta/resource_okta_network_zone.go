@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
 	"github.com/okta/terraform-provider-okta/sdk"
 )
 
@@ -20,18 +21,42 @@ func resourceNetworkZone() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
+			"asns": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Array of Autonomous System Numbers of the DYNAMIC or DYNAMIC_V2 zone",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"dynamic_locations": {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Description: "Array of locations ISO-3166-1(2). Format code: countryCode OR countryCode-regionCode",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"dynamic_locations_exclude": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Array of locations ISO-3166-1(2) excluded from the DYNAMIC_V2 zone. Format code: countryCode OR countryCode-regionCode",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"gateways": {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Description: "Array of values in CIDR/range form depending on the way it's been declared (i.e. CIDR will contain /suffix). Please check API docs for examples",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"ip_service_categories_include": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IP service categories, e.g. ALL_ANONYMIZERS or TOR_ANONYMIZER, included in the DYNAMIC_V2 zone",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ip_service_categories_exclude": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IP service categories excluded from the DYNAMIC_V2 zone",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -46,9 +71,10 @@ func resourceNetworkZone() *schema.Resource {
 			"type": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateDiagFunc: stringInSlice([]string{"IP", "DYNAMIC"}),
-				Description:      "Type of the Network Zone - can either be IP or DYNAMIC only",
+				ValidateDiagFunc: stringInSlice([]string{"IP", "DYNAMIC", sdk.NetworkZoneTypeDynamicV2}),
+				Description:      "Type of the Network Zone - can be IP, DYNAMIC or DYNAMIC_V2 (enhanced dynamic zone)",
 			},
+			"status": buildStatusSchema("Network Status - can either be ACTIVE or INACTIVE only"),
 			"usage": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -66,11 +92,15 @@ func resourceNetworkZoneCreate(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 	networkZone := buildNetworkZone(d)
-	_, _, err = getSupplementFromMetadata(m).CreateNetworkZone(ctx, networkZone, nil)
+	zone, _, err := getSupplementFromMetadata(m).CreateNetworkZone(ctx, networkZone, nil)
 	if err != nil {
 		return diag.Errorf("failed to create network zone: %v", err)
 	}
-	d.SetId(networkZone.ID)
+	d.SetId(zone.ID)
+	err = setNetworkZoneStatus(ctx, d, m, zone.Status)
+	if err != nil {
+		return diag.Errorf("failed to change network zone status: %v", err)
+	}
 	return resourceNetworkZoneRead(ctx, d, m)
 }
 
@@ -86,10 +116,19 @@ func resourceNetworkZoneRead(ctx context.Context, d *schema.ResourceData, m inte
 	_ = d.Set("name", zone.Name)
 	_ = d.Set("type", zone.Type)
 	_ = d.Set("usage", zone.Usage)
+	_ = d.Set("status", zone.Status)
+	ipServiceCategories := &sdk.NetworkZoneIncludeList{}
+	if zone.IPServiceCategories != nil {
+		ipServiceCategories = zone.IPServiceCategories
+	}
 	err = setNonPrimitives(d, map[string]interface{}{
-		"gateways":          flattenAddresses(zone.Gateways),
-		"proxies":           flattenAddresses(zone.Proxies),
-		"dynamic_locations": flattenDynamicLocations(zone.Locations),
+		"gateways":                      flattenAddresses(zone.Gateways),
+		"proxies":                       flattenAddresses(zone.Proxies),
+		"asns":                          convertStringSetToInterface(zone.Asns),
+		"dynamic_locations":             flattenDynamicLocations(zone.Locations),
+		"dynamic_locations_exclude":     flattenDynamicLocations(zone.ExcludedLocations),
+		"ip_service_categories_include": convertStringSetToInterface(ipServiceCategories.Include),
+		"ip_service_categories_exclude": convertStringSetToInterface(ipServiceCategories.Exclude),
 	})
 	if err != nil {
 		return diag.Errorf("failed to set network zone properties: %v", err)
@@ -102,15 +141,38 @@ func resourceNetworkZoneUpdate(ctx context.Context, d *schema.ResourceData, m in
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	client := getSupplementFromMetadata(m)
 	networkZone := buildNetworkZone(d)
-	_, _, err = getSupplementFromMetadata(m).UpdateNetworkZone(ctx, d.Id(), *networkZone, nil)
+	var zone *sdk.NetworkZone
+	err = retryOnConflict(ctx, func(merge bool) (*okta.Response, error) {
+		if merge {
+			latest, resp, err := client.GetNetworkZone(ctx, d.Id())
+			if err != nil {
+				return resp, err
+			}
+			mergeNetworkZone(d, networkZone, latest)
+		}
+		var resp *okta.Response
+		zone, resp, err = client.UpdateNetworkZone(ctx, d.Id(), *networkZone, nil)
+		return resp, err
+	})
 	if err != nil {
 		return diag.Errorf("failed to update network zone: %v", err)
 	}
+	err = setNetworkZoneStatus(ctx, d, m, zone.Status)
+	if err != nil {
+		return diag.Errorf("failed to change network zone status: %v", err)
+	}
 	return resourceNetworkZoneRead(ctx, d, m)
 }
 
 func resourceNetworkZoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.Get("status").(string) == statusActive {
+		_, resp, err := getSupplementFromMetadata(m).DeactivateNetworkZone(ctx, d.Id())
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return diag.Errorf("failed to deactivate network zone before removing: %v", err)
+		}
+	}
 	resp, err := getSupplementFromMetadata(m).DeleteNetworkZone(ctx, d.Id())
 	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to delete network zone: %v", err)
@@ -118,37 +180,77 @@ func resourceNetworkZoneDelete(ctx context.Context, d *schema.ResourceData, m in
 	return nil
 }
 
-func buildNetworkZone(d *schema.ResourceData) *sdk.NetworkZone {
-	var gatewaysList []*sdk.AddressObj
-	var proxiesList []*sdk.AddressObj
-	var locationsList []*sdk.Location
-	zoneType := d.Get("type").(string)
+func setNetworkZoneStatus(ctx context.Context, d *schema.ResourceData, m interface{}, status string) error {
+	client := getSupplementFromMetadata(m)
+	return changeStatus(status, d.Get("status").(string), func() error {
+		_, _, err := client.ActivateNetworkZone(ctx, d.Id())
+		return err
+	}, func() error {
+		_, _, err := client.DeactivateNetworkZone(ctx, d.Id())
+		return err
+	})
+}
 
-	if zoneType == "IP" {
+func buildNetworkZone(d *schema.ResourceData) *sdk.NetworkZone {
+	zone := &sdk.NetworkZone{
+		Name:  d.Get("name").(string),
+		Type:  d.Get("type").(string),
+		Usage: d.Get("usage").(string),
+	}
+	if zone.Type == "IP" {
 		if values, ok := d.GetOk("gateways"); ok {
-			gatewaysList = buildAddressObjList(values.(*schema.Set))
+			zone.Gateways = buildAddressObjList(values.(*schema.Set))
 		}
 		if values, ok := d.GetOk("proxies"); ok {
-			proxiesList = buildAddressObjList(values.(*schema.Set))
+			zone.Proxies = buildAddressObjList(values.(*schema.Set))
 		}
-	} else if values, ok := d.GetOk("dynamic_locations"); ok {
-		for _, value := range values.(*schema.Set).List() {
-			if strings.Contains(value.(string), "-") {
-				locationsList = append(locationsList, &sdk.Location{Country: strings.Split(value.(string), "-")[0], Region: value.(string)})
-			} else {
-				locationsList = append(locationsList, &sdk.Location{Country: value.(string)})
-			}
+		return zone
+	}
+	zone.Asns = convertInterfaceToStringSetNullable(d.Get("asns"))
+	zone.Locations = buildLocationList(d, "dynamic_locations")
+	if zone.Type == sdk.NetworkZoneTypeDynamicV2 {
+		zone.ExcludedLocations = buildLocationList(d, "dynamic_locations_exclude")
+		include := convertInterfaceToStringSetNullable(d.Get("ip_service_categories_include"))
+		exclude := convertInterfaceToStringSetNullable(d.Get("ip_service_categories_exclude"))
+		if len(include) != 0 || len(exclude) != 0 {
+			zone.IPServiceCategories = &sdk.NetworkZoneIncludeList{Include: include, Exclude: exclude}
+		}
+	}
+	return zone
+}
+
+func buildLocationList(d *schema.ResourceData, key string) []*sdk.Location {
+	values, ok := d.GetOk(key)
+	if !ok {
+		return nil
+	}
+	var locationsList []*sdk.Location
+	for _, value := range values.(*schema.Set).List() {
+		if strings.Contains(value.(string), "-") {
+			locationsList = append(locationsList, &sdk.Location{Country: strings.Split(value.(string), "-")[0], Region: value.(string)})
+		} else {
+			locationsList = append(locationsList, &sdk.Location{Country: value.(string)})
 		}
 	}
+	return locationsList
+}
 
-	return &sdk.NetworkZone{
-		Name:      d.Get("name").(string),
-		Type:      zoneType,
-		Gateways:  gatewaysList,
-		Locations: locationsList,
-		Proxies:   proxiesList,
-		Usage:     d.Get("usage").(string),
+// mergeNetworkZone applies the changes of the gateways and proxies to the latest version of the zone, which was
+// modified concurrently.
+func mergeNetworkZone(d *schema.ResourceData, networkZone, latest *sdk.NetworkZone) {
+	if networkZone.Type != "IP" {
+		return
 	}
+	networkZone.Gateways = buildAddressObjList(convertStringSetToInterface(mergeStringSet(d, "gateways", addressValues(latest.Gateways))))
+	networkZone.Proxies = buildAddressObjList(convertStringSetToInterface(mergeStringSet(d, "proxies", addressValues(latest.Proxies))))
+}
+
+func addressValues(addresses []*sdk.AddressObj) []string {
+	values := make([]string, len(addresses))
+	for i := range addresses {
+		values[i] = addresses[i].Value
+	}
+	return values
 }
 
 func buildAddressObjList(values *schema.Set) []*sdk.AddressObj {
@@ -190,5 +292,16 @@ func validateNetworkZone(d *schema.ResourceData) error {
 	if d.Get("usage").(string) != "POLICY" && ok && proxies.(*schema.Set).Len() != 0 {
 		return fmt.Errorf(`zones with usage = "BLOCKLIST" cannot have trusted proxies`)
 	}
+	zoneType := d.Get("type").(string)
+	if v, ok := d.GetOk("asns"); ok && zoneType == "IP" && v.(*schema.Set).Len() != 0 {
+		return fmt.Errorf("'asns' can only be set for the DYNAMIC or DYNAMIC_V2 zones")
+	}
+	if zoneType != sdk.NetworkZoneTypeDynamicV2 {
+		for _, key := range []string{"dynamic_locations_exclude", "ip_service_categories_include", "ip_service_categories_exclude"} {
+			if v, ok := d.GetOk(key); ok && v.(*schema.Set).Len() != 0 {
+				return fmt.Errorf("'%s' can only be set for the DYNAMIC_V2 zones", key)
+			}
+		}
+	}
 	return nil
 }
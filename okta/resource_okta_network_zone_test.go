@@ -2,12 +2,15 @@ package okta
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func sweepNetworkZones(client *testClient) error {
@@ -47,6 +50,7 @@ func TestAccOktaNetworkZone_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "proxies.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "gateways.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "usage", "POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
 					resource.TestCheckResourceAttr(dynamicResourceName, "name", fmt.Sprintf("testAcc_%d Dynamic", ri)),
 					resource.TestCheckResourceAttr(dynamicResourceName, "type", "DYNAMIC"),
 					resource.TestCheckResourceAttr(dynamicResourceName, "dynamic_locations.#", "2"),
@@ -63,6 +67,7 @@ func TestAccOktaNetworkZone_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(dynamicResourceName, "name", fmt.Sprintf("testAcc_%d Dynamic Updated", ri)),
 					resource.TestCheckResourceAttr(dynamicResourceName, "type", "DYNAMIC"),
 					resource.TestCheckResourceAttr(dynamicResourceName, "dynamic_locations.#", "3"),
+					resource.TestCheckResourceAttr(dynamicResourceName, "status", statusInactive),
 				),
 			},
 		},
@@ -73,3 +78,78 @@ func doesNetworkZoneExist(id string) (bool, error) {
 	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetNetworkZone(context.Background(), id)
 	return doesResourceExist(response, err)
 }
+
+func TestAccOktaNetworkZone_dynamicV2(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(networkZone)
+	config := mgr.GetFixtures("dynamic_v2.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("dynamic_v2_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.dynamic_v2_network_zone_example", networkZone)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(networkZone, doesNetworkZoneExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "DYNAMIC_V2"),
+					resource.TestCheckResourceAttr(resourceName, "usage", "BLOCKLIST"),
+					resource.TestCheckResourceAttr(resourceName, "asns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dynamic_locations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "dynamic_locations_exclude.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_service_categories_include.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_service_categories_exclude.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "asns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dynamic_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dynamic_locations_exclude.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ip_service_categories_include.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ip_service_categories_exclude.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+				),
+			},
+		},
+	})
+}
+
+func TestNetworkZoneJSON(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNetworkZone().Schema, map[string]interface{}{
+		"name":                          "test",
+		"type":                          "DYNAMIC_V2",
+		"asns":                          []interface{}{"23457"},
+		"dynamic_locations":             []interface{}{"US-CA"},
+		"dynamic_locations_exclude":     []interface{}{"AF"},
+		"ip_service_categories_include": []interface{}{"ALL_ANONYMIZERS"},
+	})
+	body, err := json.Marshal(buildNetworkZone(d))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `"asns":{"include":["23457"]},"locations":{"include":[{"country":"US","region":"US-CA"}],"exclude":[{"country":"AF"}]}`
+	if !strings.Contains(string(body), expected) || !strings.Contains(string(body), `"ipServiceCategories":{"include":["ALL_ANONYMIZERS"]}`) {
+		t.Fatalf("unexpected DYNAMIC_V2 zone body: %s", body)
+	}
+
+	var zone sdk.NetworkZone
+	if err := json.Unmarshal(body, &zone); err != nil {
+		t.Fatal(err)
+	}
+	if len(zone.Asns) != 1 || len(zone.Locations) != 1 || len(zone.ExcludedLocations) != 1 || zone.IPServiceCategories == nil {
+		t.Fatalf("unexpected DYNAMIC_V2 zone: %+v", zone)
+	}
+
+	err = json.Unmarshal([]byte(`{"type":"DYNAMIC","asns":["23457"],"locations":[{"country":"US"}]}`), &zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zone.Asns) != 1 || len(zone.Locations) != 1 || len(zone.ExcludedLocations) != 0 {
+		t.Fatalf("unexpected DYNAMIC zone: %+v", zone)
+	}
+}
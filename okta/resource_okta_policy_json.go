@@ -0,0 +1,183 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// policyJSONReadOnlyAttributes are managed by Okta, so they are not kept in 'policy_json'.
+var policyJSONReadOnlyAttributes = []string{"_embedded", "_links", "created", "id", "lastUpdated", "system", "type"}
+
+// resourcePolicyJSON manages the policy from its raw JSON representation, which allows to use the policy attributes,
+// which are not supported by the typed policy resources yet.
+func resourcePolicyJSON() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyJSONCreate,
+		ReadContext:   resourcePolicyJSONRead,
+		UpdateContext: resourcePolicyJSONUpdate,
+		DeleteContext: resourcePolicyJSONDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Policy type, e.g. OKTA_SIGN_ON, PASSWORD, MFA_ENROLL or ACCESS_POLICY",
+			},
+			"policy_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringIsJSON,
+				StateFunc:        normalizeDataJSON,
+				DiffSuppressFunc: suppressPolicyJSONDiff,
+				Description:      "Policy in JSON format, as it's sent to Okta API, without the 'type'",
+			},
+			"export_json": exportJSONSchema,
+		},
+	}
+}
+
+func resourcePolicyJSONCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	body, err := buildPolicyJSON(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	logger(m).Info("creating policy", "name", body["name"], "type", body["type"])
+	policy, _, err := getSupplementFromMetadata(m).CreateRawPolicy(ctx, body)
+	if err != nil {
+		return diag.Errorf("failed to create policy: %v", err)
+	}
+	d.SetId(policy["id"].(string))
+	err = setPolicyJSONStatus(ctx, d, m, body, policy)
+	if err != nil {
+		return diag.Errorf("failed to change policy's status: %v", err)
+	}
+	return resourcePolicyJSONRead(ctx, d, m)
+}
+
+func resourcePolicyJSONRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger(m).Info("getting policy", "id", d.Id())
+	policy, resp, err := getSupplementFromMetadata(m).GetObject(ctx, fmt.Sprintf("/api/v1/policies/%s", d.Id()))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get policy: %v", err)
+	}
+	if policy == nil {
+		d.SetId("")
+		return nil
+	}
+	exported, err := json.Marshal(policy)
+	if err != nil {
+		return diag.Errorf("failed to marshal policy: %v", err)
+	}
+	_ = d.Set("type", policy["type"])
+	_ = d.Set("export_json", string(exported))
+	for _, attr := range policyJSONReadOnlyAttributes {
+		delete(policy, attr)
+	}
+	policyJSON, err := json.Marshal(policy)
+	if err != nil {
+		return diag.Errorf("failed to marshal policy: %v", err)
+	}
+	_ = d.Set("policy_json", string(policyJSON))
+	return nil
+}
+
+func resourcePolicyJSONUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	body, err := buildPolicyJSON(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	logger(m).Info("updating policy", "id", d.Id())
+	policy, _, err := getSupplementFromMetadata(m).UpdateRawPolicy(ctx, d.Id(), body)
+	if err != nil {
+		return diag.Errorf("failed to update policy: %v", err)
+	}
+	err = setPolicyJSONStatus(ctx, d, m, body, policy)
+	if err != nil {
+		return diag.Errorf("failed to change policy's status: %v", err)
+	}
+	return resourcePolicyJSONRead(ctx, d, m)
+}
+
+func resourcePolicyJSONDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger(m).Info("deleting policy", "id", d.Id())
+	resp, err := getOktaClientFromMetadata(m).Policy.DeletePolicy(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete policy: %v", err)
+	}
+	return nil
+}
+
+func buildPolicyJSON(d *schema.ResourceData) (map[string]interface{}, error) {
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("policy_json").(string)), &body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal 'policy_json': %v", err)
+	}
+	body["type"] = d.Get("type").(string)
+	return body, nil
+}
+
+// setPolicyJSONStatus activates or deactivates the policy, when the configured status is not the one of the policy,
+// since Okta API changes the status of the policy only with the lifecycle operations.
+func setPolicyJSONStatus(ctx context.Context, d *schema.ResourceData, m interface{}, body, policy map[string]interface{}) error {
+	status, ok := body["status"].(string)
+	if !ok || status == policy["status"] {
+		return nil
+	}
+	client := getOktaClientFromMetadata(m)
+	var err error
+	if status == statusInactive {
+		_, err = client.Policy.DeactivatePolicy(ctx, d.Id())
+	} else {
+		_, err = client.Policy.ActivatePolicy(ctx, d.Id())
+	}
+	return err
+}
+
+// suppressPolicyJSONDiff compares only the attributes set in the configuration, since Okta returns all the attributes
+// of the policy, including the defaults.
+func suppressPolicyJSONDiff(k, old, new string, d *schema.ResourceData) bool {
+	var oldPolicy, newPolicy interface{}
+	if json.Unmarshal([]byte(old), &oldPolicy) != nil || json.Unmarshal([]byte(new), &newPolicy) != nil {
+		return false
+	}
+	return jsonContains(oldPolicy, newPolicy)
+}
+
+// jsonContains reports whether all the values of the configured JSON are equal to the ones of the actual JSON,
+// ignoring the object attributes, which are set only in the actual one.
+func jsonContains(actual, configured interface{}) bool {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range c {
+			if !jsonContains(a[k], v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(c) {
+			return false
+		}
+		for i := range c {
+			if !jsonContains(a[i], c[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(actual, configured)
+	}
+}
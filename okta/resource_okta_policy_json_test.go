@@ -0,0 +1,65 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestSuppressPolicyJSONDiff(t *testing.T) {
+	old := `{"name":"test","priority":1,"conditions":{"people":{"groups":{"include":["00g1"]}},"network":{"connection":"ANYWHERE"}}}`
+	cases := []struct {
+		new      string
+		expected bool
+	}{
+		{`{"name":"test"}`, true},
+		{`{"conditions":{"people":{"groups":{"include":["00g1"]}}},"name":"test"}`, true},
+		{`{"name":"test","priority":2}`, false},
+		{`{"conditions":{"people":{"groups":{"include":["00g1","00g2"]}}}}`, false},
+		{`{"conditions":{"network":{"connection":"ZONE"}}}`, false},
+		{`{"description":"test"}`, false},
+	}
+	for i, c := range cases {
+		if actual := suppressPolicyJSONDiff("policy_json", old, c.new, nil); actual != c.expected {
+			t.Errorf("case %d: expected %v, got %v", i, c.expected, actual)
+		}
+	}
+}
+
+func TestAccOktaPolicyJSON_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyJSON)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyJSON)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createPolicyCheckDestroy(policyJSON),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensurePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "OKTA_SIGN_ON"),
+					resource.TestCheckResourceAttrSet(resourceName, "export_json"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensurePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "OKTA_SIGN_ON"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -20,7 +21,15 @@ func resourcePolicyMfa() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: buildPolicySchema(buildFactorProviders()),
+		CustomizeDiff: validateMfaPolicySettings,
+		Schema: buildPolicySchema(buildSchema(buildFactorProviders(), map[string]*schema.Schema{
+			"is_oie": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use the authenticators of Okta Identity Engine instead of the factors",
+			},
+		})),
 	}
 }
 
@@ -41,21 +50,13 @@ func resourcePolicyMfaRead(ctx context.Context, d *schema.ResourceData, m interf
 	if policy == nil {
 		return nil
 	}
-	syncFactor(d, sdk.DuoFactor, policy.Settings.Factors.Duo)
-	syncFactor(d, sdk.FidoU2fFactor, policy.Settings.Factors.FidoU2f)
-	syncFactor(d, sdk.FidoWebauthnFactor, policy.Settings.Factors.FidoWebauthn)
-	syncFactor(d, sdk.GoogleOtpFactor, policy.Settings.Factors.GoogleOtp)
-	syncFactor(d, sdk.OktaCallFactor, policy.Settings.Factors.OktaCall)
-	syncFactor(d, sdk.OktaOtpFactor, policy.Settings.Factors.OktaOtp)
-	syncFactor(d, sdk.OktaPasswordFactor, policy.Settings.Factors.OktaPassword)
-	syncFactor(d, sdk.OktaPushFactor, policy.Settings.Factors.OktaPush)
-	syncFactor(d, sdk.OktaQuestionFactor, policy.Settings.Factors.OktaQuestion)
-	syncFactor(d, sdk.OktaSmsFactor, policy.Settings.Factors.OktaSms)
-	syncFactor(d, sdk.OktaEmailFactor, policy.Settings.Factors.OktaEmail)
-	syncFactor(d, sdk.RsaTokenFactor, policy.Settings.Factors.RsaToken)
-	syncFactor(d, sdk.SymantecVipFactor, policy.Settings.Factors.SymantecVip)
-	syncFactor(d, sdk.YubikeyTokenFactor, policy.Settings.Factors.YubikeyToken)
-	syncFactor(d, sdk.HotpFactor, policy.Settings.Factors.YubikeyToken)
+	if policy.Settings != nil && policy.Settings.Type == sdk.AuthenticatorsPolicySettingsType {
+		_ = d.Set("is_oie", true)
+		syncMfaPolicyAuthenticators(d, policy.Settings.Authenticators)
+	} else if policy.Settings != nil && policy.Settings.Factors != nil {
+		_ = d.Set("is_oie", false)
+		syncMfaPolicyFactors(d, policy.Settings.Factors)
+	}
 	err = syncPolicyFromUpstream(d, policy)
 	if err != nil {
 		return diag.Errorf("failed to sync policy: %v", err)
@@ -63,6 +64,41 @@ func resourcePolicyMfaRead(ctx context.Context, d *schema.ResourceData, m interf
 	return nil
 }
 
+// syncMfaPolicyAuthenticators sets the enrollment settings of the authenticators. The authenticators, which are missing
+// from the policy, e.g. removed outside of Terraform, are reset, so the configured ones show up as a diff.
+func syncMfaPolicyAuthenticators(d *schema.ResourceData, policyAuthenticators []*sdk.PolicyAuthenticator) {
+	found := make(map[string]bool, len(policyAuthenticators))
+	for _, a := range policyAuthenticators {
+		if a.Enroll != nil && contains(authenticators, a.Key) {
+			found[a.Key] = true
+			_ = d.Set(a.Key, map[string]interface{}{"enroll": a.Enroll.Self})
+		}
+	}
+	for _, key := range authenticators {
+		if !found[key] {
+			_ = d.Set(key, nil)
+		}
+	}
+}
+
+func syncMfaPolicyFactors(d *schema.ResourceData, factors *sdk.PolicyFactorsSettings) {
+	syncFactor(d, sdk.DuoFactor, factors.Duo)
+	syncFactor(d, sdk.FidoU2fFactor, factors.FidoU2f)
+	syncFactor(d, sdk.FidoWebauthnFactor, factors.FidoWebauthn)
+	syncFactor(d, sdk.GoogleOtpFactor, factors.GoogleOtp)
+	syncFactor(d, sdk.OktaCallFactor, factors.OktaCall)
+	syncFactor(d, sdk.OktaOtpFactor, factors.OktaOtp)
+	syncFactor(d, sdk.OktaPasswordFactor, factors.OktaPassword)
+	syncFactor(d, sdk.OktaPushFactor, factors.OktaPush)
+	syncFactor(d, sdk.OktaQuestionFactor, factors.OktaQuestion)
+	syncFactor(d, sdk.OktaSmsFactor, factors.OktaSms)
+	syncFactor(d, sdk.OktaEmailFactor, factors.OktaEmail)
+	syncFactor(d, sdk.RsaTokenFactor, factors.RsaToken)
+	syncFactor(d, sdk.SymantecVipFactor, factors.SymantecVip)
+	syncFactor(d, sdk.YubikeyTokenFactor, factors.YubikeyToken)
+	syncFactor(d, sdk.HotpFactor, factors.Hotp)
+}
+
 func resourcePolicyMfaUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	policy := buildMFAPolicy(d)
 	err := updatePolicy(ctx, d, m, policy)
@@ -106,24 +142,13 @@ func buildMFAPolicy(d *schema.ResourceData) sdk.Policy {
 	if priority, ok := d.GetOk("priority"); ok {
 		policy.Priority = int64(priority.(int))
 	}
-	policy.Settings = &sdk.PolicySettings{
-		Factors: &sdk.PolicyFactorsSettings{
-			Duo:          buildFactorProvider(d, sdk.DuoFactor),
-			FidoU2f:      buildFactorProvider(d, sdk.FidoU2fFactor),
-			FidoWebauthn: buildFactorProvider(d, sdk.FidoWebauthnFactor),
-			GoogleOtp:    buildFactorProvider(d, sdk.GoogleOtpFactor),
-			OktaCall:     buildFactorProvider(d, sdk.OktaCallFactor),
-			OktaOtp:      buildFactorProvider(d, sdk.OktaOtpFactor),
-			OktaPassword: buildFactorProvider(d, sdk.OktaPasswordFactor),
-			OktaPush:     buildFactorProvider(d, sdk.OktaPushFactor),
-			OktaQuestion: buildFactorProvider(d, sdk.OktaQuestionFactor),
-			OktaSms:      buildFactorProvider(d, sdk.OktaSmsFactor),
-			OktaEmail:    buildFactorProvider(d, sdk.OktaEmailFactor),
-			RsaToken:     buildFactorProvider(d, sdk.RsaTokenFactor),
-			SymantecVip:  buildFactorProvider(d, sdk.SymantecVipFactor),
-			YubikeyToken: buildFactorProvider(d, sdk.YubikeyTokenFactor),
-			Hotp:         buildFactorProvider(d, sdk.HotpFactor),
-		},
+	if d.Get("is_oie").(bool) {
+		policy.Settings = &sdk.PolicySettings{
+			Type:           sdk.AuthenticatorsPolicySettingsType,
+			Authenticators: buildPolicyAuthenticators(d),
+		}
+	} else {
+		policy.Settings = &sdk.PolicySettings{Factors: buildMfaPolicyFactors(d)}
 	}
 	policy.Conditions = &okta.PolicyRuleConditions{
 		People: getGroups(d),
@@ -131,14 +156,52 @@ func buildMFAPolicy(d *schema.ResourceData) sdk.Policy {
 	return policy
 }
 
+func buildMfaPolicyFactors(d *schema.ResourceData) *sdk.PolicyFactorsSettings {
+	return &sdk.PolicyFactorsSettings{
+		Duo:          buildFactorProvider(d, sdk.DuoFactor),
+		FidoU2f:      buildFactorProvider(d, sdk.FidoU2fFactor),
+		FidoWebauthn: buildFactorProvider(d, sdk.FidoWebauthnFactor),
+		GoogleOtp:    buildFactorProvider(d, sdk.GoogleOtpFactor),
+		OktaCall:     buildFactorProvider(d, sdk.OktaCallFactor),
+		OktaOtp:      buildFactorProvider(d, sdk.OktaOtpFactor),
+		OktaPassword: buildFactorProvider(d, sdk.OktaPasswordFactor),
+		OktaPush:     buildFactorProvider(d, sdk.OktaPushFactor),
+		OktaQuestion: buildFactorProvider(d, sdk.OktaQuestionFactor),
+		OktaSms:      buildFactorProvider(d, sdk.OktaSmsFactor),
+		OktaEmail:    buildFactorProvider(d, sdk.OktaEmailFactor),
+		RsaToken:     buildFactorProvider(d, sdk.RsaTokenFactor),
+		SymantecVip:  buildFactorProvider(d, sdk.SymantecVipFactor),
+		YubikeyToken: buildFactorProvider(d, sdk.YubikeyTokenFactor),
+		Hotp:         buildFactorProvider(d, sdk.HotpFactor),
+	}
+}
+
+// buildPolicyAuthenticators returns only the authenticators with the enrollment settings, since Okta Identity Engine
+// does not allow consent settings for the authenticators.
+func buildPolicyAuthenticators(d *schema.ResourceData) []*sdk.PolicyAuthenticator {
+	var res []*sdk.PolicyAuthenticator
+	for _, key := range authenticators {
+		enroll, ok := d.Get(key).(map[string]interface{})["enroll"]
+		if !ok {
+			continue
+		}
+		res = append(res, &sdk.PolicyAuthenticator{Key: key, Enroll: &sdk.Enroll{Self: enroll.(string)}})
+	}
+	return res
+}
+
 func syncFactor(d *schema.ResourceData, k string, f *sdk.PolicyFactor) {
 	if f == nil {
 		return
 	}
-	_ = d.Set(k, map[string]interface{}{
-		"consent_type": f.Consent.Type,
-		"enroll":       f.Enroll.Self,
-	})
+	factor := map[string]interface{}{}
+	if f.Consent != nil {
+		factor["consent_type"] = f.Consent.Type
+	}
+	if f.Enroll != nil {
+		factor["enroll"] = f.Enroll.Self
+	}
+	_ = d.Set(k, factor)
 }
 
 var factorProviders = []string{
@@ -159,10 +222,40 @@ var factorProviders = []string{
 	sdk.HotpFactor,
 }
 
-// List of factor provider above, they all follow the same schema
+var authenticators = []string{
+	sdk.CustomOtpAuthenticator,
+	sdk.DuoAuthenticator,
+	sdk.GoogleOtpAuthenticator,
+	sdk.OktaEmailAuthenticator,
+	sdk.OktaPasswordAuthenticator,
+	sdk.OktaVerifyAuthenticator,
+	sdk.PhoneNumberAuthenticator,
+	sdk.RsaTokenAuthenticator,
+	sdk.SecurityQuestionAuthenticator,
+	sdk.SymantecVipAuthenticator,
+	sdk.WebauthnAuthenticator,
+	sdk.YubikeyTokenAuthenticator,
+}
+
+// validateMfaPolicySettings verifies that only the factors are set in the classic orgs, and only the authenticators
+// are set in the Okta Identity Engine orgs, since some of them share the same keys.
+func validateMfaPolicySettings(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	valid, other := factorProviders, "is_oie = true"
+	if d.Get("is_oie").(bool) {
+		valid, other = authenticators, "is_oie = false"
+	}
+	for _, key := range append(factorProviders, authenticators...) {
+		if _, ok := d.GetOk(key); ok && !contains(valid, key) {
+			return fmt.Errorf("'%s' can only be set with %s", key, other)
+		}
+	}
+	return nil
+}
+
+// List of factor provider and authenticators above, they all follow the same schema
 func buildFactorProviders() map[string]*schema.Schema {
 	res := make(map[string]*schema.Schema)
-	for _, key := range factorProviders {
+	for _, key := range append(factorProviders, authenticators...) {
 		res[key] = &schema.Schema{
 			Optional: true,
 			Type:     schema.TypeMap,
@@ -2,10 +2,12 @@ package okta
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/terraform-provider-okta/sdk"
 )
 
@@ -52,3 +54,74 @@ func TestAccOktaMfaPolicy_crud(t *testing.T) {
 		},
 	})
 }
+
+// Note: this test requires an Okta Identity Engine org.
+func TestAccOktaMfaPolicy_oie(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyMfa)
+	config := mgr.GetFixtures("oie.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyMfa)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createPolicyCheckDestroy(policyMfa),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensurePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "is_oie", "true"),
+					resource.TestCheckResourceAttr(resourceName, "okta_password.enroll", "REQUIRED"),
+					resource.TestCheckResourceAttr(resourceName, "okta_verify.enroll", "REQUIRED"),
+					resource.TestCheckResourceAttr(resourceName, "okta_email.enroll", "NOT_ALLOWED"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildMFAPolicyAuthenticators(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePolicyMfa().Schema, map[string]interface{}{
+		"name":          "test",
+		"is_oie":        true,
+		"okta_verify":   map[string]interface{}{"enroll": "REQUIRED"},
+		"okta_password": map[string]interface{}{"enroll": "REQUIRED"},
+		"phone_number":  map[string]interface{}{"enroll": "OPTIONAL"},
+	})
+	policy := buildMFAPolicy(d)
+	if policy.Settings.Type != sdk.AuthenticatorsPolicySettingsType || policy.Settings.Factors != nil {
+		t.Fatalf("expected policy settings to contain only the authenticators, actual: %+v", policy.Settings)
+	}
+	actual := map[string]string{}
+	for _, a := range policy.Settings.Authenticators {
+		actual[a.Key] = a.Enroll.Self
+	}
+	expected := map[string]string{
+		sdk.OktaVerifyAuthenticator:   "REQUIRED",
+		sdk.OktaPasswordAuthenticator: "REQUIRED",
+		sdk.PhoneNumberAuthenticator:  "OPTIONAL",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected authenticators %v, actual: %v", expected, actual)
+	}
+}
+
+func TestSyncMfaPolicyAuthenticators(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePolicyMfa().Schema, map[string]interface{}{
+		"name":         "test",
+		"is_oie":       true,
+		"okta_verify":  map[string]interface{}{"enroll": "REQUIRED"},
+		"phone_number": map[string]interface{}{"enroll": "OPTIONAL"},
+	})
+	// the phone number authenticator was removed outside of Terraform
+	syncMfaPolicyAuthenticators(d, []*sdk.PolicyAuthenticator{
+		{Key: sdk.OktaVerifyAuthenticator, Enroll: &sdk.Enroll{Self: "OPTIONAL"}},
+	})
+	if enroll := d.Get(sdk.OktaVerifyAuthenticator + ".enroll"); enroll != "OPTIONAL" {
+		t.Errorf("expected okta_verify enrollment to be synced, got %v", enroll)
+	}
+	if phone := d.Get(sdk.PhoneNumberAuthenticator).(map[string]interface{}); len(phone) != 0 {
+		t.Errorf("expected the removed authenticator to be reset, got %v", phone)
+	}
+}
@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -128,7 +129,10 @@ func resourcePolicyPassword() *schema.Resource {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Description: "Notification channels to use to notify a user when their account has been locked.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: stringInSlice([]string{"EMAIL"}),
+				},
 			},
 			"question_min_length": {
 				Type:        schema.TypeInt,
@@ -204,46 +208,9 @@ func resourcePolicyPasswordRead(ctx context.Context, d *schema.ResourceData, m i
 		_ = d.Set("auth_provider", policy.Conditions.AuthProvider.Provider)
 	}
 
-	if policy.Settings != nil {
-		err = d.Set("password_lockout_notification_channels", convertStringSetToInterface(policy.Settings.Password.Lockout.UserLockoutNotificationChannels))
-		if err != nil {
-			return diag.Errorf("error setting notification channels for resource %s: %v", d.Id(), err)
-		}
-		_ = d.Set("password_min_length", policy.Settings.Password.Complexity.MinLength)
-		_ = d.Set("password_min_lowercase", policy.Settings.Password.Complexity.MinLowerCase)
-		_ = d.Set("password_min_uppercase", policy.Settings.Password.Complexity.MinUpperCase)
-		_ = d.Set("password_min_number", policy.Settings.Password.Complexity.MinNumber)
-		_ = d.Set("password_min_symbol", policy.Settings.Password.Complexity.MinSymbol)
-		_ = d.Set("password_exclude_username", policy.Settings.Password.Complexity.ExcludeUsername)
-		if policy.Settings.Password.Complexity.Dictionary != nil && policy.Settings.Password.Complexity.Dictionary.Common != nil {
-			_ = d.Set("password_dictionary_lookup", policy.Settings.Password.Complexity.Dictionary.Common.Exclude)
-		}
-		_ = d.Set("password_max_age_days", policy.Settings.Password.Age.MaxAgeDays)
-		_ = d.Set("password_expire_warn_days", policy.Settings.Password.Age.ExpireWarnDays)
-		_ = d.Set("password_min_age_minutes", policy.Settings.Password.Age.MinAgeMinutes)
-		_ = d.Set("password_history_count", policy.Settings.Password.Age.HistoryCount)
-		_ = d.Set("password_max_lockout_attempts", policy.Settings.Password.Lockout.MaxAttempts)
-		_ = d.Set("password_auto_unlock_minutes", policy.Settings.Password.Lockout.AutoUnlockMinutes)
-		_ = d.Set("password_show_lockout_failures", policy.Settings.Password.Lockout.ShowLockoutFailures)
-		_ = d.Set("question_min_length", policy.Settings.Recovery.Factors.RecoveryQuestion.Properties.Complexity.MinLength)
-		_ = d.Set("recovery_email_token", policy.Settings.Recovery.Factors.OktaEmail.Properties.RecoveryToken.TokenLifetimeMinutes)
-		_ = d.Set("sms_recovery", policy.Settings.Recovery.Factors.OktaSms.Status)
-		_ = d.Set("email_recovery", policy.Settings.Recovery.Factors.OktaEmail.Status)
-		_ = d.Set("question_recovery", policy.Settings.Recovery.Factors.RecoveryQuestion.Status)
-		_ = d.Set("call_recovery", policy.Settings.Recovery.Factors.OktaCall.Status)
-		_ = d.Set("skip_unlock", policy.Settings.Delegation.Options.SkipUnlock)
-
-		excludedAttrs := policy.Settings.Password.Complexity.ExcludeAttributes
-		if len(excludedAttrs) > 0 {
-			for _, v := range excludedAttrs {
-				switch v {
-				case "firstName":
-					_ = d.Set("password_exclude_first_name", true)
-				case "lastName":
-					_ = d.Set("password_exclude_last_name", true)
-				}
-			}
-		}
+	err = setPasswordPolicySettings(d, policy.Settings)
+	if err != nil {
+		return diag.Errorf("failed to set password policy settings: %v", err)
 	}
 	err = syncPolicyFromUpstream(d, policy)
 	if err != nil {
@@ -286,9 +253,14 @@ func buildPasswordPolicy(d *schema.ResourceData) sdk.Policy {
 		},
 		People: getGroups(d),
 	}
-	// Okta defaults
-	// we add the defaults here & not in the schema map to avoid defaults appearing in the terraform plan diff
-	template.Settings = &sdk.PolicySettings{
+	template.Settings = buildPasswordPolicySettings(d)
+	return template
+}
+
+// buildPasswordPolicySettings returns the settings, which are shared by the password policy and the default one.
+// Okta defaults are added here & not in the schema map to avoid defaults appearing in the terraform plan diff.
+func buildPasswordPolicySettings(d *schema.ResourceData) *sdk.PolicySettings {
+	return &sdk.PolicySettings{
 		Password: &sdk.PasswordPolicyPasswordSettings{
 			Age: &sdk.PasswordPolicyPasswordSettingsAge{
 				ExpireWarnDays: int64(d.Get("password_expire_warn_days").(int)),
@@ -317,39 +289,113 @@ func buildPasswordPolicy(d *schema.ResourceData) sdk.Policy {
 				UserLockoutNotificationChannels: convertInterfaceToStringSet(d.Get("password_lockout_notification_channels")),
 			},
 		},
-		Recovery: &sdk.PasswordPolicyRecoverySettings{
-			Factors: &sdk.PasswordPolicyRecoveryFactors{
-				OktaCall: &okta.PasswordPolicyRecoveryFactorSettings{
-					Status: d.Get("call_recovery").(string),
-				},
-				OktaSms: &okta.PasswordPolicyRecoveryFactorSettings{
-					Status: d.Get("sms_recovery").(string),
-				},
-				OktaEmail: &sdk.PasswordPolicyRecoveryEmail{
-					Properties: &sdk.PasswordPolicyRecoveryEmailProperties{
-						RecoveryToken: &sdk.PasswordPolicyRecoveryEmailRecoveryToken{
-							TokenLifetimeMinutes: int64(d.Get("recovery_email_token").(int)),
-						},
+		Recovery: buildPasswordPolicyRecoverySettings(d),
+		Delegation: &okta.PasswordPolicyDelegationSettings{
+			Options: &okta.PasswordPolicyDelegationSettingsOptions{
+				SkipUnlock: boolPtr(d.Get("skip_unlock").(bool)),
+			},
+		},
+	}
+}
+
+// setPasswordPolicySettings sets all the password policy settings to the state. The settings omitted by the API are
+// set to their zero values, so the changes made outside of terraform are always detected.
+func setPasswordPolicySettings(d *schema.ResourceData, settings *sdk.PolicySettings) error {
+	if settings == nil {
+		return nil
+	}
+	if settings.Password != nil {
+		if age := settings.Password.Age; age != nil {
+			_ = d.Set("password_max_age_days", age.MaxAgeDays)
+			_ = d.Set("password_expire_warn_days", age.ExpireWarnDays)
+			_ = d.Set("password_min_age_minutes", age.MinAgeMinutes)
+			_ = d.Set("password_history_count", age.HistoryCount)
+		}
+		if complexity := settings.Password.Complexity; complexity != nil {
+			_ = d.Set("password_min_length", complexity.MinLength)
+			_ = d.Set("password_min_lowercase", complexity.MinLowerCase)
+			_ = d.Set("password_min_uppercase", complexity.MinUpperCase)
+			_ = d.Set("password_min_number", complexity.MinNumber)
+			_ = d.Set("password_min_symbol", complexity.MinSymbol)
+			_ = d.Set("password_exclude_username", complexity.ExcludeUsername)
+			_ = d.Set("password_exclude_first_name", contains(complexity.ExcludeAttributes, "firstName"))
+			_ = d.Set("password_exclude_last_name", contains(complexity.ExcludeAttributes, "lastName"))
+			dictionaryLookup := complexity.Dictionary != nil && complexity.Dictionary.Common != nil &&
+				complexity.Dictionary.Common.Exclude != nil && *complexity.Dictionary.Common.Exclude
+			_ = d.Set("password_dictionary_lookup", dictionaryLookup)
+		}
+		if lockout := settings.Password.Lockout; lockout != nil {
+			_ = d.Set("password_max_lockout_attempts", lockout.MaxAttempts)
+			_ = d.Set("password_auto_unlock_minutes", lockout.AutoUnlockMinutes)
+			_ = d.Set("password_show_lockout_failures", lockout.ShowLockoutFailures)
+			err := d.Set("password_lockout_notification_channels", convertStringSetToInterface(lockout.UserLockoutNotificationChannels))
+			if err != nil {
+				return fmt.Errorf("failed to set notification channels: %v", err)
+			}
+		}
+	}
+	setPasswordPolicyRecoverySettings(d, settings.Recovery)
+	if settings.Delegation != nil && settings.Delegation.Options != nil {
+		_ = d.Set("skip_unlock", settings.Delegation.Options.SkipUnlock)
+	}
+	return nil
+}
+
+// buildPasswordPolicyRecoverySettings builds the self-service recovery settings of the password policy, which are shared
+// with okta_account_recovery.
+func buildPasswordPolicyRecoverySettings(d *schema.ResourceData) *sdk.PasswordPolicyRecoverySettings {
+	return &sdk.PasswordPolicyRecoverySettings{
+		Factors: &sdk.PasswordPolicyRecoveryFactors{
+			OktaCall: &okta.PasswordPolicyRecoveryFactorSettings{
+				Status: d.Get("call_recovery").(string),
+			},
+			OktaSms: &okta.PasswordPolicyRecoveryFactorSettings{
+				Status: d.Get("sms_recovery").(string),
+			},
+			OktaEmail: &sdk.PasswordPolicyRecoveryEmail{
+				Properties: &sdk.PasswordPolicyRecoveryEmailProperties{
+					RecoveryToken: &sdk.PasswordPolicyRecoveryEmailRecoveryToken{
+						TokenLifetimeMinutes: int64(d.Get("recovery_email_token").(int)),
 					},
-					Status: d.Get("email_recovery").(string),
 				},
-				RecoveryQuestion: &sdk.PasswordPolicyRecoveryQuestion{
-					Properties: &sdk.PasswordPolicyRecoveryQuestionProperties{
-						Complexity: &sdk.PasswordPolicyRecoveryQuestionComplexity{
-							MinLength: int64(d.Get("question_min_length").(int)),
-						},
+				Status: d.Get("email_recovery").(string),
+			},
+			RecoveryQuestion: &sdk.PasswordPolicyRecoveryQuestion{
+				Properties: &sdk.PasswordPolicyRecoveryQuestionProperties{
+					Complexity: &sdk.PasswordPolicyRecoveryQuestionComplexity{
+						MinLength: int64(d.Get("question_min_length").(int)),
 					},
-					Status: d.Get("question_recovery").(string),
 				},
-			},
-		},
-		Delegation: &okta.PasswordPolicyDelegationSettings{
-			Options: &okta.PasswordPolicyDelegationSettingsOptions{
-				SkipUnlock: boolPtr(d.Get("skip_unlock").(bool)),
+				Status: d.Get("question_recovery").(string),
 			},
 		},
 	}
-	return template
+}
+
+// setPasswordPolicyRecoverySettings sets the self-service recovery settings of the password policy to the state.
+func setPasswordPolicyRecoverySettings(d *schema.ResourceData, recovery *sdk.PasswordPolicyRecoverySettings) {
+	if recovery == nil || recovery.Factors == nil {
+		return
+	}
+	factors := recovery.Factors
+	if factors.RecoveryQuestion != nil {
+		_ = d.Set("question_recovery", factors.RecoveryQuestion.Status)
+		if factors.RecoveryQuestion.Properties != nil && factors.RecoveryQuestion.Properties.Complexity != nil {
+			_ = d.Set("question_min_length", factors.RecoveryQuestion.Properties.Complexity.MinLength)
+		}
+	}
+	if factors.OktaEmail != nil {
+		_ = d.Set("email_recovery", factors.OktaEmail.Status)
+		if factors.OktaEmail.Properties != nil && factors.OktaEmail.Properties.RecoveryToken != nil {
+			_ = d.Set("recovery_email_token", factors.OktaEmail.Properties.RecoveryToken.TokenLifetimeMinutes)
+		}
+	}
+	if factors.OktaSms != nil {
+		_ = d.Set("sms_recovery", factors.OktaSms.Status)
+	}
+	if factors.OktaCall != nil {
+		_ = d.Set("call_recovery", factors.OktaCall.Status)
+	}
 }
 
 func getExcludedAttrs(excludeFirstName, excludeLastName bool) []string {
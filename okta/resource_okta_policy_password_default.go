@@ -133,7 +133,10 @@ func resourcePolicyPasswordDefault() *schema.Resource {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Description: "Notification channels to use to notify a user when their account has been locked.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: stringInSlice([]string{"EMAIL"}),
+				},
 			},
 			"question_min_length": {
 				Type:        schema.TypeInt,
@@ -210,40 +213,10 @@ func resourcePolicyPasswordDefaultRead(ctx context.Context, d *schema.ResourceDa
 	if policy == nil {
 		return nil
 	}
-	err = d.Set("password_lockout_notification_channels", convertStringSetToInterface(policy.Settings.Password.Lockout.UserLockoutNotificationChannels))
+	syncDefaultPolicy(d, policy)
+	err = setPasswordPolicySettings(d, policy.Settings)
 	if err != nil {
-		return diag.Errorf("error setting notification channels for resource %s: %v", d.Id(), err)
-	}
-	if policy.Settings.Password.Complexity.Dictionary != nil && policy.Settings.Password.Complexity.Dictionary.Common != nil {
-		_ = d.Set("password_dictionary_lookup", policy.Settings.Password.Complexity.Dictionary.Common.Exclude)
-	}
-	_ = d.Set("password_min_length", policy.Settings.Password.Complexity.MinLength)
-	_ = d.Set("password_min_lowercase", policy.Settings.Password.Complexity.MinLowerCase)
-	_ = d.Set("password_min_uppercase", policy.Settings.Password.Complexity.MinUpperCase)
-	_ = d.Set("password_min_number", policy.Settings.Password.Complexity.MinNumber)
-	_ = d.Set("password_min_symbol", policy.Settings.Password.Complexity.MinSymbol)
-	_ = d.Set("password_exclude_username", policy.Settings.Password.Complexity.ExcludeUsername)
-	_ = d.Set("password_max_age_days", policy.Settings.Password.Age.MaxAgeDays)
-	_ = d.Set("password_expire_warn_days", policy.Settings.Password.Age.ExpireWarnDays)
-	_ = d.Set("password_min_age_minutes", policy.Settings.Password.Age.MinAgeMinutes)
-	_ = d.Set("password_history_count", policy.Settings.Password.Age.HistoryCount)
-	_ = d.Set("password_max_lockout_attempts", policy.Settings.Password.Lockout.MaxAttempts)
-	_ = d.Set("password_auto_unlock_minutes", policy.Settings.Password.Lockout.AutoUnlockMinutes)
-	_ = d.Set("password_show_lockout_failures", policy.Settings.Password.Lockout.ShowLockoutFailures)
-	_ = d.Set("question_min_length", policy.Settings.Recovery.Factors.RecoveryQuestion.Properties.Complexity.MinLength)
-	_ = d.Set("recovery_email_token", policy.Settings.Recovery.Factors.OktaEmail.Properties.RecoveryToken.TokenLifetimeMinutes)
-	_ = d.Set("sms_recovery", policy.Settings.Recovery.Factors.OktaSms.Status)
-	_ = d.Set("email_recovery", policy.Settings.Recovery.Factors.OktaEmail.Status)
-	_ = d.Set("question_recovery", policy.Settings.Recovery.Factors.RecoveryQuestion.Status)
-	_ = d.Set("call_recovery", policy.Settings.Recovery.Factors.OktaCall.Status)
-	_ = d.Set("skip_unlock", policy.Settings.Delegation.Options.SkipUnlock)
-	for _, v := range policy.Settings.Password.Complexity.ExcludeAttributes {
-		switch v {
-		case "firstName":
-			_ = d.Set("password_exclude_first_name", true)
-		case "lastName":
-			_ = d.Set("password_exclude_last_name", true)
-		}
+		return diag.Errorf("failed to set default password policy settings: %v", err)
 	}
 	return nil
 }
@@ -259,7 +232,6 @@ func buildDefaultPasswordPolicy(d *schema.ResourceData) sdk.Policy {
 	policy.Name = d.Get("name").(string)
 	policy.Status = d.Get("status").(string)
 	policy.Description = d.Get("description").(string)
-	policy.Priority = int64(d.Get("priority").(int))
 	policy.Conditions = &okta.PolicyRuleConditions{
 		AuthProvider: &okta.PasswordPolicyAuthenticationProviderCondition{
 			Provider: d.Get("default_auth_provider").(string),
@@ -270,68 +242,6 @@ func buildDefaultPasswordPolicy(d *schema.ResourceData) sdk.Policy {
 			},
 		},
 	}
-	// Okta defaults
-	// we add the defaults here & not in the schema map to avoid defaults appearing in the terraform plan diff
-	policy.Settings = &sdk.PolicySettings{
-		Password: &sdk.PasswordPolicyPasswordSettings{
-			Age: &sdk.PasswordPolicyPasswordSettingsAge{
-				ExpireWarnDays: int64(d.Get("password_expire_warn_days").(int)),
-				HistoryCount:   int64(d.Get("password_history_count").(int)),
-				MaxAgeDays:     int64(d.Get("password_max_age_days").(int)),
-				MinAgeMinutes:  int64(d.Get("password_min_age_minutes").(int)),
-			},
-			Complexity: &sdk.PasswordPolicyPasswordSettingsComplexity{
-				Dictionary: &okta.PasswordDictionary{
-					Common: &okta.PasswordDictionaryCommon{
-						Exclude: boolPtr(d.Get("password_dictionary_lookup").(bool)),
-					},
-				},
-				ExcludeAttributes: getExcludedAttrs(d.Get("password_exclude_first_name").(bool), d.Get("password_exclude_last_name").(bool)),
-				ExcludeUsername:   boolPtr(d.Get("password_exclude_username").(bool)),
-				MinLength:         int64(d.Get("password_min_length").(int)),
-				MinLowerCase:      int64(d.Get("password_min_lowercase").(int)),
-				MinNumber:         int64(d.Get("password_min_number").(int)),
-				MinSymbol:         int64(d.Get("password_min_symbol").(int)),
-				MinUpperCase:      int64(d.Get("password_min_uppercase").(int)),
-			},
-			Lockout: &sdk.PasswordPolicyPasswordSettingsLockout{
-				AutoUnlockMinutes:               int64(d.Get("password_auto_unlock_minutes").(int)),
-				MaxAttempts:                     int64(d.Get("password_max_lockout_attempts").(int)),
-				ShowLockoutFailures:             boolPtr(d.Get("password_show_lockout_failures").(bool)),
-				UserLockoutNotificationChannels: convertInterfaceToStringSet(d.Get("password_lockout_notification_channels")),
-			},
-		},
-		Recovery: &sdk.PasswordPolicyRecoverySettings{
-			Factors: &sdk.PasswordPolicyRecoveryFactors{
-				OktaCall: &okta.PasswordPolicyRecoveryFactorSettings{
-					Status: d.Get("call_recovery").(string),
-				},
-				OktaSms: &okta.PasswordPolicyRecoveryFactorSettings{
-					Status: d.Get("sms_recovery").(string),
-				},
-				OktaEmail: &sdk.PasswordPolicyRecoveryEmail{
-					Properties: &sdk.PasswordPolicyRecoveryEmailProperties{
-						RecoveryToken: &sdk.PasswordPolicyRecoveryEmailRecoveryToken{
-							TokenLifetimeMinutes: int64(d.Get("recovery_email_token").(int)),
-						},
-					},
-					Status: d.Get("email_recovery").(string),
-				},
-				RecoveryQuestion: &sdk.PasswordPolicyRecoveryQuestion{
-					Properties: &sdk.PasswordPolicyRecoveryQuestionProperties{
-						Complexity: &sdk.PasswordPolicyRecoveryQuestionComplexity{
-							MinLength: int64(d.Get("question_min_length").(int)),
-						},
-					},
-					Status: d.Get("question_recovery").(string),
-				},
-			},
-		},
-		Delegation: &okta.PasswordPolicyDelegationSettings{
-			Options: &okta.PasswordPolicyDelegationSettingsOptions{
-				SkipUnlock: boolPtr(d.Get("skip_unlock").(bool)),
-			},
-		},
-	}
+	policy.Settings = buildPasswordPolicySettings(d)
 	return policy
 }
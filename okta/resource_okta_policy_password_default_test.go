@@ -25,6 +25,7 @@ func TestAccOktaDefaultPasswordPolicy(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					ensurePolicyExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttrSet(resourceName, "priority"),
 					resource.TestCheckResourceAttr(resourceName, "sms_recovery", statusActive),
 				),
 			},
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
 	"github.com/okta/terraform-provider-okta/sdk"
@@ -71,6 +72,7 @@ func TestAccOktaPolicyPassword_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "password_exclude_username", "false"),
 					resource.TestCheckResourceAttr(resourceName, "password_exclude_first_name", "true"),
 					resource.TestCheckResourceAttr(resourceName, "password_exclude_last_name", "true"),
+					resource.TestCheckResourceAttr(resourceName, "password_dictionary_lookup", "true"),
 					resource.TestCheckResourceAttr(resourceName, "password_max_age_days", "60"),
 					resource.TestCheckResourceAttr(resourceName, "password_expire_warn_days", "15"),
 					resource.TestCheckResourceAttr(resourceName, "password_min_age_minutes", "60"),
@@ -89,6 +91,52 @@ func TestAccOktaPolicyPassword_crud(t *testing.T) {
 	})
 }
 
+func TestSetPasswordPolicySettings(t *testing.T) {
+	d := resourcePolicyPassword().TestResourceData()
+	_ = d.Set("password_exclude_first_name", true)
+	_ = d.Set("password_exclude_last_name", true)
+	_ = d.Set("password_dictionary_lookup", true)
+	_ = d.Set("password_lockout_notification_channels", []interface{}{"EMAIL"})
+	settings := &sdk.PolicySettings{
+		Password: &sdk.PasswordPolicyPasswordSettings{
+			Complexity: &sdk.PasswordPolicyPasswordSettingsComplexity{
+				ExcludeAttributes: []string{"lastName"},
+				MinLength:         12,
+			},
+			Lockout: &sdk.PasswordPolicyPasswordSettingsLockout{
+				MaxAttempts: 5,
+			},
+		},
+		Recovery: &sdk.PasswordPolicyRecoverySettings{
+			Factors: &sdk.PasswordPolicyRecoveryFactors{
+				OktaEmail: &sdk.PasswordPolicyRecoveryEmail{Status: statusActive},
+			},
+		},
+	}
+	err := setPasswordPolicySettings(d, settings)
+	if err != nil {
+		t.Fatalf("failed to set password policy settings: %v", err)
+	}
+	if d.Get("password_exclude_first_name").(bool) {
+		t.Error("'password_exclude_first_name' should be false when 'firstName' is not excluded")
+	}
+	if !d.Get("password_exclude_last_name").(bool) {
+		t.Error("'password_exclude_last_name' should be true when 'lastName' is excluded")
+	}
+	if d.Get("password_dictionary_lookup").(bool) {
+		t.Error("'password_dictionary_lookup' should be false when the dictionary is omitted")
+	}
+	if n := d.Get("password_lockout_notification_channels").(*schema.Set).Len(); n != 0 {
+		t.Errorf("expected no notification channels, got %d", n)
+	}
+	if d.Get("password_min_length").(int) != 12 || d.Get("password_max_lockout_attempts").(int) != 5 {
+		t.Error("password complexity and lockout settings were not set")
+	}
+	if d.Get("email_recovery").(string) != statusActive {
+		t.Error("email recovery status was not set")
+	}
+}
+
 func ensurePolicyExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		missingErr := fmt.Errorf("resource not found: %s", name)
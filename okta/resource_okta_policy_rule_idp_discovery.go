@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -39,9 +40,16 @@ func resourcePolicyRuleIdpDiscovery() *schema.Resource {
 				Description: "Applications to exclude in discovery rule",
 			},
 			"platform_include": {
-				Type:     schema.TypeSet,
-				Elem:     platformIncludeResource,
-				Optional: true,
+				Type:        schema.TypeSet,
+				Elem:        platformIncludeResource,
+				Optional:    true,
+				Description: "Platforms to include in discovery rule",
+			},
+			"platform_exclude": {
+				Type:        schema.TypeSet,
+				Elem:        platformIncludeResource,
+				Optional:    true,
+				Description: "Platforms to exclude in discovery rule",
 			},
 			"user_identifier_type": {
 				Type:             schema.TypeString,
@@ -93,16 +101,29 @@ func resourcePolicyRuleIdpDiscoveryRead(ctx context.Context, d *schema.ResourceD
 	_ = d.Set("name", rule.Name)
 	_ = d.Set("status", rule.Status)
 	_ = d.Set("priority", rule.Priority)
-	_ = d.Set("user_identifier_attribute", rule.Conditions.UserIdentifier.Attribute)
-	_ = d.Set("user_identifier_type", rule.Conditions.UserIdentifier.Type)
-	_ = d.Set("network_connection", rule.Conditions.Network.Connection)
+	conditions := rule.Conditions
+	if conditions == nil {
+		conditions = &sdk.IdpDiscoveryRuleConditions{}
+	}
+	network := conditions.Network
+	if network == nil {
+		network = &sdk.IdpDiscoveryRuleNetwork{}
+	}
+	userIdentifier := conditions.UserIdentifier
+	if userIdentifier == nil {
+		userIdentifier = &sdk.IdpDiscoveryRuleUserIdentifier{}
+	}
+	_ = d.Set("user_identifier_attribute", userIdentifier.Attribute)
+	_ = d.Set("user_identifier_type", userIdentifier.Type)
+	_ = d.Set("network_connection", network.Connection)
 	err = setNonPrimitives(d, map[string]interface{}{
-		"network_includes":         convertStringArrToInterface(rule.Conditions.Network.Include),
-		"network_excludes":         convertStringArrToInterface(rule.Conditions.Network.Exclude),
-		"platform_include":         flattenPlatformInclude(rule.Conditions.Platform),
-		"user_identifier_patterns": flattenUserIDPatterns(rule.Conditions.UserIdentifier.Patterns),
-		"app_include":              flattenAppInclude(rule.Conditions.App),
-		"app_exclude":              flattenAppExclude(rule.Conditions.App),
+		"network_includes":         convertStringArrToInterface(network.Include),
+		"network_excludes":         convertStringArrToInterface(network.Exclude),
+		"platform_include":         flattenPlatformInclude(conditions.Platform),
+		"platform_exclude":         flattenPlatformExclude(conditions.Platform),
+		"user_identifier_patterns": flattenUserIDPatterns(userIdentifier.Patterns),
+		"app_include":              flattenAppInclude(conditions.App),
+		"app_exclude":              flattenAppExclude(conditions.App),
 	})
 	if err != nil {
 		return diag.Errorf("failed to set IDP discovery policy rule properties: %v", err)
@@ -175,7 +196,7 @@ func buildIdpDiscoveryRule(d *schema.ResourceData) *sdk.IdpDiscoveryRule {
 				Include: convertInterfaceToStringArr(d.Get("network_includes")),
 				Exclude: convertInterfaceToStringArr(d.Get("network_excludes")),
 			},
-			Platform:       buildPlatformInclude(d),
+			Platform:       buildIdpDiscoveryRulePlatform(d),
 			UserIdentifier: buildIdentifier(d),
 		},
 		Type:   sdk.IdpDiscoveryType,
@@ -245,12 +266,34 @@ var (
 )
 
 func buildPlatformInclude(d *schema.ResourceData) *sdk.IdpDiscoveryRulePlatform {
-	var includeList []*sdk.IdpDiscoveryRulePlatformInclude
-	if v, ok := d.GetOk("platform_include"); ok {
+	include := buildPlatformConditions(d, "platform_include")
+	if include == nil {
+		return nil
+	}
+	return &sdk.IdpDiscoveryRulePlatform{
+		Include: include,
+	}
+}
+
+func buildIdpDiscoveryRulePlatform(d *schema.ResourceData) *sdk.IdpDiscoveryRulePlatform {
+	include := buildPlatformConditions(d, "platform_include")
+	exclude := buildPlatformConditions(d, "platform_exclude")
+	if include == nil && exclude == nil {
+		return nil
+	}
+	return &sdk.IdpDiscoveryRulePlatform{
+		Include: include,
+		Exclude: exclude,
+	}
+}
+
+func buildPlatformConditions(d *schema.ResourceData, key string) []*sdk.IdpDiscoveryRulePlatformInclude {
+	var platforms []*sdk.IdpDiscoveryRulePlatformInclude
+	if v, ok := d.GetOk(key); ok {
 		valueList := v.(*schema.Set).List()
 		for _, item := range valueList {
 			if value, ok := item.(map[string]interface{}); ok {
-				includeList = append(includeList, &sdk.IdpDiscoveryRulePlatformInclude{
+				platforms = append(platforms, &sdk.IdpDiscoveryRulePlatformInclude{
 					Os: &sdk.IdpDiscoveryRulePlatformOS{
 						Expression: getMapString(value, "os_expression"),
 						Type:       getMapString(value, "os_type"),
@@ -259,11 +302,8 @@ func buildPlatformInclude(d *schema.ResourceData) *sdk.IdpDiscoveryRulePlatform
 				})
 			}
 		}
-		return &sdk.IdpDiscoveryRulePlatform{
-			Include: includeList,
-		}
 	}
-	return nil
+	return platforms
 }
 
 func buildAppConditions(d *schema.ResourceData) *sdk.IdpDiscoveryRuleApp {
@@ -339,15 +379,31 @@ func flattenUserIDPatterns(patterns []*sdk.IdpDiscoveryRulePattern) *schema.Set
 }
 
 func flattenPlatformInclude(platform *sdk.IdpDiscoveryRulePlatform) *schema.Set {
+	if platform != nil {
+		return flattenPlatformConditions(platform.Include)
+	}
+	return flattenPlatformConditions(nil)
+}
+
+func flattenPlatformExclude(platform *sdk.IdpDiscoveryRulePlatform) *schema.Set {
+	if platform != nil {
+		return flattenPlatformConditions(platform.Exclude)
+	}
+	return flattenPlatformConditions(nil)
+}
+
+func flattenPlatformConditions(platforms []*sdk.IdpDiscoveryRulePlatformInclude) *schema.Set {
 	var flattened []interface{}
-	if platform != nil && platform.Include != nil {
-		for _, v := range platform.Include {
-			flattened = append(flattened, map[string]interface{}{
-				"os_expression": v.Os.Expression,
-				"os_type":       v.Os.Type,
-				"type":          v.Type,
-			})
+	for _, v := range platforms {
+		os := v.Os
+		if os == nil {
+			os = &sdk.IdpDiscoveryRulePlatformOS{}
 		}
+		flattened = append(flattened, map[string]interface{}{
+			"os_expression": os.Expression,
+			"os_type":       os.Type,
+			"type":          v.Type,
+		})
 	}
 	return schema.NewSet(schema.HashResource(platformIncludeResource), flattened)
 }
@@ -379,8 +435,12 @@ func flattenAppObj(appObj []*sdk.IdpDiscoveryRuleAppObj) *schema.Set {
 }
 
 var (
-	errFDiscoveryRuleIdPAppConditionID   = "either 'name' or 'id' should be provided in the '%s' block"
-	errFDiscoveryRuleIdPAppConditionName = "'name' is required if the type is 'APP_TYPE' in the '%s' block"
+	errFDiscoveryRuleIdPAppConditionID       = "either 'name' or 'id' should be provided in the '%s' block"
+	errFDiscoveryRuleIdPAppConditionName     = "'name' is required if the type is 'APP_TYPE' in the '%s' block"
+	errFDiscoveryRuleIdPPlatformOSExpression = "'os_expression' can only be set if the 'os_type' is 'OTHER' in the '%s' block"
+	errDiscoveryRuleIdPPatternsWithoutType   = "'user_identifier_type' is required to match the 'user_identifier_patterns'"
+	errDiscoveryRuleIdPMultipleExpressions   = "only a single 'user_identifier_patterns' block can be set if the 'match_type' is 'EXPRESSION'"
+	errDiscoveryRuleIdPAttributeMissing      = "'user_identifier_attribute' is required if the 'user_identifier_type' is 'ATTRIBUTE'"
 )
 
 func validatePolicyRuleIdpDiscovery(d *schema.ResourceData) error {
@@ -402,5 +462,31 @@ func validatePolicyRuleIdpDiscovery(d *schema.ResourceData) error {
 			}
 		}
 	}
+	for _, platformCondition := range []string{"platform_include", "platform_exclude"} {
+		v, ok := d.GetOk(platformCondition)
+		if !ok {
+			continue
+		}
+		for _, item := range v.(*schema.Set).List() {
+			if value, ok := item.(map[string]interface{}); ok {
+				if getMapString(value, "os_expression") != "" && getMapString(value, "os_type") != "OTHER" {
+					return fmt.Errorf(errFDiscoveryRuleIdPPlatformOSExpression, platformCondition)
+				}
+			}
+		}
+	}
+	patterns := buildUserIDPatterns(d)
+	uidType := d.Get("user_identifier_type").(string)
+	if len(patterns) > 0 && uidType == "" {
+		return errors.New(errDiscoveryRuleIdPPatternsWithoutType)
+	}
+	if uidType == "ATTRIBUTE" && d.Get("user_identifier_attribute").(string) == "" {
+		return errors.New(errDiscoveryRuleIdPAttributeMissing)
+	}
+	for _, pattern := range patterns {
+		if pattern.MatchType == "EXPRESSION" && len(patterns) > 1 {
+			return errors.New(errDiscoveryRuleIdPMultipleExpressions)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,136 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourcePolicyRuleIdpDiscoveryDefault() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyRuleIdpDiscoveryDefaultUpdate,
+		ReadContext:   resourcePolicyRuleIdpDiscoveryDefaultRead,
+		UpdateContext: resourcePolicyRuleIdpDiscoveryDefaultUpdate,
+		DeleteContext: resourcePolicyRuleIdpDiscoveryDefaultDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				rule, err := findDefaultIdpDiscoveryRule(ctx, m, d.Id())
+				if err != nil {
+					return nil, err
+				}
+				_ = d.Set("policyid", d.Id())
+				d.SetId(rule.ID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"policyid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the IdP discovery policy",
+			},
+			"idp_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the identity provider, which the users are routed to, when no other rule matches",
+			},
+			"idp_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "OKTA",
+				Description: "Type of the identity provider, e.g. OKTA, SAML2, FACEBOOK, GOOGLE, LINKEDIN, MICROSOFT or OIDC",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the default rule",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Priority of the default rule, it's always the last one",
+			},
+			"status": buildComputedStatusSchema("Status of the default rule"),
+		},
+	}
+}
+
+func resourcePolicyRuleIdpDiscoveryDefaultRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	rule, resp, err := getSupplementFromMetadata(m).GetIdpDiscoveryRule(ctx, d.Get("policyid").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get default IdP discovery policy rule: %v", err)
+	}
+	if rule == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", rule.Name)
+	_ = d.Set("status", rule.Status)
+	_ = d.Set("priority", rule.Priority)
+	if rule.Actions != nil && rule.Actions.IDP != nil && len(rule.Actions.IDP.Providers) > 0 {
+		_ = d.Set("idp_id", rule.Actions.IDP.Providers[0].ID)
+		_ = d.Set("idp_type", rule.Actions.IDP.Providers[0].Type)
+	}
+	return nil
+}
+
+// resourcePolicyRuleIdpDiscoveryDefaultUpdate changes the target identity provider of the default rule, the rule
+// itself can't be created, and its conditions can't be changed.
+func resourcePolicyRuleIdpDiscoveryDefaultUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	policyID := d.Get("policyid").(string)
+	client := getSupplementFromMetadata(m)
+	var rule *sdk.IdpDiscoveryRule
+	if d.Id() == "" {
+		var err error
+		rule, err = findDefaultIdpDiscoveryRule(ctx, m, policyID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(rule.ID)
+	} else {
+		var err error
+		rule, _, err = client.GetIdpDiscoveryRule(ctx, policyID, d.Id())
+		if err != nil {
+			return diag.Errorf("failed to get default IdP discovery policy rule: %v", err)
+		}
+	}
+	rule.Actions = &sdk.IdpDiscoveryRuleActions{
+		IDP: &sdk.IdpDiscoveryRuleIdp{
+			Providers: []*sdk.IdpDiscoveryRuleProvider{
+				{
+					Type: d.Get("idp_type").(string),
+					ID:   d.Get("idp_id").(string),
+				},
+			},
+		},
+	}
+	_, _, err := client.UpdateIdpDiscoveryRule(ctx, policyID, d.Id(), *rule, nil)
+	if err != nil {
+		return diag.Errorf("failed to update default IdP discovery policy rule: %v", err)
+	}
+	return resourcePolicyRuleIdpDiscoveryDefaultRead(ctx, d, m)
+}
+
+// Default rule can not be removed
+func resourcePolicyRuleIdpDiscoveryDefaultDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return nil
+}
+
+// findDefaultIdpDiscoveryRule returns the system rule of the IdP discovery policy, which routes the users, who don't
+// match any other rule.
+func findDefaultIdpDiscoveryRule(ctx context.Context, m interface{}, policyID string) (*sdk.IdpDiscoveryRule, error) {
+	rules, _, err := getSupplementFromMetadata(m).ListIdpDiscoveryRules(ctx, policyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IdP discovery policy rules: %v", err)
+	}
+	for _, rule := range rules {
+		if rule.System {
+			return rule, nil
+		}
+	}
+	return nil, fmt.Errorf("default rule of the IdP discovery policy '%s' was not found", policyID)
+}
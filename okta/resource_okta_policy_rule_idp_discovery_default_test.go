@@ -0,0 +1,55 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaPolicyRuleIdpDiscoveryDefault(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(idpDiscoveryRuleDefault)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	oktaConfig := mgr.GetFixtures("basic_okta.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", idpDiscoveryRuleDefault)
+	var ruleID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					saveResourceID(resourceName, &ruleID),
+					resource.TestCheckResourceAttr(resourceName, "idp_type", "SAML2"),
+					resource.TestCheckResourceAttrPair(resourceName, "idp_id", fmt.Sprintf("%s.test", idpSaml), "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+				),
+			},
+			{
+				Config: oktaConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &ruleID),
+					resource.TestCheckResourceAttr(resourceName, "idp_type", "OKTA"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return rs.Primary.Attributes["policyid"], nil
+				},
+			},
+		},
+	})
+}
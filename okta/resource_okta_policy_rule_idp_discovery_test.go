@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/terraform-provider-okta/sdk"
 )
 
@@ -78,8 +79,81 @@ func TestAccOktaPolicyRuleIdpDiscovery_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "app_exclude.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "idp_type", "OKTA"),
 					resource.TestCheckResourceAttr(resourceName, "platform_include.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "platform_exclude.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_identifier_type", "IDENTIFIER"),
+					resource.TestCheckResourceAttr(resourceName, "user_identifier_patterns.#", "1"),
 				),
 			},
 		},
 	})
 }
+
+func TestValidatePolicyRuleIdpDiscovery(t *testing.T) {
+	s := resourcePolicyRuleIdpDiscovery().Schema
+	tests := []struct {
+		name string
+		raw  map[string]interface{}
+		err  string
+	}{
+		{
+			name: "valid",
+			raw: map[string]interface{}{
+				"user_identifier_type": "IDENTIFIER",
+				"user_identifier_patterns": []interface{}{
+					map[string]interface{}{"match_type": "SUFFIX", "value": "example.com"},
+					map[string]interface{}{"match_type": "EQUALS", "value": "john@example.org"},
+				},
+				"platform_exclude": []interface{}{
+					map[string]interface{}{"type": "DESKTOP", "os_type": "OTHER", "os_expression": "device.os == 'Linux'"},
+				},
+			},
+		},
+		{
+			name: "multiple expressions",
+			raw: map[string]interface{}{
+				"user_identifier_type": "IDENTIFIER",
+				"user_identifier_patterns": []interface{}{
+					map[string]interface{}{"match_type": "EXPRESSION", "value": "String.stringContains(user.login, 'a')"},
+					map[string]interface{}{"match_type": "SUFFIX", "value": "example.com"},
+				},
+			},
+			err: errDiscoveryRuleIdPMultipleExpressions,
+		},
+		{
+			name: "patterns without type",
+			raw: map[string]interface{}{
+				"user_identifier_patterns": []interface{}{
+					map[string]interface{}{"match_type": "SUFFIX", "value": "example.com"},
+				},
+			},
+			err: errDiscoveryRuleIdPPatternsWithoutType,
+		},
+		{
+			name: "attribute without name",
+			raw:  map[string]interface{}{"user_identifier_type": "ATTRIBUTE"},
+			err:  errDiscoveryRuleIdPAttributeMissing,
+		},
+		{
+			name: "os expression without other os type",
+			raw: map[string]interface{}{
+				"platform_include": []interface{}{
+					map[string]interface{}{"type": "MOBILE", "os_type": "IOS", "os_expression": "device.os == 'iOS'"},
+				},
+			},
+			err: fmt.Sprintf(errFDiscoveryRuleIdPPlatformOSExpression, "platform_include"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.raw["policyid"] = "00p1"
+			tt.raw["name"] = "test"
+			err := validatePolicyRuleIdpDiscovery(schema.TestResourceDataRaw(t, s, tt.raw))
+			if tt.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.err != "" && (err == nil || err.Error() != tt.err) {
+				t.Fatalf("expected error %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
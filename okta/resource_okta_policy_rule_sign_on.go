@@ -73,6 +73,18 @@ func resourcePolicySignonRule() *schema.Resource {
 				Description: "Whether session cookies will last across browser sessions. Okta Administrators can never have persistent session cookies.",
 				Default:     false,
 			},
+			"risk_level": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice([]string{"ANY", "LOW", "MEDIUM", "HIGH"}),
+				Description:      "Risk level of the sign-on attempt: ANY, LOW, MEDIUM or HIGH.",
+			},
+			"behaviors": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the behavior detection rules, which match the sign-on attempt.",
+			},
 		}),
 	}
 }
@@ -108,6 +120,21 @@ func resourcePolicySignOnRuleRead(ctx context.Context, d *schema.ResourceData, m
 	if rule.Actions.Signon.FactorPromptMode != "" {
 		_ = d.Set("mfa_prompt", rule.Actions.Signon.FactorPromptMode)
 	}
+	if rule.Conditions.RiskScore != nil {
+		_ = d.Set("risk_level", rule.Conditions.RiskScore.Level)
+	} else {
+		_ = d.Set("risk_level", "")
+	}
+	var behaviors []string
+	if rule.Conditions.Risk != nil {
+		behaviors = rule.Conditions.Risk.Behaviors
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"behaviors": convertStringSetToInterface(behaviors),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set sign-on policy rule behaviors: %v", err)
+	}
 	err = syncRuleFromUpstream(d, rule)
 	if err != nil {
 		return diag.Errorf("failed to sync sign-on policy rule: %v", err)
@@ -147,6 +174,16 @@ func buildSignOnPolicyRule(d *schema.ResourceData) sdk.PolicyRule {
 		},
 		People: getUsers(d),
 	}
+	if behaviors := convertInterfaceToStringSetNullable(d.Get("behaviors")); len(behaviors) > 0 {
+		template.Conditions.Risk = &okta.RiskPolicyRuleCondition{
+			Behaviors: behaviors,
+		}
+	}
+	if riskLevel, ok := d.GetOk("risk_level"); ok {
+		template.Conditions.RiskScore = &okta.RiskScorePolicyRuleCondition{
+			Level: riskLevel.(string),
+		}
+	}
 	template.Actions = sdk.PolicyRuleActions{
 		OktaSignOnPolicyRuleActions: &okta.OktaSignOnPolicyRuleActions{
 			Signon: &okta.OktaSignOnPolicyRuleSignonActions{
@@ -36,7 +36,9 @@ func TestAccOktaPolicyRuleSignon_crud(t *testing.T) {
 	config := mgr.GetFixtures("basic.tf", ri, t)
 	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
 	excludedNetwork := mgr.GetFixtures("excluded_network.tf", ri, t)
+	renamed := mgr.GetFixtures("renamed.tf", ri, t)
 	resourceName := fmt.Sprintf("%s.test", policyRuleSignOn)
+	var ruleID string
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -72,6 +74,39 @@ func TestAccOktaPolicyRuleSignon_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
 					resource.TestCheckResourceAttr(resourceName, "access", "DENY"),
 					resource.TestCheckResourceAttr(resourceName, "network_connection", "ZONE"),
+					saveResourceID(resourceName, &ruleID),
+				),
+			},
+			{
+				Config: renamed,
+				Check: resource.ComposeTestCheckFunc(
+					ensureRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)+"_renamed"),
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &ruleID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOktaPolicyRuleSignon_risk(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyRuleSignOn)
+	config := mgr.GetFixtures("risk.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyRuleSignOn)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createRuleCheckDestroy(policyRuleSignOn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "risk_level", "HIGH"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "behaviors.#", "0"),
 				),
 			},
 		},
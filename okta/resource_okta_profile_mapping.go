@@ -16,14 +16,16 @@ var mappingResource = &schema.Resource{
 			Description: "The mapping property key.",
 		},
 		"expression": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Combination or single source properties that will be mapped to the target property.",
 		},
 		"push_status": {
 			Type:             schema.TypeString,
 			Optional:         true,
 			Default:          dontPush,
 			ValidateDiagFunc: stringInSlice([]string{push, dontPush}),
+			Description:      "Whether to update the target property on user create and update (PUSH), or only on create (DONT_PUSH).",
 		},
 	},
 }
@@ -48,6 +50,7 @@ func resourceOktaProfileMapping() *schema.Resource {
 			"delete_when_absent": {
 				Type:        schema.TypeBool,
 				Optional:    true,
+				Default:     false,
 				Description: "When turned on this flag will trigger the provider to delete mapping properties that are not defined in config. By default, we do not delete missing properties.",
 			},
 			"source_type": {
@@ -112,6 +115,7 @@ func resourceProfileMappingRead(ctx context.Context, d *schema.ResourceData, m i
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("source_id", mapping.Source.ID)
 	_ = d.Set("source_type", mapping.Source.Type)
 	_ = d.Set("source_name", mapping.Source.Name)
 	_ = d.Set("target_type", mapping.Target.Type)
@@ -176,9 +180,17 @@ func mergeProperties(target, b map[string]*sdk.MappingProperty) map[string]*sdk.
 func flattenMappingProperties(src map[string]*sdk.MappingProperty) *schema.Set {
 	var arr []interface{}
 	for k, v := range src {
+		if v == nil {
+			continue
+		}
+		// the push status is omitted by the API for the mappings, which were never pushed
+		pushStatus := v.PushStatus
+		if pushStatus == "" {
+			pushStatus = dontPush
+		}
 		arr = append(arr, map[string]interface{}{
 			"id":          k,
-			"push_status": v.PushStatus,
+			"push_status": pushStatus,
 			"expression":  v.Expression,
 		})
 	}
@@ -7,8 +7,27 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
+func TestFlattenMappingProperties(t *testing.T) {
+	set := flattenMappingProperties(map[string]*sdk.MappingProperty{
+		"firstName": {Expression: "appuser.firstName", PushStatus: push},
+		"lastName":  {Expression: "appuser.lastName"},
+		"nickName":  nil,
+	})
+	if set.Len() != 2 {
+		t.Fatalf("expected 2 mappings, got %d", set.Len())
+	}
+	props := buildMappingProperties(set)
+	if props["firstName"].PushStatus != push {
+		t.Errorf("expected push status of 'firstName' to be %s, got %s", push, props["firstName"].PushStatus)
+	}
+	if props["lastName"].PushStatus != dontPush {
+		t.Errorf("expected omitted push status of 'lastName' to default to %s, got %s", dontPush, props["lastName"].PushStatus)
+	}
+}
+
 func TestAccOktaProfileMapping_crud(t *testing.T) {
 	ri := acctest.RandInt()
 	resourceName := fmt.Sprintf("%s.test", oktaProfileMapping)
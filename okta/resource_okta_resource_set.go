@@ -0,0 +1,148 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceResourceSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceResourceSetCreate,
+		ReadContext:   resourceResourceSetRead,
+		UpdateContext: resourceResourceSetUpdate,
+		DeleteContext: resourceResourceSetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name given to the Resource Set",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A description of the Resource Set",
+			},
+			"resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: stringIsURL(validURLSchemes...),
+				},
+				Description: "The endpoints that reference the resources to be included in the new Resource Set, e.g. 'https://example.okta.com/api/v1/groups/00g1234'",
+			},
+		},
+	}
+}
+
+func resourceResourceSetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	set, _, err := getSupplementFromMetadata(m).CreateResourceSet(ctx, buildResourceSet(d))
+	if err != nil {
+		return diag.Errorf("failed to create resource set: %v", err)
+	}
+	d.SetId(set.Id)
+	return resourceResourceSetRead(ctx, d, m)
+}
+
+func resourceResourceSetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	set, resp, err := getSupplementFromMetadata(m).GetResourceSet(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get resource set: %v", err)
+	}
+	if set == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("label", set.Label)
+	_ = d.Set("description", set.Description)
+	resources, err := listResourceSetResources(ctx, m, d.Id())
+	if err != nil {
+		return diag.Errorf("failed to list resources of the resource set: %v", err)
+	}
+	hrefs := make([]string, 0, len(resources))
+	for href := range resources {
+		hrefs = append(hrefs, href)
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"resources": convertStringSetToInterface(hrefs),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set resource set properties: %v", err)
+	}
+	return nil
+}
+
+func resourceResourceSetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("label", "description") {
+		_, _, err := getSupplementFromMetadata(m).UpdateResourceSet(ctx, d.Id(), buildResourceSet(d))
+		if err != nil {
+			return diag.Errorf("failed to update resource set: %v", err)
+		}
+	}
+	if d.HasChange("resources") {
+		oldResources, newResources := d.GetChange("resources")
+		oldSet := oldResources.(*schema.Set)
+		newSet := newResources.(*schema.Set)
+		add := convertInterfaceToStringSet(newSet.Difference(oldSet))
+		if len(add) > 0 {
+			_, err := getSupplementFromMetadata(m).AddResourceSetResources(ctx, d.Id(), sdk.IAMAdditions{Additions: add})
+			if err != nil {
+				return diag.Errorf("failed to add resources to the resource set: %v", err)
+			}
+		}
+		remove := convertInterfaceToStringSet(oldSet.Difference(newSet))
+		if len(remove) > 0 {
+			// resources are removed by their IDs in the resource set, which are not the same as the IDs of the objects
+			resources, err := listResourceSetResources(ctx, m, d.Id())
+			if err != nil {
+				return diag.Errorf("failed to list resources of the resource set: %v", err)
+			}
+			for _, href := range remove {
+				id, ok := resources[href]
+				if !ok {
+					continue
+				}
+				resp, err := getSupplementFromMetadata(m).DeleteResourceSetResource(ctx, d.Id(), id)
+				if err := suppressErrorOn404(resp, err); err != nil {
+					return diag.Errorf("failed to remove '%s' resource from the resource set: %v", href, err)
+				}
+			}
+		}
+	}
+	return resourceResourceSetRead(ctx, d, m)
+}
+
+func resourceResourceSetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteResourceSet(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete resource set: %v", err)
+	}
+	return nil
+}
+
+func buildResourceSet(d *schema.ResourceData) sdk.ResourceSet {
+	return sdk.ResourceSet{
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+		Resources:   convertInterfaceToStringSet(d.Get("resources")),
+	}
+}
+
+// listResourceSetResources returns the map of the resource URLs to their IDs in the resource set.
+func listResourceSetResources(ctx context.Context, m interface{}, setID string) (map[string]string, error) {
+	resources, _, err := getSupplementFromMetadata(m).ListResourceSetResources(ctx, setID)
+	if err != nil {
+		return nil, err
+	}
+	hrefs := make(map[string]string, len(resources))
+	for _, resource := range resources {
+		hrefs[resource.Href()] = resource.Id
+	}
+	return hrefs, nil
+}
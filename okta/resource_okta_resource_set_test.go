@@ -0,0 +1,57 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaResourceSet_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", resourceSet)
+	mgr := newFixtureManager(resourceSet)
+	// Replace example org url with actual url to prevent API error
+	config := strings.ReplaceAll(mgr.GetFixtures("basic.tf", ri, t), "https://your.okta.org", getOktaDomainName())
+	updatedConfig := strings.ReplaceAll(mgr.GetFixtures("basic_updated.tf", ri, t), "https://your.okta.org", getOktaDomainName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(resourceSet, doesResourceSetExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesResourceSetExist),
+					resource.TestCheckResourceAttr(resourceName, "label", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "description", "testing, testing"),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesResourceSetExist),
+					resource.TestCheckResourceAttr(resourceName, "label", fmt.Sprintf("testAccUpdated_%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "description", "testing, testing updated"),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resources.*", fmt.Sprintf("%s/api/v1/users", getOktaDomainName())),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func doesResourceSetExist(id string) (bool, error) {
+	_, resp, err := getSupplementFromMetadata(testAccProvider.Meta()).GetResourceSet(context.Background(), id)
+	return doesResourceExist(resp, err)
+}
@@ -0,0 +1,102 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceSubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSubscriptionCreate,
+		ReadContext:   resourceSubscriptionRead,
+		UpdateContext: resourceSubscriptionUpdate,
+		DeleteContext: resourceSubscriptionDelete,
+		Importer:      createNestedResourceImporter([]string{"user_id", "notification_type"}),
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the admin user",
+			},
+			"notification_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					"CONNECTOR_AGENT", "USER_LOCKED_OUT", "APP_IMPORT", "LDAP_AGENT", "AD_AGENT", "OKTA_ANNOUNCEMENT",
+					"OKTA_ISSUE", "OKTA_UPDATE", "IWA_AGENT", "USER_DEPROVISION", "REPORT_SUSPICIOUS_ACTIVITY",
+					"RATELIMIT_NOTIFICATION",
+				}),
+				Description: "Type of the notification",
+			},
+			"subscribed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the user is subscribed to the notification",
+			},
+			"channels": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Channels the notification is delivered to",
+			},
+		},
+	}
+}
+
+func resourceSubscriptionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := setSubscription(ctx, d, m); err != nil {
+		return diag.Errorf("failed to change subscription: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("user_id").(string), d.Get("notification_type").(string)))
+	return resourceSubscriptionRead(ctx, d, m)
+}
+
+func resourceSubscriptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	subscription, resp, err := getSupplementFromMetadata(m).GetUserSubscription(ctx, d.Get("user_id").(string), d.Get("notification_type").(string))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get subscription: %v", err)
+	}
+	if subscription == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("subscribed", subscription.Status == sdk.SubscriptionStatusSubscribed)
+	err = setNonPrimitives(d, map[string]interface{}{
+		"channels": convertStringSetToInterface(subscription.Channels),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set subscription properties: %v", err)
+	}
+	return nil
+}
+
+func resourceSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := setSubscription(ctx, d, m); err != nil {
+		return diag.Errorf("failed to change subscription: %v", err)
+	}
+	return resourceSubscriptionRead(ctx, d, m)
+}
+
+// Subscription can not be removed, so it's left as is on destroy.
+func resourceSubscriptionDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+func setSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	client := getSupplementFromMetadata(m)
+	userID, notificationType := d.Get("user_id").(string), d.Get("notification_type").(string)
+	var err error
+	if d.Get("subscribed").(bool) {
+		_, err = client.SubscribeUser(ctx, userID, notificationType)
+	} else {
+		_, err = client.UnsubscribeUser(ctx, userID, notificationType)
+	}
+	return err
+}
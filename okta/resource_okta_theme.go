@@ -0,0 +1,237 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// themeFiles maps the file attributes of the theme to the files of the Brands API.
+var themeFiles = map[string]string{
+	"logo":             sdk.ThemeLogo,
+	"favicon":          sdk.ThemeFavicon,
+	"background_image": sdk.ThemeBackgroundImage,
+}
+
+func resourceTheme() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceThemeCreate,
+		ReadContext:   resourceThemeRead,
+		UpdateContext: resourceThemeUpdate,
+		DeleteContext: resourceThemeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 2 {
+					return nil, errors.New("invalid resource import specifier. Use: terraform import <brand_id>/<theme_id>")
+				}
+				_ = d.Set("brand_id", parts[0])
+				_ = d.Set("theme_id", parts[1])
+				d.SetId(parts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"brand_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the brand",
+			},
+			"theme_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the theme. If not set, the theme of the brand is managed",
+			},
+			"logo": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: logoValid(),
+				Description:      "Path to the logo file, it should be a PNG, JPEG or SVG file less than 1 MB in size",
+			},
+			"logo_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of the logo",
+			},
+			"favicon": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: logoValid(),
+				Description:      "Path to the favicon file, it should be a PNG or ICO file less than 1 MB in size",
+			},
+			"favicon_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of the favicon",
+			},
+			"background_image": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: logoValid(),
+				Description:      "Path to the background image file of the sign-in page, it should be a PNG, JPEG or GIF file less than 1 MB in size",
+			},
+			"background_image_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of the background image",
+			},
+			"primary_color_hex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: stringIsHexColor,
+				Description:      "Primary color in hex format, e.g. #1662dd",
+			},
+			"primary_color_contrast_hex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: stringIsHexColor,
+				Description:      "Color of the text on the primary color, in hex format",
+			},
+			"secondary_color_hex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: stringIsHexColor,
+				Description:      "Secondary color in hex format, e.g. #ebebed",
+			},
+			"secondary_color_contrast_hex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: stringIsHexColor,
+				Description:      "Color of the text on the secondary color, in hex format",
+			},
+			"sign_in_page_touch_point_variant": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: stringInSlice([]string{"OKTA_DEFAULT", "BACKGROUND_SECONDARY_COLOR", "BACKGROUND_IMAGE"}),
+				Description:      "Variant of the sign-in page: OKTA_DEFAULT, BACKGROUND_SECONDARY_COLOR or BACKGROUND_IMAGE",
+			},
+			"end_user_dashboard_touch_point_variant": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: stringInSlice([]string{"OKTA_DEFAULT", "WHITE_LOGO_BACKGROUND", "FULL_THEME", "LOGO_ON_FULL_WHITE_BACKGROUND"}),
+				Description:      "Variant of the End-User Dashboard: OKTA_DEFAULT, WHITE_LOGO_BACKGROUND, FULL_THEME or LOGO_ON_FULL_WHITE_BACKGROUND",
+			},
+			"error_page_touch_point_variant": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: stringInSlice([]string{"OKTA_DEFAULT", "BACKGROUND_SECONDARY_COLOR", "BACKGROUND_IMAGE"}),
+				Description:      "Variant of the error page: OKTA_DEFAULT, BACKGROUND_SECONDARY_COLOR or BACKGROUND_IMAGE",
+			},
+			"email_template_touch_point_variant": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: stringInSlice([]string{"OKTA_DEFAULT", "FULL_THEME"}),
+				Description:      "Variant of the email templates: OKTA_DEFAULT or FULL_THEME",
+			},
+		},
+	}
+}
+
+func resourceThemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Get("theme_id").(string)
+	if id == "" {
+		themes, _, err := getSupplementFromMetadata(m).ListBrandThemes(ctx, d.Get("brand_id").(string))
+		if err != nil {
+			return diag.Errorf("failed to list brand themes: %v", err)
+		}
+		if len(themes) == 0 {
+			return diag.Errorf("brand '%s' does not have any themes", d.Get("brand_id").(string))
+		}
+		id = themes[0].Id
+	}
+	d.SetId(id)
+	return resourceThemeUpdate(ctx, d, m)
+}
+
+func resourceThemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	theme, resp, err := getSupplementFromMetadata(m).GetBrandTheme(ctx, d.Get("brand_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get brand theme: %v", err)
+	}
+	if theme == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("theme_id", theme.Id)
+	_ = d.Set("logo_url", theme.Logo)
+	_ = d.Set("favicon_url", theme.Favicon)
+	_ = d.Set("background_image_url", theme.BackgroundImage)
+	_ = d.Set("primary_color_hex", theme.PrimaryColorHex)
+	_ = d.Set("primary_color_contrast_hex", theme.PrimaryColorContrastHex)
+	_ = d.Set("secondary_color_hex", theme.SecondaryColorHex)
+	_ = d.Set("secondary_color_contrast_hex", theme.SecondaryColorContrastHex)
+	_ = d.Set("sign_in_page_touch_point_variant", theme.SignInPageTouchPointVariant)
+	_ = d.Set("end_user_dashboard_touch_point_variant", theme.EndUserDashboardTouchPointVariant)
+	_ = d.Set("error_page_touch_point_variant", theme.ErrorPageTouchPointVariant)
+	_ = d.Set("email_template_touch_point_variant", theme.EmailTemplateTouchPointVariant)
+	return nil
+}
+
+func resourceThemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	brandID := d.Get("brand_id").(string)
+	client := getSupplementFromMetadata(m)
+	// the files have to be uploaded before the variants, which use them, are set
+	for attr, kind := range themeFiles {
+		if !d.HasChange(attr) {
+			continue
+		}
+		var err error
+		if file := d.Get(attr).(string); file != "" {
+			_, err = client.UploadBrandThemeFile(ctx, brandID, d.Id(), kind, file)
+		} else {
+			_, err = client.DeleteBrandThemeFile(ctx, brandID, d.Id(), kind)
+		}
+		if err != nil {
+			return diag.Errorf("failed to change the %s of the brand theme: %v", strings.ReplaceAll(attr, "_", " "), err)
+		}
+	}
+	theme, _, err := client.GetBrandTheme(ctx, brandID, d.Id())
+	if err != nil {
+		return diag.Errorf("failed to get brand theme: %v", err)
+	}
+	_, _, err = client.UpdateBrandTheme(ctx, brandID, d.Id(), buildTheme(d, *theme))
+	if err != nil {
+		return diag.Errorf("failed to update brand theme: %v", err)
+	}
+	return resourceThemeRead(ctx, d, m)
+}
+
+// Theme can not be removed, the brand always has one
+func resourceThemeDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+// buildTheme sets the configured colors and variants of the theme, the others keep their current values, since the
+// API requires all of them.
+func buildTheme(d *schema.ResourceData, theme sdk.Theme) sdk.Theme {
+	for attr, field := range map[string]*string{
+		"primary_color_hex":                      &theme.PrimaryColorHex,
+		"primary_color_contrast_hex":             &theme.PrimaryColorContrastHex,
+		"secondary_color_hex":                    &theme.SecondaryColorHex,
+		"secondary_color_contrast_hex":           &theme.SecondaryColorContrastHex,
+		"sign_in_page_touch_point_variant":       &theme.SignInPageTouchPointVariant,
+		"end_user_dashboard_touch_point_variant": &theme.EndUserDashboardTouchPointVariant,
+		"error_page_touch_point_variant":         &theme.ErrorPageTouchPointVariant,
+		"email_template_touch_point_variant":     &theme.EmailTemplateTouchPointVariant,
+	} {
+		if v, ok := d.GetOk(attr); ok {
+			*field = v.(string)
+		}
+	}
+	return theme
+}
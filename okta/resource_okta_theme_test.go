@@ -0,0 +1,54 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaTheme_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(oktaTheme)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", oktaTheme)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "theme_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "logo_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "favicon_url"),
+					resource.TestCheckResourceAttr(resourceName, "primary_color_hex", "#1a73e8"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_color_hex", "#f1f3f4"),
+					resource.TestCheckResourceAttr(resourceName, "sign_in_page_touch_point_variant", "BACKGROUND_SECONDARY_COLOR"),
+					resource.TestCheckResourceAttr(resourceName, "end_user_dashboard_touch_point_variant", "FULL_THEME"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "primary_color_hex", "#1662dd"),
+					resource.TestCheckResourceAttr(resourceName, "sign_in_page_touch_point_variant", "OKTA_DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "email_template_touch_point_variant", "OKTA_DEFAULT"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["brand_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
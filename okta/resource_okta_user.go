@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,6 +19,7 @@ var profileKeys = []string{
 	"cost_center",
 	"country_code",
 	"custom_profile_attributes",
+	"custom_profile_attributes_map",
 	"department",
 	"display_name",
 	"division",
@@ -77,19 +79,22 @@ func resourceUser() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"city": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User city",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User city",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"cost_center": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User cost center",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User cost center",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"country_code": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User country code",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User country code",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"custom_profile_attributes": {
 				Type:             schema.TypeString,
@@ -97,24 +102,41 @@ func resourceUser() *schema.Resource {
 				ValidateDiagFunc: stringIsJSON,
 				StateFunc:        normalizeDataJSON,
 				Description:      "JSON formatted custom attributes for a user. It must be JSON due to various types Okta allows.",
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return new == ""
-				},
+				DiffSuppressFunc: suppressCustomProfileAttributesDiff,
+				ConflictsWith:    []string{"custom_profile_attributes_map"},
 			},
-			"department": {
-				Type:        schema.TypeString,
+			"custom_profile_attributes_map": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: mapValuesAreJSON,
+				DiffSuppressFunc: suppressCustomProfileAttributesMapDiff,
+				Description:      "Custom attributes for a user with JSON encoded values, e.g. set with jsonencode(). Empty values stand for null.",
+				ConflictsWith:    []string{"custom_profile_attributes"},
+			},
+			"custom_profile_attributes_to_ignore": {
+				Type:        schema.TypeSet,
 				Optional:    true,
-				Description: "User department",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Custom attributes, which are managed by Okta or outside of Terraform. They are neither read nor changed.",
+			},
+			"department": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User department",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"display_name": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User display name, suitable to show end users",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User display name, suitable to show end users",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"division": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User division",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User division",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"email": {
 				Type:             schema.TypeString,
@@ -123,9 +145,10 @@ func resourceUser() *schema.Resource {
 				ValidateDiagFunc: stringIsEmail,
 			},
 			"employee_number": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User employee number",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User employee number",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"first_name": {
 				Type:        schema.TypeString,
@@ -139,14 +162,16 @@ func resourceUser() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"honorific_prefix": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User honorific prefix",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User honorific prefix",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"honorific_suffix": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User honorific suffix",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User honorific suffix",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"last_name": {
 				Type:        schema.TypeString,
@@ -154,9 +179,10 @@ func resourceUser() *schema.Resource {
 				Description: "User last name",
 			},
 			"locale": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User default location",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User default location",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"login": {
 				Type:        schema.TypeString,
@@ -164,67 +190,85 @@ func resourceUser() *schema.Resource {
 				Description: "User Okta login",
 				ForceNew:    true,
 			},
-			"manager": {
-				Type:        schema.TypeString,
+			"managed_attributes": {
+				Type:        schema.TypeSet,
 				Optional:    true,
-				Description: "Manager of User",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Profile attributes managed by Terraform, e.g. 'department' or 'customAttribute'. When set, the rest of the attributes, except login, email, firstName and lastName, are not changed, and their drift is ignored.",
+			},
+			"manager": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Manager of User",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"manager_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Manager ID of User",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Manager ID of User",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"middle_name": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User middle name",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User middle name",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"mobile_phone": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User mobile phone number",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User mobile phone number",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"nick_name": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User nickname",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User nickname",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"organization": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User organization",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User organization",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"postal_address": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User mailing address",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User mailing address",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"preferred_language": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User preferred language",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User preferred language",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"primary_phone": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User primary phone number",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User primary phone number",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"profile_url": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Description:      "User online profile (web page)",
 				ValidateDiagFunc: stringIsURL(validURLSchemes...),
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"second_email": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Description:      "User secondary email address, used for account recovery",
 				ValidateDiagFunc: stringIsEmail,
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"state": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User state or region",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User state or region",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"status": {
 				Type:             schema.TypeString,
@@ -244,29 +288,34 @@ func resourceUser() *schema.Resource {
 				Description: "The raw status of the User in Okta - (status is mapped)",
 			},
 			"street_address": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User street address",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User street address",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"timezone": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User default timezone",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User default timezone",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"title": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User title",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User title",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"user_type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User employee type",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User employee type",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"zip_code": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User zipcode or postal code",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "User zipcode or postal code",
+				DiffSuppressFunc: suppressUnmanagedUserAttributeDiff,
 			},
 			"password": {
 				Type:        schema.TypeString,
@@ -286,6 +335,12 @@ func resourceUser() *schema.Resource {
 				ValidateDiagFunc: stringLenBetween(4, 1000),
 				Description:      "User Password Recovery Answer",
 			},
+			"skip_activation_email": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Do not send the activation email, when the user is activated, e.g. for the service accounts without a mailbox",
+			},
 		},
 	}
 }
@@ -293,10 +348,17 @@ func resourceUser() *schema.Resource {
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	logger(m).Info("creating user", "login", d.Get("login").(string))
 	profile := populateUserProfile(d)
+	for k := range *profile {
+		if !isUserAttributeManaged(d, k) {
+			delete(*profile, k)
+		}
+	}
+	stampUserProfile(m, profile)
 	qp := query.NewQueryParams()
 
 	// setting activate to false on user creation will leave the user with a status of STAGED
-	if d.Get("status").(string) == userStatusStaged {
+	skipActivationEmail := d.Get("skip_activation_email").(bool)
+	if d.Get("status").(string) == userStatusStaged || skipActivationEmail {
 		qp = query.NewQueryParams(query.WithActivate(false))
 	}
 
@@ -326,6 +388,14 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 	// set the user id into state before setting roles and status in case they fail
 	d.SetId(user.Id)
 
+	// the user is created as STAGED, and is activated without the activation email
+	if d.Get("status").(string) != userStatusStaged && skipActivationEmail {
+		err = updateUserStatus(ctx, user.Id, statusActive, false, client)
+		if err != nil {
+			return diag.Errorf("failed to activate user: %v", err)
+		}
+	}
+
 	// role assigning can only happen after the user is created so order matters here
 	roles := convertInterfaceToStringSetNullable(d.Get("admin_roles"))
 	if roles != nil {
@@ -346,7 +416,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 	// status changing can only happen after user is created as well
 	if d.Get("status").(string) == userStatusSuspended || d.Get("status").(string) == userStatusDeprovisioned {
-		err := updateUserStatus(ctx, user.Id, d.Get("status").(string), client)
+		err := updateUserStatus(ctx, user.Id, d.Get("status").(string), !skipActivationEmail, client)
 		if err != nil {
 			return diag.Errorf("failed to update user status: %v", err)
 		}
@@ -367,6 +437,12 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 	}
 	_ = d.Set("raw_status", user.Status)
 	rawMap := flattenUser(user)
+	customAttrs := userCustomProfileAttributes(user, append(userAttributesToIgnore(d, m), unmanagedUserAttributes(d, *user.Profile)...))
+	data, _ := json.Marshal(customAttrs)
+	rawMap["custom_profile_attributes"] = string(data)
+	if _, ok := d.GetOk("custom_profile_attributes_map"); ok {
+		rawMap["custom_profile_attributes_map"] = encodeCustomProfileAttributesMap(customAttrs)
+	}
 	err = setNonPrimitives(d, rawMap)
 	if err != nil {
 		return diag.Errorf("failed to set user's properties: %v", err)
@@ -406,7 +482,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	// can be updated further if it's status changed in it's terraform configs
 	client := getOktaClientFromMetadata(m)
 	if statusChange {
-		err := updateUserStatus(ctx, d.Id(), status, client)
+		err := updateUserStatus(ctx, d.Id(), status, !d.Get("skip_activation_email").(bool), client)
 		if err != nil {
 			return diag.Errorf("failed to update user status: %v", err)
 		}
@@ -419,6 +495,31 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 
 	if userChange {
 		profile := populateUserProfile(d)
+		// the profile is replaced, so the current values of the ignored attributes are sent as is
+		if ignored := convertInterfaceToStringSetNullable(d.Get("custom_profile_attributes_to_ignore")); len(ignored) > 0 {
+			current, _, err := client.User.GetUser(ctx, d.Id())
+			if err != nil {
+				return diag.Errorf("failed to get user: %v", err)
+			}
+			for _, k := range ignored {
+				if v, ok := (*current.Profile)[k]; ok {
+					(*profile)[k] = v
+				}
+			}
+		}
+		// only the managed attributes are changed, the rest of the profile is sent as is
+		if managed := convertInterfaceToStringSetNullable(d.Get("managed_attributes")); len(managed) > 0 {
+			current, _, err := client.User.GetUser(ctx, d.Id())
+			if err != nil {
+				return diag.Errorf("failed to get user: %v", err)
+			}
+			for _, k := range append(managed, requiredUserAttributes...) {
+				// the managed attributes, which are removed from the configuration, are cleared
+				(*current.Profile)[k] = (*profile)[k]
+			}
+			profile = current.Profile
+		}
+		stampUserProfile(m, profile)
 		userBody := okta.User{Profile: profile}
 		_, _, err := client.User.UpdateUser(ctx, d.Id(), userBody, nil)
 		if err != nil {
@@ -442,8 +543,14 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		_ = d.Set("group_memberships", groups)
 	}
 
-	if passwordChange {
-		oldPassword, newPassword := d.GetChange("password")
+	oldPassword, newPassword := d.GetChange("password")
+	// the password of the user without one, e.g. a service account, can only be set by the admin
+	if passwordChange && oldPassword.(string) == "" {
+		err := setUserCredentials(ctx, d, client)
+		if err != nil {
+			return diag.Errorf("failed to set user's password: %v", err)
+		}
+	} else if passwordChange {
 		op := &okta.PasswordCredential{
 			Value: oldPassword.(string),
 		}
@@ -460,7 +567,13 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		}
 	}
 
-	if recoveryQuestionChange || recoveryAnswerChange {
+	// the recovery question of the user without password can only be set by the admin
+	if (recoveryQuestionChange || recoveryAnswerChange) && d.Get("password").(string) == "" {
+		err := setUserCredentials(ctx, d, client)
+		if err != nil {
+			return diag.Errorf("failed to set user's password recovery question: %v", err)
+		}
+	} else if recoveryQuestionChange || recoveryAnswerChange {
 		nuc := &okta.UserCredentials{
 			Password: &okta.PasswordCredential{
 				Value: d.Get("password").(string),
@@ -482,6 +595,9 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 // to give a sensible user readable error when they attempt to update a DEPROVISIONED user. Previously
 // this error always occurred when you set a user's status to DEPROVISIONED.
 func hasProfileChange(d *schema.ResourceData) bool {
+	if d.HasChange("managed_attributes") {
+		return true
+	}
 	for _, k := range profileKeys {
 		if d.HasChange(k) {
 			return true
@@ -522,3 +638,20 @@ func mapStatus(currentStatus string) string {
 	}
 	return currentStatus
 }
+
+// setUserCredentials sets the password and the recovery question of the user as the admin, which does not require the
+// current password of the user.
+func setUserCredentials(ctx context.Context, d *schema.ResourceData, client *okta.Client) error {
+	uc := &okta.UserCredentials{}
+	if password := d.Get("password").(string); password != "" {
+		uc.Password = &okta.PasswordCredential{Value: password}
+	}
+	if question := d.Get("recovery_question").(string); question != "" {
+		uc.RecoveryQuestion = &okta.RecoveryQuestionCredential{
+			Question: question,
+			Answer:   d.Get("recovery_answer").(string),
+		}
+	}
+	_, _, err := client.User.PartialUpdateUser(ctx, d.Id(), okta.User{Credentials: uc}, nil)
+	return err
+}
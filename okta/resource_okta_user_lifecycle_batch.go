@@ -0,0 +1,194 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// userLifecycleBatchConfirmation has to be set explicitly, so the users are not deactivated by accident.
+const userLifecycleBatchConfirmation = "DEACTIVATE"
+
+func resourceUserLifecycleBatch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserLifecycleBatchCreate,
+		ReadContext:   resourceUserLifecycleBatchRead,
+		UpdateContext: resourceUserLifecycleBatchUpdate,
+		DeleteContext: resourceUserLifecycleBatchDelete,
+		CustomizeDiff: resourceUserLifecycleBatchCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Search expression of the users to deactivate, e.g. 'profile.department eq \"Offboarded\"'",
+			},
+			"clear_sessions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Clear the sessions and revoke the OAuth tokens of the users before the deactivation",
+			},
+			"confirmation": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringInSlice([]string{userLifecycleBatchConfirmation}),
+				Description:      fmt.Sprintf("Explicit confirmation of the deactivation, has to be '%s'", userLifecycleBatchConfirmation),
+			},
+			"max_users": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: intAtLeast(0),
+				Description:      "Maximum number of the users, which can be deactivated during a single apply. '0' means no limit",
+			},
+			"deactivated_users": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the users, which were deactivated during the last apply",
+			},
+			"pending_users": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the users, which match the search, but are not deactivated yet. Only these users are deactivated during the apply",
+			},
+		},
+	}
+}
+
+// resourceUserLifecycleBatchCustomizeDiff plans the users, which are deactivated during the apply. The users, which
+// started matching the search after the last apply, are deactivated on the next one.
+func resourceUserLifecycleBatchCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("search") {
+		return d.SetNewComputed("pending_users")
+	}
+	qp := &query.Params{Search: d.Get("search").(string), Limit: defaultPaginationLimit}
+	ids, err := listUserLifecycleBatchUsers(ctx, m, qp)
+	if err != nil {
+		return fmt.Errorf("failed to list users: %v", err)
+	}
+	if max := d.Get("max_users").(int); max > 0 && len(ids) > max {
+		return fmt.Errorf("%d users match the search, which is more than 'max_users' (%d)", len(ids), max)
+	}
+	if d.Id() != "" && len(ids) == 0 && d.Get("pending_users").(*schema.Set).Len() == 0 {
+		return nil
+	}
+	if err := d.SetNew("pending_users", convertStringSetToInterface(ids)); err != nil {
+		return err
+	}
+	return d.SetNewComputed("deactivated_users")
+}
+
+func resourceUserLifecycleBatchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := deactivateUserLifecycleBatch(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(d.Get("search").(string)))))
+	return resourceUserLifecycleBatchRead(ctx, d, m)
+}
+
+// The pending users are planned during the diff, so they are not refreshed here: this way, the users, which match the
+// search, are shown as the planned change.
+func resourceUserLifecycleBatchRead(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceUserLifecycleBatchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the users are deactivated only when some are planned, e.g. not when only 'clear_sessions' is toggled
+	if d.Get("pending_users").(*schema.Set).Len() == 0 {
+		return nil
+	}
+	if err := deactivateUserLifecycleBatch(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceUserLifecycleBatchRead(ctx, d, m)
+}
+
+// Users are left deactivated on destroy.
+func resourceUserLifecycleBatchDelete(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return nil
+}
+
+// listUserLifecycleBatchUsers returns the IDs of the users, which match the search and are not deactivated yet.
+func listUserLifecycleBatchUsers(ctx context.Context, m interface{}, qp *query.Params) ([]string, error) {
+	users, err := collectUsers(ctx, getOktaClientFromMetadata(m), qp)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, user := range users {
+		if user.Status != userStatusDeprovisioned {
+			ids = append(ids, user.Id)
+		}
+	}
+	return ids, nil
+}
+
+// plannedUserLifecycleBatchUsers returns the planned users, which are still not deactivated. It fails if some users
+// started matching the search after the plan, so only the users shown in the plan are deactivated.
+func plannedUserLifecycleBatchUsers(planned, live []string) ([]string, error) {
+	var unplanned, ids []string
+	for _, id := range live {
+		if contains(planned, id) {
+			ids = append(ids, id)
+		} else {
+			unplanned = append(unplanned, id)
+		}
+	}
+	if len(unplanned) > 0 {
+		return nil, fmt.Errorf("users %v started matching the search after the plan, run the plan again to review them", unplanned)
+	}
+	return ids, nil
+}
+
+// deactivateUserLifecycleBatch deactivates the planned users concurrently, limited by the 'parallelism' of the
+// provider.
+func deactivateUserLifecycleBatch(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	planned := convertInterfaceToStringSet(d.Get("pending_users"))
+	if max := d.Get("max_users").(int); max > 0 && len(planned) > max {
+		return fmt.Errorf("%d users are planned for the deactivation, which is more than 'max_users' (%d)", len(planned), max)
+	}
+	qp := &query.Params{Search: d.Get("search").(string), Limit: defaultPaginationLimit}
+	live, err := listUserLifecycleBatchUsers(ctx, m, qp)
+	if err != nil {
+		return fmt.Errorf("failed to list users: %v", err)
+	}
+	ids, err := plannedUserLifecycleBatchUsers(planned, live)
+	if err != nil {
+		return err
+	}
+	client := getOktaClientFromMetadata(m)
+	clearSessions := d.Get("clear_sessions").(bool)
+	funcs := make([]func() error, len(ids))
+	for i := range ids {
+		id := ids[i]
+		funcs[i] = func() error {
+			if clearSessions {
+				resp, err := client.User.ClearUserSessions(ctx, id, &query.Params{OauthTokens: boolPtr(true)})
+				if err := suppressErrorOn404(resp, err); err != nil {
+					return fmt.Errorf("failed to clear sessions of user '%s': %v", id, err)
+				}
+			}
+			resp, err := client.User.DeactivateUser(ctx, id, nil)
+			if err := suppressErrorOn404(resp, err); err != nil {
+				return fmt.Errorf("failed to deactivate user '%s': %v", id, err)
+			}
+			return nil
+		}
+	}
+	if len(funcs) > 0 {
+		if err := getPromiseError(promiseAll(ctx, getParallelismFromMetadata(m), funcs...), "failed to deactivate users"); err != nil {
+			return err
+		}
+	}
+	// all the planned users are deactivated now
+	return setNonPrimitives(d, map[string]interface{}{
+		"deactivated_users": convertStringSetToInterface(ids),
+		"pending_users":     convertStringSetToInterface(nil),
+	})
+}
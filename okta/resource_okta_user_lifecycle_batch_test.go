@@ -0,0 +1,67 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaUserLifecycleBatch_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", userLifecycleBatch)
+	mgr := newFixtureManager(userLifecycleBatch)
+	users := mgr.GetFixtures("users.tf", ri, t)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: users,
+			},
+			{
+				// the search has to be indexed before the deactivation
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deactivated_users.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "pending_users.#", "0"),
+					ensureUserStatus("okta_user.test.0", userStatusDeprovisioned),
+					ensureUserStatus("okta_user.test.1", userStatusDeprovisioned),
+				),
+			},
+		},
+	})
+}
+
+func ensureUserStatus(name, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		user, _, err := getOktaClientFromMetadata(testAccProvider.Meta()).User.GetUser(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if user.Status != status {
+			return fmt.Errorf("expected user '%s' to be %s, actual: %s", rs.Primary.ID, status, user.Status)
+		}
+		return nil
+	}
+}
+
+func TestPlannedUserLifecycleBatchUsers(t *testing.T) {
+	ids, err := plannedUserLifecycleBatchUsers([]string{"00u1", "00u2"}, []string{"00u2"})
+	if err != nil || len(ids) != 1 || ids[0] != "00u2" {
+		t.Errorf("expected only the planned user, which is still active, got %v, %v", ids, err)
+	}
+	if _, err := plannedUserLifecycleBatchUsers([]string{"00u1"}, []string{"00u1", "00u3"}); err == nil {
+		t.Error("expected an error for the user, which wasn't planned")
+	}
+}
@@ -47,6 +47,13 @@ func resourceUserSchema() *schema.Resource {
 					Default:          "NONE",
 					ValidateDiagFunc: stringInSlice([]string{"SELF", "NONE", ""}),
 				},
+				"union": {
+					Type:          schema.TypeBool,
+					Optional:      true,
+					Description:   "Allows to combine the values of the array attribute across groups",
+					Default:       false,
+					ConflictsWith: []string{"enum"},
+				},
 				"master": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -55,27 +62,10 @@ func resourceUserSchema() *schema.Resource {
 					Description:      "SubSchema profile manager, if not set it will inherit its setting.",
 					Default:          "PROFILE_MASTER",
 				},
-				"master_override_priority": {
-					Type:        schema.TypeList,
-					Optional:    true,
-					Description: "",
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"type": {
-								Type:     schema.TypeString,
-								Optional: true,
-								Default:  "APP",
-							},
-							"value": {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-						},
-					},
-				},
+				"master_override_priority": masterOverridePrioritySchema,
 			},
 		),
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Type: resourceUserSchemaResourceV0().CoreConfigSchema().ImpliedType(),
@@ -85,12 +75,37 @@ func resourceUserSchema() *schema.Resource {
 				},
 				Version: 0,
 			},
+			{
+				Type: resourceUserSchemaResourceV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+					rawState["union"] = false
+					return rawState, nil
+				},
+				Version: 1,
+			},
 		},
 	}
 }
 
+func resourceUserSchemaResourceV1() *schema.Resource {
+	return &schema.Resource{Schema: buildSchema(userBaseSchemaSchema, userSchemaSchemaV1, userTypeSchema, userPatternSchema, map[string]*schema.Schema{
+		"scope": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "NONE",
+			ValidateDiagFunc: stringInSlice([]string{"SELF", "NONE", ""}),
+		},
+		"master": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "PROFILE_MASTER",
+		},
+		"master_override_priority": masterOverridePrioritySchema,
+	})}
+}
+
 func resourceUserSchemaResourceV0() *schema.Resource {
-	return &schema.Resource{Schema: buildSchema(userBaseSchemaSchema, userSchemaSchema, map[string]*schema.Schema{
+	return &schema.Resource{Schema: buildSchema(userBaseSchemaSchema, userSchemaSchemaV1, map[string]*schema.Schema{
 		"scope": {
 			Type:             schema.TypeString,
 			Optional:         true,
@@ -113,6 +128,14 @@ func resourceUserSchemaCreateOrUpdate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.Errorf("failed to create user custom schema: %v", err)
 	}
+	body := userSubSchema(d)
+	if body.Type == "array" {
+		if d.Get("union").(bool) {
+			body.Union = "ENABLE"
+		} else {
+			body.Union = "DISABLE"
+		}
+	}
 	var subschema *sdk.UserSubSchema
 	timer := time.NewTimer(time.Second * 3)
 	ticker := time.NewTicker(time.Millisecond * 500)
@@ -124,7 +147,7 @@ loop:
 		case <-timer.C:
 			return diag.Errorf("failed to create user custom schema: no more attempts left")
 		case <-ticker.C:
-			updated, _, err := getSupplementFromMetadata(m).UpdateCustomUserSchemaProperty(ctx, schemaUrl, d.Get("index").(string), userSubSchema(d))
+			updated, _, err := getSupplementFromMetadata(m).UpdateCustomUserSchemaProperty(ctx, schemaUrl, d.Get("index").(string), body)
 			if err != nil {
 				return diag.Errorf("failed to create user custom schema: %v", err)
 			}
@@ -143,6 +166,7 @@ loop:
 	if err != nil {
 		return diag.Errorf("failed to set user custom schema properties: %v", err)
 	}
+	syncUserSchemaUnion(d, subschema)
 	return nil
 }
 
@@ -165,6 +189,7 @@ func resourceUserSchemaRead(ctx context.Context, d *schema.ResourceData, m inter
 	if err != nil {
 		return diag.Errorf("failed to set user custom schema properties: %v", err)
 	}
+	syncUserSchemaUnion(d, subschema)
 	return nil
 }
 
@@ -180,14 +205,24 @@ func resourceUserSchemaDelete(ctx context.Context, d *schema.ResourceData, m int
 	return nil
 }
 
+func syncUserSchemaUnion(d *schema.ResourceData, subschema *sdk.UserSubSchema) {
+	if subschema.Union != "" {
+		_ = d.Set("union", subschema.Union != "DISABLE")
+	}
+}
+
 func validateUserSchema(d *schema.ResourceData) error {
-	v, ok := d.GetOk("master")
-	if !ok || v.(string) != "OVERRIDE" {
-		return nil
+	if d.Get("union").(bool) {
+		if d.Get("type").(string) != "array" {
+			return errors.New("combining values across groups (union=true) is only supported for the properties of type 'array'")
+		}
+		if d.Get("scope").(string) == "SELF" {
+			return errors.New("you can not use combine values across groups (union=true) for self scoped " +
+				"attribute (scope=SELF). Either change scope to 'NONE', or use group priority option by setting union to 'false'")
+		}
 	}
-	mop, _ := d.Get("master_override_priority").([]interface{})
-	if len(mop) == 0 {
-		return errors.New("when setting profile master type to 'OVERRIDE' at least one 'master_override_priority' should be provided")
+	if d.Get("array_properties").(*schema.Set).Len() > 0 && d.Get("array_type").(string) != "object" {
+		return errors.New("'array_properties' can only be set for the array items of type 'object' (array_type=\"object\")")
 	}
-	return nil
+	return validateMasterOverridePriority(d)
 }
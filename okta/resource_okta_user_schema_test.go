@@ -207,6 +207,32 @@ func TestAccOktaUserSchema_arrayString(t *testing.T) {
 	})
 }
 
+func TestAccOktaUserSchema_arrayObject(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", userSchema)
+	mgr := newFixtureManager(userSchema)
+	config := mgr.GetFixtures("array_object.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      checkOktaUserSchemasDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testOktaUserSchemasExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "index", "testAcc_"+strconv.Itoa(ri)),
+					resource.TestCheckResourceAttr(resourceName, "type", "array"),
+					resource.TestCheckResourceAttr(resourceName, "array_type", "object"),
+					resource.TestCheckResourceAttr(resourceName, "union", "true"),
+					resource.TestCheckResourceAttr(resourceName, "array_properties.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func checkOktaUserSchemasDestroy() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -266,3 +292,19 @@ func testSchemaPropertyExists(schemaUserType, index, resolutionScope string) (bo
 		return false, fmt.Errorf("resolution scope can be only 'base' or 'custom'")
 	}
 }
+
+func TestUserSchemaResourceV1(t *testing.T) {
+	v1 := resourceUserSchemaResourceV1().Schema
+	if _, ok := v1["array_properties"]; ok {
+		t.Error("expected the schema version 1 not to contain 'array_properties'")
+	}
+	if _, ok := v1["union"]; ok {
+		t.Error("expected the schema version 1 not to contain 'union'")
+	}
+	current := resourceUserSchema().Schema
+	for k := range v1 {
+		if _, ok := current[k]; !ok {
+			t.Errorf("expected '%s' of the schema version 1 to be in the current schema", k)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
@@ -101,6 +103,76 @@ func TestAccOktaUser_customProfileAttributes(t *testing.T) {
 	})
 }
 
+func TestAccOktaUser_customProfileAttributesMap(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(oktaUser)
+	config := mgr.GetFixtures("custom_attributes_map.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", oktaUser)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_profile_attributes_map.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_profile_attributes_map.customAttribute123", "\"testing-custom-attribute\""),
+					resource.TestCheckResourceAttr(resourceName, "custom_profile_attributes_map.array123", "[\"test\"]"),
+					resource.TestCheckResourceAttr(resourceName, "custom_profile_attributes_to_ignore.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestNormalizeCustomProfileAttributes(t *testing.T) {
+	attrs := decodeCustomProfileAttributesMap(map[string]interface{}{
+		"string": `"value"`,
+		"array":  `["a","b"]`,
+		"number": `1`,
+		"empty":  ``,
+		"null":   `null`,
+		"ignore": `"value"`,
+	})
+	expected := map[string]interface{}{
+		"string": "value",
+		"array":  []interface{}{"a", "b"},
+		"number": float64(1),
+	}
+	normalized := normalizeCustomProfileAttributes(attrs, []string{"ignore"})
+	if !reflect.DeepEqual(expected, normalized) {
+		t.Fatalf("expected %+v, got %+v", expected, normalized)
+	}
+	encoded := encodeCustomProfileAttributesMap(normalized)
+	if encoded["array"] != `["a","b"]` || encoded["string"] != `"value"` || encoded["number"] != `1` {
+		t.Fatalf("unexpected encoded attributes %+v", encoded)
+	}
+}
+
+func TestSuppressCustomProfileAttributesDiff(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+		"custom_profile_attributes_to_ignore": []interface{}{"ignored"},
+	})
+	tests := []struct {
+		old, new string
+		expected bool
+	}{
+		{`{"a":"b"}`, ``, true},
+		{`{"a":"b"}`, `{ "a": "b" }`, true},
+		{`{"a":"b"}`, `{"a":"b","c":null,"d":""}`, true},
+		{`{"a":"b"}`, `{"a":"b","ignored":"value"}`, true},
+		{`{"a":"b"}`, `{"a":"c"}`, false},
+		{`{"a":"b"}`, `{"a":"b","c":1}`, false},
+	}
+	for _, test := range tests {
+		if actual := suppressCustomProfileAttributesDiff("", test.old, test.new, d); actual != test.expected {
+			t.Errorf("old: %s, new: %s, expected %v, got %v", test.old, test.new, test.expected, actual)
+		}
+	}
+}
+
 func TestAccOktaUser_groupMembership(t *testing.T) {
 	ri := acctest.RandInt()
 	mgr := newFixtureManager(oktaUser)
@@ -277,6 +349,36 @@ func TestAccOktaUser_updateCredentials(t *testing.T) {
 	})
 }
 
+func TestAccOktaUser_serviceAccount(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(oktaUser)
+	config := mgr.GetFixtures("service_account.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("service_account_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", oktaUser)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "skip_activation_email", "true"),
+					resource.TestCheckResourceAttr(resourceName, "raw_status", userStatusProvisioned),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "raw_status", userStatusProvisioned),
+					resource.TestCheckResourceAttr(resourceName, "recovery_answer", "Forty Two"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccOktaUser_statusDeprovisioned(t *testing.T) {
 	ri := acctest.RandInt()
 	mgr := newFixtureManager(oktaUser)
@@ -400,3 +502,65 @@ resource okta_user "test" {
 }
 `, r)
 }
+
+func TestAccOktaUser_managedAttributes(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(oktaUser)
+	config := mgr.GetFixtures("managed_attributes.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", oktaUser)
+	login := fmt.Sprintf("testAcc-%d@example.com", ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", "Engineer"),
+					resource.TestCheckResourceAttr(resourceName, "managed_attributes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_profile_attributes_map.customAttribute123", "\"testing-custom-attribute\""),
+				),
+			},
+			{
+				// the attributes mastered outside of Terraform don't cause the drift
+				PreConfig: func() {
+					client := getOktaClientFromMetadata(testAccProvider.Meta())
+					user, _, err := client.User.GetUser(context.Background(), login)
+					if err != nil {
+						t.Fatalf("failed to get user: %v", err)
+					}
+					(*user.Profile)["department"] = "Human Resources"
+					_, _, err = client.User.UpdateUser(context.Background(), user.Id, *user, nil)
+					if err != nil {
+						t.Fatalf("failed to update user: %v", err)
+					}
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestSuppressUnmanagedUserAttributeDiff(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+		"managed_attributes": []interface{}{"costCenter", "managed"},
+	})
+	for k, expected := range map[string]bool{
+		"cost_center": false,
+		"email":       false,
+		"department":  true,
+	} {
+		if actual := suppressUnmanagedUserAttributeDiff(k, "", "value", d); actual != expected {
+			t.Errorf("%s: expected %v, got %v", k, expected, actual)
+		}
+	}
+	if !suppressCustomProfileAttributesDiff("", `{"managed":"a"}`, `{"managed":"a","hr":"b"}`, d) {
+		t.Error("expected the diff of the unmanaged custom attribute to be suppressed")
+	}
+	if suppressCustomProfileAttributesDiff("", `{"managed":"a"}`, `{"managed":"b"}`, d) {
+		t.Error("expected the diff of the managed custom attribute not to be suppressed")
+	}
+}
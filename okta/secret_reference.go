@@ -0,0 +1,138 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+const vaultSecretPrefix = "vault:"
+
+var awsSecretARNRegexp = regexp.MustCompile(`^arn:aws[a-z-]*:secretsmanager:([a-z0-9-]+):\d{12}:secret:.+`)
+
+// resolveSecret returns the value of the secret, which the credential refers to, so the plain text credentials are
+// neither kept in the configuration nor in the state, only the references are. The references are:
+//   - 'arn:aws:secretsmanager:<region>:<account>:secret:<name>' - AWS Secrets Manager secret, the credentials of the
+//     default AWS credential chain are used;
+//   - 'vault:<path>' - KV secret of HashiCorp Vault, e.g. 'vault:secret/data/okta', the VAULT_ADDR and VAULT_TOKEN
+//     environment variables are used to connect to Vault.
+//
+// The reference may be followed by '#<key>' to get the key of the JSON secret, the key is required for Vault secrets.
+// Other values are returned as they are. The references are resolved only when the provider is configured with
+// 'resolve_secret_references', otherwise all the values are returned as they are.
+func resolveSecret(ctx context.Context, m interface{}, value string) (string, error) {
+	if c, ok := m.(*Config); !ok || !c.resolveSecretRefs {
+		return value, nil
+	}
+	switch {
+	case awsSecretARNRegexp.MatchString(value):
+		arn, key := splitSecretReference(value)
+		secret, err := getAWSSecret(ctx, arn)
+		if err != nil {
+			return "", fmt.Errorf("failed to get AWS Secrets Manager secret '%s': %v", arn, err)
+		}
+		return secretValue(secret, key)
+	case strings.HasPrefix(value, vaultSecretPrefix):
+		path, key := splitSecretReference(strings.TrimPrefix(value, vaultSecretPrefix))
+		if key == "" {
+			return "", fmt.Errorf("key of the Vault secret '%s' is not set, it should be referenced as 'vault:<path>#<key>'", path)
+		}
+		data, err := getVaultSecret(ctx, cleanhttp.DefaultClient(), os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN"), path)
+		if err != nil {
+			return "", fmt.Errorf("failed to get Vault secret '%s': %v", path, err)
+		}
+		v, ok := data[key].(string)
+		if !ok {
+			return "", fmt.Errorf("secret '%s' has no '%s' key", path, key)
+		}
+		return v, nil
+	default:
+		return value, nil
+	}
+}
+
+func splitSecretReference(ref string) (string, string) {
+	if i := strings.LastIndex(ref, "#"); i != -1 {
+		return ref[:i], ref[i+1:]
+	}
+	return ref, ""
+}
+
+// secretValue returns the key of the JSON secret, or the whole secret, when the key is empty.
+func secretValue(secret, key string) (string, error) {
+	if key == "" {
+		return secret, nil
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(secret), &data); err != nil {
+		return "", fmt.Errorf("failed to get '%s' key of the secret, it's not a JSON object: %v", key, err)
+	}
+	v, ok := data[key].(string)
+	if !ok {
+		return "", fmt.Errorf("secret has no '%s' key", key)
+	}
+	return v, nil
+}
+
+func getAWSSecret(ctx context.Context, arn string) (string, error) {
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(awsSecretARNRegexp.FindStringSubmatch(arn)[1]))
+	if err != nil {
+		return "", err
+	}
+	out, err := secretsmanager.NewFromConfig(cfg).GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(arn),
+	})
+	if err != nil {
+		return "", err
+	}
+	if out.SecretString == nil {
+		return "", errors.New("binary secrets are not supported")
+	}
+	return *out.SecretString, nil
+}
+
+// getVaultSecret returns the data of the KV secret. The version 2 of the KV secrets engine wraps the data along with
+// the metadata of the secret.
+func getVaultSecret(ctx context.Context, client *http.Client, addr, token, path string) (map[string]interface{}, error) {
+	if addr == "" || token == "" {
+		return nil, errors.New("VAULT_ADDR and VAULT_TOKEN environment variables should be set")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/%s", strings.TrimRight(addr, "/"), strings.TrimLeft(path, "/")), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if ns := os.Getenv("VAULT_NAMESPACE"); ns != "" {
+		req.Header.Set("X-Vault-Namespace", ns)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+	var secret struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return nil, err
+	}
+	if data, ok := secret.Data["data"].(map[string]interface{}); ok {
+		if _, ok := secret.Data["metadata"]; ok {
+			return data, nil
+		}
+	}
+	return secret.Data, nil
+}
@@ -0,0 +1,90 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResolveSecret(t *testing.T) {
+	m := &Config{resolveSecretRefs: true}
+	value, err := resolveSecret(context.Background(), m, "plain text password")
+	if err != nil || value != "plain text password" {
+		t.Errorf("expected the plain text value to be returned as it is, got '%s', %v", value, err)
+	}
+	_, err = resolveSecret(context.Background(), m, "vault:secret/data/okta")
+	if err == nil {
+		t.Error("expected an error for the Vault reference without the key")
+	}
+	value, err = resolveSecret(context.Background(), &Config{}, "vault:secret/data/okta")
+	if err != nil || value != "vault:secret/data/okta" {
+		t.Errorf("expected the reference not to be resolved without 'resolve_secret_references', got '%s', %v", value, err)
+	}
+}
+
+func TestSecretValue(t *testing.T) {
+	cases := []struct {
+		secret   string
+		key      string
+		expected string
+		fails    bool
+	}{
+		{secret: "plain", expected: "plain"},
+		{secret: `{"password":"secret"}`, key: "password", expected: "secret"},
+		{secret: `{"username":"john"}`, key: "password", fails: true},
+		{secret: "plain", key: "password", fails: true},
+	}
+	for _, c := range cases {
+		value, err := secretValue(c.secret, c.key)
+		if c.fails != (err != nil) || value != c.expected {
+			t.Errorf("expected '%s' key of '%s' to be '%s', got '%s', %v", c.key, c.secret, c.expected, value, err)
+		}
+	}
+}
+
+func TestSplitSecretReference(t *testing.T) {
+	ref, key := splitSecretReference("arn:aws:secretsmanager:us-east-1:123456789012:secret:okta-AbCdEf#password")
+	if ref != "arn:aws:secretsmanager:us-east-1:123456789012:secret:okta-AbCdEf" || key != "password" {
+		t.Errorf("unexpected reference '%s' and key '%s'", ref, key)
+	}
+	ref, key = splitSecretReference("secret/data/okta")
+	if ref != "secret/data/okta" || key != "" {
+		t.Errorf("unexpected reference '%s' and key '%s'", ref, key)
+	}
+}
+
+func TestGetVaultSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/okta":
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"v2"},"metadata":{"version":1}}}`))
+		case "/v1/kv/okta":
+			_, _ = w.Write([]byte(`{"data":{"password":"v1"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	for path, expected := range map[string]string{"secret/data/okta": "v2", "kv/okta": "v1"} {
+		data, err := getVaultSecret(context.Background(), server.Client(), server.URL, "token", path)
+		if err != nil {
+			t.Fatalf("failed to get Vault secret '%s': %v", path, err)
+		}
+		if data["password"] != expected {
+			t.Errorf("expected password of the secret '%s' to be '%s', got '%v'", path, expected, data["password"])
+		}
+	}
+	_, err := getVaultSecret(context.Background(), server.Client(), server.URL, "token", "kv/missing")
+	if err == nil {
+		t.Error("expected an error for the missing secret")
+	}
+	_, err = getVaultSecret(context.Background(), server.Client(), server.URL, "", "kv/okta")
+	if err == nil {
+		t.Error("expected an error when the token is not set")
+	}
+}
@@ -0,0 +1,41 @@
+package okta
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// statusSchema is the schema of the 'status' of the objects that can be activated and deactivated. The status is always
+// read back from the API, so any change made outside of Terraform shows up as a drift and is reverted by changeStatus.
+var statusSchema = buildStatusSchema("Status of the object: ACTIVE or INACTIVE.")
+
+func buildStatusSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          statusActive,
+		ValidateDiagFunc: stringInSlice([]string{statusActive, statusInactive}),
+		Description:      description,
+	}
+}
+
+// buildComputedStatusSchema builds the schema of the 'status' of the objects, which status can not be managed, e.g.
+// default policies
+func buildComputedStatusSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: description,
+	}
+}
+
+// changeStatus activates or deactivates the object, if its current status differs from the desired one. Empty current
+// status means that it's unknown, so the object's status is changed unconditionally.
+func changeStatus(current, desired string, activate, deactivate func() error) error {
+	if current == desired {
+		return nil
+	}
+	if desired == statusActive {
+		return activate()
+	}
+	return deactivate()
+}
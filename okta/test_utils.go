@@ -55,3 +55,16 @@ func ensureResourceNotExists(name string) resource.TestCheckFunc {
 		return fmt.Errorf("Resource found: %s", name)
 	}
 }
+
+// saveResourceID saves the ID of the resource, so the later steps can check that it was updated in place, e.g. with
+// resource.TestCheckResourceAttrPtr(name, "id", &id).
+func saveResourceID(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
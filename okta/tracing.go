@@ -0,0 +1,131 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing of the API calls is enabled with the standard 'OTEL_TRACES_EXPORTER=otlp' environment variable, the
+// exporter is configured with the standard 'OTEL_EXPORTER_OTLP_*' environment variables.
+const (
+	tracesExporterEnv  = "OTEL_TRACES_EXPORTER"
+	tracesExporterOTLP = "otlp"
+	tracerName         = "github.com/okta/terraform-provider-okta"
+)
+
+// newTracer returns nil, if the tracing is not enabled. The spans are exported synchronously, since the provider's
+// process is stopped by Terraform without any notice, and batched spans would be lost.
+func newTracer() (trace.Tracer, error) {
+	if os.Getenv(tracesExporterEnv) != tracesExporterOTLP {
+		return nil, nil
+	}
+	exporter, err := otlptracehttp.New(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceNameKey.String("terraform-provider-okta"),
+		)),
+	)
+	return provider.Tracer(tracerName), nil
+}
+
+// tracingTransport creates a span for every request made to Okta, including the retried ones.
+func tracingTransport(tracer trace.Tracer, next http.RoundTripper) http.RoundTripper {
+	return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		ctx, span := tracer.Start(req.Context(), "okta.api "+req.Method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.HTTPMethodKey.String(req.Method),
+				semconv.HTTPTargetKey.String(req.URL.Path),
+				semconv.HTTPHostKey.String(req.URL.Host),
+			))
+		defer span.End()
+		resp, err := next.RoundTrip(req.WithContext(ctx))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return resp, err
+		}
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(resp.StatusCode))
+		if limit := resp.Header.Get("X-Rate-Limit-Limit"); limit != "" {
+			span.SetAttributes(
+				attribute.String("okta.rate_limit.limit", limit),
+				attribute.String("okta.rate_limit.remaining", resp.Header.Get("X-Rate-Limit-Remaining")),
+			)
+		}
+		if resp.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, resp.Status)
+		}
+		return resp, nil
+	})
+}
+
+// tracingRetryPolicy adds an event to the span of the operation, every time the request is retried.
+func tracingRetryPolicy(next retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		retry, retryErr := next(ctx, resp, err)
+		if retry {
+			var attrs []attribute.KeyValue
+			if resp != nil {
+				attrs = append(attrs, semconv.HTTPStatusCodeKey.Int(resp.StatusCode))
+			}
+			trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(attrs...))
+		}
+		return retry, retryErr
+	}
+}
+
+func withTracing(name, operation string, f contextFunc) contextFunc {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c, ok := m.(*Config)
+		if !ok || c.tracer == nil {
+			return f(ctx, d, m)
+		}
+		ctx, span := c.tracer.Start(ctx, name+" "+operation, trace.WithAttributes(
+			attribute.String("okta.resource.type", name),
+			attribute.String("okta.operation", operation),
+		))
+		defer span.End()
+		diags := f(ctx, d, m)
+		span.SetAttributes(attribute.String("okta.resource.id", d.Id()))
+		for _, v := range diags {
+			if v.Severity == diag.Error {
+				span.SetStatus(codes.Error, v.Summary)
+				break
+			}
+		}
+		return diags
+	}
+}
+
+// addTracing makes every operation of the resources and data sources create a span, so the API calls made by the
+// operation are grouped together.
+func addTracing(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		r.CreateContext = withTracing(name, "create", r.CreateContext)
+		r.ReadContext = withTracing(name, "read", r.ReadContext)
+		r.UpdateContext = withTracing(name, "update", r.UpdateContext)
+		r.DeleteContext = withTracing(name, "delete", r.DeleteContext)
+	}
+	for name, r := range p.DataSourcesMap {
+		r.ReadContext = withTracing(name, "read", r.ReadContext)
+	}
+}
@@ -0,0 +1,57 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+func TestTracing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit-Limit", "600")
+		w.Header().Set("X-Rate-Limit-Remaining", "599")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	c := &Config{tracer: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer(tracerName)}
+	client := &http.Client{Transport: tracingTransport(c.tracer, http.DefaultTransport)}
+	read := withTracing("okta_test", "read", func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/test", nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer resp.Body.Close()
+		return diag.Errorf("not found")
+	})
+	_ = read(context.Background(), schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, nil), c)
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, actual: %d", len(spans))
+	}
+	request, operation := spans[0], spans[1]
+	if request.Parent().SpanID() != operation.SpanContext().SpanID() {
+		t.Errorf("expected request span to be a child of the operation span")
+	}
+	if operation.Name() != "okta_test read" || operation.Status().Description != "not found" {
+		t.Errorf("unexpected operation span: %s, %v", operation.Name(), operation.Status())
+	}
+	attrs := map[string]string{}
+	for _, kv := range request.Attributes() {
+		attrs[string(kv.Key)] = kv.Value.Emit()
+	}
+	if attrs[string(semconv.HTTPStatusCodeKey)] != "404" || attrs[string(semconv.HTTPTargetKey)] != "/api/v1/test" ||
+		attrs["okta.rate_limit.remaining"] != "599" {
+		t.Errorf("unexpected request span attributes: %v", attrs)
+	}
+}
@@ -0,0 +1,105 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// unknownAttributes returns the attributes of the raw API object, which are not known to the given struct of the Okta
+// SDK, and thus are silently dropped when the object is decoded. The nested attributes are joined with dots, and the
+// values are in JSON format. Free form maps (e.g. app settings) are never reported.
+func unknownAttributes(raw map[string]interface{}, v interface{}) map[string]string {
+	res := map[string]string{}
+	collectUnknownAttributes("", raw, reflect.TypeOf(v), res)
+	return res
+}
+
+func collectUnknownAttributes(prefix string, raw map[string]interface{}, t reflect.Type, res map[string]string) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+	fields := jsonFields(t)
+	for k, val := range raw {
+		ft, ok := fields[k]
+		if !ok {
+			b, _ := json.Marshal(val)
+			res[prefix+k] = string(b)
+			continue
+		}
+		if nested, ok := val.(map[string]interface{}); ok {
+			collectUnknownAttributes(prefix+k+".", nested, ft, res)
+		}
+	}
+}
+
+// jsonFields returns the types of the JSON attributes of the struct, including the ones of the embedded structs, which
+// are shadowed by the attributes of the outer struct with the same name.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type, t.NumField())
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "" && f.Anonymous {
+			ft := f.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, ft)
+			}
+			continue
+		}
+		if name != "" && name != "-" {
+			fields[name] = f.Type
+		}
+	}
+	for _, et := range embedded {
+		for name, ft := range jsonFields(et) {
+			if _, ok := fields[name]; !ok {
+				fields[name] = ft
+			}
+		}
+	}
+	return fields
+}
+
+// fetchObjectWithUnknownAttributes gets the object at the given URL and decodes it into v. When the provider is
+// configured with 'log_unknown_attributes', the attributes unknown to the provider are logged and set to the
+// 'unmanaged_attributes' of the resource, otherwise the object is just decoded.
+func fetchObjectWithUnknownAttributes(ctx context.Context, d *schema.ResourceData, m interface{}, url string, v interface{}) error {
+	raw, resp, err := getSupplementFromMetadata(m).GetObject(ctx, url)
+	if err := suppressErrorOn404(resp, err); err != nil || raw == nil {
+		return err
+	}
+	b, _ := json.Marshal(raw)
+	err = json.Unmarshal(b, v)
+	if err != nil {
+		return err
+	}
+	unknown := unknownAttributes(raw, v)
+	if len(unknown) > 0 {
+		keys := make([]string, 0, len(unknown))
+		for k := range unknown {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		logger(m).Warn("API returned attributes unknown to the provider, they are ignored", "url", url,
+			"attributes", strings.Join(keys, ", "))
+	}
+	_ = d.Set("unmanaged_attributes", unknown)
+	return nil
+}
+
+func logUnknownAttributes(m interface{}) bool {
+	c, ok := m.(*Config)
+	return ok && c.logUnknownAttributes
+}
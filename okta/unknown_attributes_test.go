@@ -0,0 +1,62 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func TestUnknownAttributes(t *testing.T) {
+	raw := map[string]interface{}{
+		"id":    "0oa1",
+		"label": "test",
+		"newAttribute": map[string]interface{}{
+			"enabled": true,
+		},
+		"visibility": map[string]interface{}{
+			"autoSubmitToolbar": false,
+			"newVisibility":     "value",
+		},
+		"settings": map[string]interface{}{
+			"app": map[string]interface{}{
+				"customSetting": "value",
+			},
+		},
+	}
+	// settings of SAML apps are free form
+	actual := unknownAttributes(raw, okta.NewSamlApplication())
+	expected := map[string]string{
+		"newAttribute":             `{"enabled":true}`,
+		"visibility.newVisibility": `"value"`,
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected unknown attributes %v, actual: %v", expected, actual)
+	}
+	for k, v := range expected {
+		if actual[k] != v {
+			t.Errorf("expected unknown attribute %s to be %s, actual: %s", k, v, actual[k])
+		}
+	}
+	actual = unknownAttributes(raw, okta.NewBookmarkApplication())
+	if actual["settings.app.customSetting"] != `"value"` {
+		t.Errorf("expected unknown setting of bookmark app, actual: %v", actual)
+	}
+	// attributes of the embedded SDK structs are known as well
+	raw["settings"] = map[string]interface{}{
+		"signOn": map[string]interface{}{
+			"audience":    "test",
+			"inlineHooks": []interface{}{map[string]interface{}{"id": "cal1"}},
+			"newSignOn":   "value",
+		},
+	}
+	actual = unknownAttributes(raw, sdk.NewSamlApplication(nil))
+	expected = map[string]string{
+		"newAttribute":              `{"enabled":true}`,
+		"visibility.newVisibility":  `"value"`,
+		"settings.signOn.newSignOn": `"value"`,
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected unknown attributes %v, actual: %v", expected, actual)
+	}
+}
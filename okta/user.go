@@ -7,10 +7,12 @@ import (
 	"log"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 const (
@@ -219,6 +221,13 @@ func populateUserProfile(d *schema.ResourceData) *okta.UserProfile {
 			profile[k] = v
 		}
 	}
+	for k, v := range decodeCustomProfileAttributesMap(d.Get("custom_profile_attributes_map").(map[string]interface{})) {
+		profile[k] = v
+	}
+	// the ignored attributes are managed by Okta or outside of Terraform
+	for _, k := range convertInterfaceToStringSetNullable(d.Get("custom_profile_attributes_to_ignore")) {
+		delete(profile, k)
+	}
 
 	profile["firstName"] = d.Get("first_name").(string)
 	profile["lastName"] = d.Get("last_name").(string)
@@ -322,6 +331,40 @@ func setGroups(ctx context.Context, d *schema.ResourceData, c *okta.Client) erro
 	})
 }
 
+// requiredUserAttributes are always managed by Terraform, even if they are not listed in 'managed_attributes'.
+var requiredUserAttributes = []string{"email", "firstName", "lastName", "login"}
+
+// isUserAttributeManaged reports whether the profile attribute is managed by Terraform: when 'managed_attributes' is
+// set, the rest of the attributes, e.g. the ones mastered by an HR system, are neither read nor changed.
+func isUserAttributeManaged(d *schema.ResourceData, k string) bool {
+	managed := convertInterfaceToStringSetNullable(d.Get("managed_attributes"))
+	return len(managed) == 0 || contains(managed, k) || contains(requiredUserAttributes, k)
+}
+
+// suppressUnmanagedUserAttributeDiff suppresses the diff of the base profile attributes, which are not managed by
+// Terraform.
+func suppressUnmanagedUserAttributeDiff(k, _, _ string, d *schema.ResourceData) bool {
+	for _, attr := range append(convertInterfaceToStringSetNullable(d.Get("managed_attributes")), requiredUserAttributes...) {
+		if camelCaseToUnderscore(attr) == k {
+			return false
+		}
+	}
+	return !isUserAttributeManaged(d, k)
+}
+
+// unmanagedUserAttributes returns the attributes of the profiles, which are not managed by Terraform.
+func unmanagedUserAttributes(d *schema.ResourceData, profiles ...map[string]interface{}) []string {
+	var unmanaged []string
+	for _, profile := range profiles {
+		for k := range profile {
+			if !isUserAttributeManaged(d, k) && !contains(unmanaged, k) {
+				unmanaged = append(unmanaged, k)
+			}
+		}
+	}
+	return unmanaged
+}
+
 func isCustomUserAttr(key string) bool {
 	return !contains(profileKeys, key)
 }
@@ -402,7 +445,7 @@ func updateGroupsOnUser(ctx context.Context, u string, g []string, c *okta.Clien
 // handle setting of user status based on what the current status is because okta
 // only allows transitions to certain statuses from other statuses - consult okta User API docs for more info
 // https://developer.okta.com/docs/api/resources/users#lifecycle-operations
-func updateUserStatus(ctx context.Context, uid, desiredStatus string, c *okta.Client) error {
+func updateUserStatus(ctx context.Context, uid, desiredStatus string, sendEmail bool, c *okta.Client) error {
 	user, _, err := c.User.GetUser(ctx, uid)
 	if err != nil {
 		return fmt.Errorf("failed to get user: %v", err)
@@ -423,7 +466,7 @@ func updateUserStatus(ctx context.Context, uid, desiredStatus string, c *okta.Cl
 		case userStatusLockedOut:
 			_, statusErr = c.User.UnlockUser(ctx, uid)
 		default:
-			_, _, statusErr = c.User.ActivateUser(ctx, uid, nil)
+			_, _, statusErr = c.User.ActivateUser(ctx, uid, query.NewQueryParams(query.WithSendEmail(sendEmail)))
 		}
 	}
 	if statusErr != nil {
@@ -452,3 +495,82 @@ func waitForStatusTransition(ctx context.Context, u string, c *okta.Client) erro
 		}
 	}
 }
+
+// decodeCustomProfileAttributesMap decodes the JSON encoded values of 'custom_profile_attributes_map', the empty
+// values stand for null.
+func decodeCustomProfileAttributesMap(m map[string]interface{}) map[string]interface{} {
+	attrs := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		var value interface{}
+		// We validate the JSON, no need to check error
+		_ = json.Unmarshal([]byte(v.(string)), &value)
+		attrs[k] = value
+	}
+	return attrs
+}
+
+// encodeCustomProfileAttributesMap returns the custom attributes with JSON encoded values.
+func encodeCustomProfileAttributesMap(attrs map[string]interface{}) map[string]interface{} {
+	m := make(map[string]interface{}, len(attrs))
+	for k, v := range attrs {
+		b, _ := json.Marshal(v)
+		m[k] = string(b)
+	}
+	return m
+}
+
+// normalizeCustomProfileAttributes drops the null and empty values, since Okta doesn't return the attributes, which
+// are not set, and the ignored attributes.
+func normalizeCustomProfileAttributes(attrs map[string]interface{}, ignored []string) map[string]interface{} {
+	normalized := make(map[string]interface{}, len(attrs))
+	for k, v := range attrs {
+		if v == nil || v == "" || contains(ignored, k) {
+			continue
+		}
+		normalized[k] = v
+	}
+	return normalized
+}
+
+// suppressCustomProfileAttributesDiff compares the custom attributes in JSON format semantically.
+func suppressCustomProfileAttributesDiff(_, old, new string, d *schema.ResourceData) bool {
+	if new == "" {
+		return true
+	}
+	var oldAttrs, newAttrs map[string]interface{}
+	if json.Unmarshal([]byte(old), &oldAttrs) != nil || json.Unmarshal([]byte(new), &newAttrs) != nil {
+		return false
+	}
+	ignored := append(convertInterfaceToStringSetNullable(d.Get("custom_profile_attributes_to_ignore")), unmanagedUserAttributes(d, oldAttrs, newAttrs)...)
+	return reflect.DeepEqual(normalizeCustomProfileAttributes(oldAttrs, ignored), normalizeCustomProfileAttributes(newAttrs, ignored))
+}
+
+// suppressCustomProfileAttributesMapDiff compares the JSON encoded values of the custom attributes semantically.
+// The diff of the whole map, e.g. of the number of its elements, is suppressed when the maps are semantically equal.
+func suppressCustomProfileAttributesMapDiff(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("custom_profile_attributes_map")
+	oldAttrs := decodeCustomProfileAttributesMap(o.(map[string]interface{}))
+	newAttrs := decodeCustomProfileAttributesMap(n.(map[string]interface{}))
+	ignored := append(convertInterfaceToStringSetNullable(d.Get("custom_profile_attributes_to_ignore")), unmanagedUserAttributes(d, oldAttrs, newAttrs)...)
+	oldAttrs = normalizeCustomProfileAttributes(oldAttrs, ignored)
+	newAttrs = normalizeCustomProfileAttributes(newAttrs, ignored)
+	if reflect.DeepEqual(oldAttrs, newAttrs) {
+		return true
+	}
+	if strings.HasSuffix(k, ".%") {
+		return false
+	}
+	key := strings.TrimPrefix(k, "custom_profile_attributes_map.")
+	return reflect.DeepEqual(oldAttrs[key], newAttrs[key])
+}
+
+// userCustomProfileAttributes returns the custom attributes of the user's profile, except the ignored ones.
+func userCustomProfileAttributes(u *okta.User, ignored []string) map[string]interface{} {
+	attrs := make(map[string]interface{})
+	for k, v := range *u.Profile {
+		if v != nil && isCustomUserAttr(camelCaseToUnderscore(k)) && !contains(ignored, k) {
+			attrs[k] = v
+		}
+	}
+	return attrs
+}
@@ -1,6 +1,7 @@
 package okta
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,6 +10,117 @@ import (
 
 var (
 	userSchemaSchema = map[string]*schema.Schema{
+		"array_type": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: stringInSlice([]string{"string", "number", "integer", "reference", "object"}),
+			Description:      "Subschema array type: string, number, integer, reference, or object. Type field must be an array.",
+			ForceNew:         true,
+		},
+		"array_enum": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Custom Subschema enumerated value of a property of type array.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"array_one_of": {
+			Type:        schema.TypeList,
+			ForceNew:    true,
+			Optional:    true,
+			Description: "array of valid JSON schemas for property type array.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"const": {
+						Required:    true,
+						Type:        schema.TypeString,
+						Description: "Enum value",
+					},
+					"title": {
+						Required:    true,
+						Type:        schema.TypeString,
+						Description: "Enum title",
+					},
+				},
+			},
+		},
+		"array_properties": {
+			Type:        schema.TypeSet,
+			ForceNew:    true,
+			Optional:    true,
+			Description: "Nested properties of the items of type object. Array type field must be an object.",
+			Elem:        itemPropertyResource,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Custom Subschema description",
+		},
+		"min_length": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "Subschema of type string minimum length",
+			ValidateDiagFunc: intAtLeast(1),
+		},
+		"max_length": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "Subschema of type string maximum length",
+			ValidateDiagFunc: intAtLeast(1),
+		},
+		"enum": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			ForceNew:      true,
+			Description:   "Custom Subschema enumerated value of the property. see: developer.okta.com/docs/api/resources/schemas#user-profile-schema-property-object",
+			ConflictsWith: []string{"array_type"},
+			Elem:          &schema.Schema{Type: schema.TypeString},
+		},
+		"one_of": {
+			Type:          schema.TypeList,
+			ForceNew:      true,
+			Optional:      true,
+			Description:   "Custom Subschema json schemas. see: developer.okta.com/docs/api/resources/schemas#user-profile-schema-property-object",
+			ConflictsWith: []string{"array_type"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"const": {
+						Required:    true,
+						Type:        schema.TypeString,
+						Description: "Enum value",
+					},
+					"title": {
+						Required:    true,
+						Type:        schema.TypeString,
+						Description: "Enum title",
+					},
+				},
+			},
+		},
+		"external_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Subschema external name",
+			ForceNew:    true,
+		},
+		"external_namespace": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Subschema external namespace",
+			ForceNew:    true,
+		},
+		"unique": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Subschema unique restriction",
+			ValidateDiagFunc: stringInSlice([]string{"UNIQUE_VALIDATED", "NOT_UNIQUE"}),
+			ConflictsWith:    []string{"one_of", "enum", "array_type"},
+		},
+	}
+
+	// userSchemaSchemaV1 is the snapshot of the userSchemaSchema as it was in the schema version 1, before
+	// the array items of type object were supported. It is used to decode the states of the older versions.
+	userSchemaSchemaV1 = map[string]*schema.Schema{
 		"array_type": {
 			Type:             schema.TypeString,
 			Optional:         true,
@@ -110,6 +222,38 @@ var (
 		},
 	}
 
+	// itemPropertyResource is the nested property of the array items of type object
+	itemPropertyResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"index": {
+				Required:    true,
+				Type:        schema.TypeString,
+				Description: "Nested property unique string identifier",
+			},
+			"title": {
+				Required:    true,
+				Type:        schema.TypeString,
+				Description: "Nested property title (display name)",
+			},
+			"type": {
+				Required:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: stringInSlice([]string{"string", "boolean", "number", "integer"}),
+				Description:      "Nested property type: string, boolean, number, or integer",
+			},
+			"description": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "Nested property description",
+			},
+			"required": {
+				Optional:    true,
+				Type:        schema.TypeBool,
+				Description: "Whether the nested property is required",
+			},
+		},
+	}
+
 	userBaseSchemaSchema = map[string]*schema.Schema{
 		"index": {
 			Type:        schema.TypeString,
@@ -153,6 +297,25 @@ var (
 		},
 	}
 
+	masterOverridePrioritySchema = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Prioritized list of profile sources, required when the profile master is 'OVERRIDE'",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "APP",
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+
 	userPatternSchema = map[string]*schema.Schema{
 		"pattern": {
 			Type:        schema.TypeString,
@@ -176,6 +339,12 @@ func syncUserSchema(d *schema.ResourceData, subschema *sdk.UserSubSchema) error
 		_ = d.Set("array_type", subschema.Items.Type)
 		_ = d.Set("array_one_of", flattenOneOf(subschema.Items.OneOf))
 		_ = d.Set("array_enum", convertStringArrToInterface(subschema.Items.Enum))
+		err := setNonPrimitives(d, map[string]interface{}{
+			"array_properties": flattenItemProperties(subschema.Items.Properties),
+		})
+		if err != nil {
+			return err
+		}
 	}
 	return setNonPrimitives(d, map[string]interface{}{
 		"enum":   subschema.Enum,
@@ -262,18 +431,63 @@ func getNullableMaster(d *schema.ResourceData) *sdk.UserSchemaMaster {
 	return usm
 }
 
+func validateMasterOverridePriority(d *schema.ResourceData) error {
+	v, ok := d.GetOk("master")
+	if !ok || v.(string) != "OVERRIDE" {
+		return nil
+	}
+	mop, _ := d.Get("master_override_priority").([]interface{})
+	if len(mop) == 0 {
+		return errors.New("when setting profile master type to 'OVERRIDE' at least one 'master_override_priority' should be provided")
+	}
+	return nil
+}
+
 func getNullableItem(d *schema.ResourceData) *sdk.UserSchemaItem {
 	if v, ok := d.GetOk("array_type"); ok {
 		return &sdk.UserSchemaItem{
-			Type:  v.(string),
-			OneOf: getNullableOneOf(d, "array_one_of"),
-			Enum:  convertInterfaceToStringArrNullable(d.Get("array_enum")),
+			Type:       v.(string),
+			OneOf:      getNullableOneOf(d, "array_one_of"),
+			Enum:       convertInterfaceToStringArrNullable(d.Get("array_enum")),
+			Properties: getNullableItemProperties(d),
 		}
 	}
 
 	return nil
 }
 
+func getNullableItemProperties(d *schema.ResourceData) map[string]*sdk.UserSubSchema {
+	set, ok := d.GetOk("array_properties")
+	if !ok {
+		return nil
+	}
+	props := make(map[string]*sdk.UserSubSchema, set.(*schema.Set).Len())
+	for _, v := range set.(*schema.Set).List() {
+		valueMap := v.(map[string]interface{})
+		props[valueMap["index"].(string)] = &sdk.UserSubSchema{
+			Title:       valueMap["title"].(string),
+			Type:        valueMap["type"].(string),
+			Description: valueMap["description"].(string),
+			Required:    boolPtr(valueMap["required"].(bool)),
+		}
+	}
+	return props
+}
+
+func flattenItemProperties(props map[string]*sdk.UserSubSchema) *schema.Set {
+	arr := make([]interface{}, 0, len(props))
+	for index, p := range props {
+		arr = append(arr, map[string]interface{}{
+			"index":       index,
+			"title":       p.Title,
+			"type":        p.Type,
+			"description": p.Description,
+			"required":    p.Required != nil && *p.Required,
+		})
+	}
+	return schema.NewSet(schema.HashResource(itemPropertyResource), arr)
+}
+
 func flattenOneOf(oneOf []*sdk.UserSchemaEnum) []interface{} {
 	result := make([]interface{}, len(oneOf))
 	for i, v := range oneOf {
@@ -273,6 +273,7 @@ func getNullableInt(d *schema.ResourceData, key string) *int {
 // of nested resources.
 func suppressErrorOn404(resp *okta.Response, err error) error {
 	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		logSuppressedNotFound(resp)
 		return nil
 	}
 	return responseErr(resp, err)
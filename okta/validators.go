@@ -125,6 +125,24 @@ func stringIsJSON(i interface{}, k cty.Path) diag.Diagnostics {
 	return nil
 }
 
+// mapValuesAreJSON validates that every value of the map is either JSON encoded or empty, which stands for null.
+func mapValuesAreJSON(i interface{}, k cty.Path) diag.Diagnostics {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return diag.Errorf("expected type of %s to be map", k)
+	}
+	for key, v := range m {
+		s, _ := v.(string)
+		if s == "" {
+			continue
+		}
+		if _, err := structure.NormalizeJsonString(s); err != nil {
+			return diag.Errorf("value of '%s' in %q is not a valid JSON, use jsonencode() to set it: %s", key, k, err)
+		}
+	}
+	return nil
+}
+
 func stringLenBetween(min, max int) schema.SchemaValidateDiagFunc {
 	return func(i interface{}, k cty.Path) diag.Diagnostics {
 		v, ok := i.(string)
@@ -183,3 +201,16 @@ func stringIsVersion(i interface{}, k cty.Path) diag.Diagnostics {
 	}
 	return nil
 }
+
+var hexColorRegex = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func stringIsHexColor(i interface{}, k cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %s to be string", k)
+	}
+	if !hexColorRegex.MatchString(v) {
+		return diag.Errorf("%s field is not a valid hex color, e.g. #1662dd", k)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type (
+	AccessPolicyRule struct {
+		Actions     *AccessPolicyRuleActions    `json:"actions,omitempty"`
+		Conditions  *AccessPolicyRuleConditions `json:"conditions,omitempty"`
+		Created     string                      `json:"created,omitempty"`
+		ID          string                      `json:"id,omitempty"`
+		LastUpdated string                      `json:"lastUpdated,omitempty"`
+		Name        string                      `json:"name,omitempty"`
+		Priority    int64                       `json:"priority,omitempty"`
+		Status      string                      `json:"status,omitempty"`
+		System      *bool                       `json:"system,omitempty"`
+		Type        string                      `json:"type,omitempty"`
+	}
+
+	AccessPolicyRuleActions struct {
+		AppSignOn *AccessPolicyRuleAppSignOn `json:"appSignOn,omitempty"`
+	}
+
+	AccessPolicyRuleAppSignOn struct {
+		Access             string                              `json:"access,omitempty"`
+		VerificationMethod *AccessPolicyRuleVerificationMethod `json:"verificationMethod,omitempty"`
+	}
+
+	AccessPolicyRuleVerificationMethod struct {
+		Constraints      []map[string]interface{} `json:"constraints,omitempty"`
+		FactorMode       string                   `json:"factorMode,omitempty"`
+		InactivityPeriod string                   `json:"inactivityPeriod,omitempty"`
+		ReauthenticateIn string                   `json:"reauthenticateIn,omitempty"`
+		Type             string                   `json:"type,omitempty"`
+	}
+
+	AccessPolicyRuleConditions struct {
+		Device      *AccessPolicyRuleDevice            `json:"device,omitempty"`
+		ElCondition *AccessPolicyRuleElCondition       `json:"elCondition,omitempty"`
+		Network     *okta.PolicyNetworkCondition       `json:"network,omitempty"`
+		People      *okta.PolicyPeopleCondition        `json:"people,omitempty"`
+		Platform    *okta.PlatformPolicyRuleCondition  `json:"platform,omitempty"`
+		RiskScore   *okta.RiskScorePolicyRuleCondition `json:"riskScore,omitempty"`
+		UserType    *AccessPolicyRuleUserType          `json:"userType,omitempty"`
+	}
+
+	AccessPolicyRuleDevice struct {
+		Managed    *bool `json:"managed,omitempty"`
+		Registered *bool `json:"registered,omitempty"`
+	}
+
+	AccessPolicyRuleElCondition struct {
+		Condition string `json:"condition,omitempty"`
+	}
+
+	AccessPolicyRuleUserType struct {
+		Exclude []string `json:"exclude,omitempty"`
+		Include []string `json:"include,omitempty"`
+	}
+)
+
+// Creates a rule of the app sign-on policy.
+func (m *ApiSupplement) CreateAccessPolicyRule(ctx context.Context, policyID string, body AccessPolicyRule) (*AccessPolicyRule, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%s/rules", policyID)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rule AccessPolicyRule
+	resp, err := m.RequestExecutor.Do(ctx, req, &rule)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &rule, resp, nil
+}
+
+// Gets a rule of the app sign-on policy.
+func (m *ApiSupplement) GetAccessPolicyRule(ctx context.Context, policyID, ruleID string) (*AccessPolicyRule, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%s/rules/%s", policyID, ruleID)
+	req, err := m.RequestExecutor.WithAccept("application/json").NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rule AccessPolicyRule
+	resp, err := m.RequestExecutor.Do(ctx, req, &rule)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &rule, resp, nil
+}
+
+// Updates a rule of the app sign-on policy.
+func (m *ApiSupplement) UpdateAccessPolicyRule(ctx context.Context, policyID, ruleID string, body AccessPolicyRule) (*AccessPolicyRule, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%s/rules/%s", policyID, ruleID)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rule AccessPolicyRule
+	resp, err := m.RequestExecutor.Do(ctx, req, &rule)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &rule, resp, nil
+}
+
+// Assigns the app sign-on policy to the application.
+func (m *ApiSupplement) SetAppAccessPolicy(ctx context.Context, appID, policyID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/policies/%s", appID, policyID)
+	req, err := m.RequestExecutor.NewRequest("PUT", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// ApiToken is the SSWS API token, the 'current' ID refers to the token of the request.
+type ApiToken struct {
+	Id          string                       `json:"id,omitempty"`
+	Name        string                       `json:"name,omitempty"`
+	UserId      string                       `json:"userId,omitempty"`
+	ClientName  string                       `json:"clientName,omitempty"`
+	TokenWindow string                       `json:"tokenWindow,omitempty"`
+	Network     *okta.PolicyNetworkCondition `json:"network,omitempty"`
+}
+
+func (m *ApiSupplement) GetApiToken(ctx context.Context, id string) (*ApiToken, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/api-tokens/%s", id)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var token ApiToken
+	resp, err := m.RequestExecutor.Do(ctx, req, &token)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &token, resp, nil
+}
+
+func (m *ApiSupplement) UpdateApiToken(ctx context.Context, id string, body ApiToken) (*ApiToken, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/api-tokens/%s", id)
+	req, err := m.RequestExecutor.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var token ApiToken
+	resp, err := m.RequestExecutor.Do(ctx, req, &token)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &token, resp, nil
+}
@@ -12,6 +12,11 @@ import (
 )
 
 func (m *ApiSupplement) UploadAppLogo(ctx context.Context, appID, filename string) (*okta.Response, error) {
+	return m.uploadFile(ctx, fmt.Sprintf("/api/v1/apps/%s/logo", appID), filename, nil)
+}
+
+// uploadFile sends the file as the 'file' field of the multipart form, and decodes the response into v, if it's set.
+func (m *ApiSupplement) uploadFile(ctx context.Context, url, filename string, v interface{}) (*okta.Response, error) {
 	file, err := os.Open(filename)
 	if err != nil {
 		return nil, err
@@ -28,10 +33,9 @@ func (m *ApiSupplement) UploadAppLogo(ctx context.Context, appID, filename strin
 		return nil, err
 	}
 	_ = writer.Close()
-	url := fmt.Sprintf("/api/v1/apps/%s/logo", appID)
 	req, err := m.RequestExecutor.WithContentType(writer.FormDataContentType()).NewRequest("POST", url, body)
 	if err != nil {
 		return nil, err
 	}
-	return m.RequestExecutor.Do(ctx, req, nil)
+	return m.RequestExecutor.Do(ctx, req, v)
 }
@@ -0,0 +1,75 @@
+package sdk
+
+import "github.com/okta/okta-sdk-golang/v2/okta"
+
+// OpenIdConnectApplication extends okta.OpenIdConnectApplication with the wildcard redirect of the OAuth client, which
+// is not supported by the official SDK. The wrapped structs are shared, so the changes are visible in both of them.
+type OpenIdConnectApplication struct {
+	*okta.OpenIdConnectApplication
+	Settings *OpenIdConnectApplicationSettings `json:"settings,omitempty"`
+}
+
+type OpenIdConnectApplicationSettings struct {
+	*okta.OpenIdConnectApplicationSettings
+	OauthClient *OpenIdConnectApplicationSettingsClient `json:"oauthClient,omitempty"`
+}
+
+type OpenIdConnectApplicationSettingsClient struct {
+	*okta.OpenIdConnectApplicationSettingsClient
+	WildcardRedirect string `json:"wildcard_redirect,omitempty"`
+}
+
+func NewOpenIdConnectApplication(app *okta.OpenIdConnectApplication) *OpenIdConnectApplication {
+	if app == nil {
+		app = okta.NewOpenIdConnectApplication()
+	}
+	a := &OpenIdConnectApplication{OpenIdConnectApplication: app}
+	if app.Settings != nil {
+		a.Settings = &OpenIdConnectApplicationSettings{OpenIdConnectApplicationSettings: app.Settings}
+		if app.Settings.OauthClient != nil {
+			a.Settings.OauthClient = &OpenIdConnectApplicationSettingsClient{OpenIdConnectApplicationSettingsClient: app.Settings.OauthClient}
+		}
+	}
+	return a
+}
+
+// Unwrap returns the okta.OpenIdConnectApplication with the settings, which were decoded into the
+// OpenIdConnectApplication.
+func (a *OpenIdConnectApplication) Unwrap() *okta.OpenIdConnectApplication {
+	if a.OpenIdConnectApplication == nil {
+		a.OpenIdConnectApplication = okta.NewOpenIdConnectApplication()
+	}
+	a.OpenIdConnectApplication.Settings = nil
+	if a.Settings != nil {
+		if a.Settings.OpenIdConnectApplicationSettings == nil {
+			a.Settings.OpenIdConnectApplicationSettings = okta.NewOpenIdConnectApplicationSettings()
+		}
+		a.OpenIdConnectApplication.Settings = a.Settings.OpenIdConnectApplicationSettings
+		a.Settings.OpenIdConnectApplicationSettings.OauthClient = nil
+		if a.Settings.OauthClient != nil && a.Settings.OauthClient.OpenIdConnectApplicationSettingsClient != nil {
+			a.Settings.OpenIdConnectApplicationSettings.OauthClient = a.Settings.OauthClient.OpenIdConnectApplicationSettingsClient
+		}
+	}
+	return a.OpenIdConnectApplication
+}
+
+// WildcardRedirect returns the wildcard redirect mode of the OAuth client: DISABLED or SUBDOMAIN.
+func (a *OpenIdConnectApplication) WildcardRedirect() string {
+	if a.Settings == nil || a.Settings.OauthClient == nil {
+		return ""
+	}
+	return a.Settings.OauthClient.WildcardRedirect
+}
+
+// SetWildcardRedirect sets the wildcard redirect mode of the OAuth client.
+func (a *OpenIdConnectApplication) SetWildcardRedirect(mode string) {
+	if a.Settings == nil {
+		a.Settings = &OpenIdConnectApplicationSettings{OpenIdConnectApplicationSettings: okta.NewOpenIdConnectApplicationSettings()}
+		a.OpenIdConnectApplication.Settings = a.Settings.OpenIdConnectApplicationSettings
+	}
+	if a.Settings.OauthClient == nil {
+		a.Settings.OauthClient = &OpenIdConnectApplicationSettingsClient{OpenIdConnectApplicationSettingsClient: okta.NewOpenIdConnectApplicationSettingsClient()}
+		a.Settings.OpenIdConnectApplicationSettings.OauthClient = a.Settings.OauthClient.OpenIdConnectApplicationSettingsClient
+	}
+	a.Settings.OauthClient.WildcardRedirect = mode
+}
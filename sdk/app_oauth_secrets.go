@@ -0,0 +1,91 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type ClientSecret struct {
+	Id           string     `json:"id,omitempty"`
+	Status       string     `json:"status,omitempty"`
+	ClientSecret string     `json:"client_secret,omitempty"`
+	SecretHash   string     `json:"secret_hash,omitempty"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastUpdated  *time.Time `json:"lastUpdated,omitempty"`
+}
+
+type ClientSecretMetadata struct {
+	ClientSecret string `json:"client_secret,omitempty"`
+}
+
+// ListClientSecretsForApplication lists all client secrets of the OAuth application
+func (m *ApiSupplement) ListClientSecretsForApplication(ctx context.Context, appID string) ([]*ClientSecret, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/credentials/secrets", appID)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var secrets []*ClientSecret
+	resp, err := m.RequestExecutor.Do(ctx, req, &secrets)
+	if err != nil {
+		return nil, resp, err
+	}
+	return secrets, resp, nil
+}
+
+// CreateNewClientSecretForApplication generates a new client secret for the OAuth application. If body is nil, the
+// secret value is generated by Okta.
+func (m *ApiSupplement) CreateNewClientSecretForApplication(ctx context.Context, appID string, body *ClientSecretMetadata) (*ClientSecret, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/credentials/secrets", appID)
+	if body == nil {
+		body = &ClientSecretMetadata{}
+	}
+	req, err := m.RequestExecutor.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var secret ClientSecret
+	resp, err := m.RequestExecutor.Do(ctx, req, &secret)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &secret, resp, nil
+}
+
+// ActivateClientSecretForApplication activates the client secret of the OAuth application
+func (m *ApiSupplement) ActivateClientSecretForApplication(ctx context.Context, appID, secretID string) (*ClientSecret, *okta.Response, error) {
+	return m.clientSecretLifecycle(ctx, appID, secretID, "activate")
+}
+
+// DeactivateClientSecretForApplication deactivates the client secret of the OAuth application
+func (m *ApiSupplement) DeactivateClientSecretForApplication(ctx context.Context, appID, secretID string) (*ClientSecret, *okta.Response, error) {
+	return m.clientSecretLifecycle(ctx, appID, secretID, "deactivate")
+}
+
+// DeleteClientSecretForApplication removes the client secret from the OAuth application. Only inactive secrets can
+// be removed.
+func (m *ApiSupplement) DeleteClientSecretForApplication(ctx context.Context, appID, secretID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/credentials/secrets/%s", appID, secretID)
+	req, err := m.RequestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+func (m *ApiSupplement) clientSecretLifecycle(ctx context.Context, appID, secretID, action string) (*ClientSecret, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/credentials/secrets/%s/lifecycle/%s", appID, secretID, action)
+	req, err := m.RequestExecutor.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var secret ClientSecret
+	resp, err := m.RequestExecutor.Do(ctx, req, &secret)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &secret, resp, nil
+}
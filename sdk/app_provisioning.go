@@ -0,0 +1,144 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// AppConnection is the provisioning connection of the application to the downstream system
+type AppConnection struct {
+	AuthScheme string                `json:"authScheme,omitempty"`
+	BaseUrl    string                `json:"baseUrl,omitempty"`
+	Profile    *AppConnectionProfile `json:"profile,omitempty"`
+	Status     string                `json:"status,omitempty"`
+}
+
+type AppConnectionProfile struct {
+	AuthScheme string `json:"authScheme,omitempty"`
+	Token      string `json:"token,omitempty"`
+}
+
+// AppFeature is the provisioning feature of the application, e.g. USER_PROVISIONING
+type AppFeature struct {
+	Name         string                  `json:"name,omitempty"`
+	Status       string                  `json:"status,omitempty"`
+	Description  string                  `json:"description,omitempty"`
+	Capabilities *AppFeatureCapabilities `json:"capabilities,omitempty"`
+}
+
+type AppFeatureCapabilities struct {
+	Create *AppFeatureCreate `json:"create,omitempty"`
+	Update *AppFeatureUpdate `json:"update,omitempty"`
+}
+
+type AppFeatureCreate struct {
+	LifecycleCreate *AppFeatureStatus `json:"lifecycleCreate,omitempty"`
+}
+
+type AppFeatureUpdate struct {
+	LifecycleDeactivate *AppFeatureStatus   `json:"lifecycleDeactivate,omitempty"`
+	Profile             *AppFeatureStatus   `json:"profile,omitempty"`
+	Password            *AppFeaturePassword `json:"password,omitempty"`
+}
+
+type AppFeatureStatus struct {
+	Status string `json:"status,omitempty"`
+}
+
+type AppFeaturePassword struct {
+	Status string `json:"status,omitempty"`
+	Seed   string `json:"seed,omitempty"`
+	Change string `json:"change,omitempty"`
+}
+
+const (
+	AppConnectionAuthSchemeToken = "TOKEN"
+	AppFeatureUserProvisioning   = "USER_PROVISIONING"
+	AppFeatureStatusEnabled      = "ENABLED"
+	AppFeatureStatusDisabled     = "DISABLED"
+)
+
+// GetDefaultAppConnection gets the default provisioning connection of the application
+func (m *ApiSupplement) GetDefaultAppConnection(ctx context.Context, appID string) (*AppConnection, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/connections/default", appID)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var connection AppConnection
+	resp, err := m.RequestExecutor.Do(ctx, req, &connection)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &connection, resp, nil
+}
+
+// SetDefaultAppConnection sets the default provisioning connection of the application
+func (m *ApiSupplement) SetDefaultAppConnection(ctx context.Context, appID string, body AppConnection, qp *query.Params) (*AppConnection, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/connections/default", appID)
+	if qp != nil {
+		url += qp.String()
+	}
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var connection AppConnection
+	resp, err := m.RequestExecutor.Do(ctx, req, &connection)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &connection, resp, nil
+}
+
+// ActivateDefaultAppConnection activates the default provisioning connection of the application
+func (m *ApiSupplement) ActivateDefaultAppConnection(ctx context.Context, appID string) (*okta.Response, error) {
+	return m.defaultAppConnectionLifecycle(ctx, appID, "activate")
+}
+
+// DeactivateDefaultAppConnection deactivates the default provisioning connection of the application
+func (m *ApiSupplement) DeactivateDefaultAppConnection(ctx context.Context, appID string) (*okta.Response, error) {
+	return m.defaultAppConnectionLifecycle(ctx, appID, "deactivate")
+}
+
+func (m *ApiSupplement) defaultAppConnectionLifecycle(ctx context.Context, appID, action string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/connections/default/lifecycle/%s", appID, action)
+	req, err := m.RequestExecutor.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// GetAppFeature gets the provisioning feature of the application
+func (m *ApiSupplement) GetAppFeature(ctx context.Context, appID, name string) (*AppFeature, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/features/%s", appID, name)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var feature AppFeature
+	resp, err := m.RequestExecutor.Do(ctx, req, &feature)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &feature, resp, nil
+}
+
+// UpdateAppFeature updates the capabilities of the provisioning feature of the application
+func (m *ApiSupplement) UpdateAppFeature(ctx context.Context, appID, name string, body AppFeatureCapabilities) (*AppFeature, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/features/%s", appID, name)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var feature AppFeature
+	resp, err := m.RequestExecutor.Do(ctx, req, &feature)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &feature, resp, nil
+}
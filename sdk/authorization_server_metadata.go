@@ -0,0 +1,45 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// AuthorizationServerMetadata is the OpenID Connect discovery document of the authorization server.
+type AuthorizationServerMetadata struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	RegistrationEndpoint              string   `json:"registration_endpoint"`
+	JwksURI                           string   `json:"jwks_uri"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	EndSessionEndpoint                string   `json:"end_session_endpoint"`
+	DeviceAuthorizationEndpoint       string   `json:"device_authorization_endpoint"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	IdTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+}
+
+// GetAuthorizationServerMetadata gets the '.well-known/openid-configuration' document of the authorization server.
+func (m *ApiSupplement) GetAuthorizationServerMetadata(ctx context.Context, authServerID string) (*AuthorizationServerMetadata, *okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/%s/.well-known/openid-configuration", authServerID)
+	req, err := m.RequestExecutor.WithAccept("application/json").NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var metadata AuthorizationServerMetadata
+	resp, err := m.RequestExecutor.Do(ctx, req, &metadata)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &metadata, resp, nil
+}
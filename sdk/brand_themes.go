@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// Theme files, which can be uploaded to the brand's theme.
+const (
+	ThemeLogo            = "logo"
+	ThemeFavicon         = "favicon"
+	ThemeBackgroundImage = "background-image"
+)
+
+type Theme struct {
+	Id                                string `json:"id,omitempty"`
+	Logo                              string `json:"logo,omitempty"`
+	Favicon                           string `json:"favicon,omitempty"`
+	BackgroundImage                   string `json:"backgroundImage,omitempty"`
+	PrimaryColorHex                   string `json:"primaryColorHex,omitempty"`
+	PrimaryColorContrastHex           string `json:"primaryColorContrastHex,omitempty"`
+	SecondaryColorHex                 string `json:"secondaryColorHex,omitempty"`
+	SecondaryColorContrastHex         string `json:"secondaryColorContrastHex,omitempty"`
+	SignInPageTouchPointVariant       string `json:"signInPageTouchPointVariant,omitempty"`
+	EndUserDashboardTouchPointVariant string `json:"endUserDashboardTouchPointVariant,omitempty"`
+	ErrorPageTouchPointVariant        string `json:"errorPageTouchPointVariant,omitempty"`
+	EmailTemplateTouchPointVariant    string `json:"emailTemplateTouchPointVariant,omitempty"`
+}
+
+func (m *ApiSupplement) ListBrandThemes(ctx context.Context, brandID string) ([]*Theme, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s/themes", brandID)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var themes []*Theme
+	resp, err := m.RequestExecutor.Do(ctx, req, &themes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return themes, resp, nil
+}
+
+func (m *ApiSupplement) GetBrandTheme(ctx context.Context, brandID, themeID string) (*Theme, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s/themes/%s", brandID, themeID)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var theme Theme
+	resp, err := m.RequestExecutor.Do(ctx, req, &theme)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &theme, resp, nil
+}
+
+// UpdateBrandTheme updates the colors and the variants of the theme, the files are uploaded separately.
+func (m *ApiSupplement) UpdateBrandTheme(ctx context.Context, brandID, themeID string, body Theme) (*Theme, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s/themes/%s", brandID, themeID)
+	body.Id, body.Logo, body.Favicon, body.BackgroundImage = "", "", "", ""
+	req, err := m.RequestExecutor.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var theme Theme
+	resp, err := m.RequestExecutor.Do(ctx, req, &theme)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &theme, resp, nil
+}
+
+// UploadBrandThemeFile uploads the file of the theme: ThemeLogo, ThemeFavicon or ThemeBackgroundImage.
+func (m *ApiSupplement) UploadBrandThemeFile(ctx context.Context, brandID, themeID, kind, filename string) (*okta.Response, error) {
+	return m.uploadFile(ctx, fmt.Sprintf("/api/v1/brands/%s/themes/%s/%s", brandID, themeID, kind), filename, nil)
+}
+
+// DeleteBrandThemeFile replaces the file of the theme with the Okta default one.
+func (m *ApiSupplement) DeleteBrandThemeFile(ctx context.Context, brandID, themeID, kind string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s/themes/%s/%s", brandID, themeID, kind)
+	req, err := m.RequestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
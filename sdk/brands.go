@@ -0,0 +1,73 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type Brand struct {
+	Id                         string `json:"id,omitempty"`
+	AgreeToCustomPrivacyPolicy bool   `json:"agreeToCustomPrivacyPolicy,omitempty"`
+	CustomPrivacyPolicyUrl     string `json:"customPrivacyPolicyUrl,omitempty"`
+	RemovePoweredByOkta        bool   `json:"removePoweredByOkta"`
+	IsDefault                  bool   `json:"isDefault,omitempty"`
+}
+
+func (m *ApiSupplement) ListBrands(ctx context.Context) ([]*Brand, *okta.Response, error) {
+	url := "/api/v1/brands"
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var brands []*Brand
+	resp, err := m.RequestExecutor.Do(ctx, req, &brands)
+	if err != nil {
+		return nil, resp, err
+	}
+	return brands, resp, nil
+}
+
+func (m *ApiSupplement) UpdateBrand(ctx context.Context, id string, body Brand) (*Brand, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s", id)
+	req, err := m.RequestExecutor.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var brand Brand
+	resp, err := m.RequestExecutor.Do(ctx, req, &brand)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &brand, resp, nil
+}
+
+func (m *ApiSupplement) GetBrand(ctx context.Context, id string) (*Brand, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s", id)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var brand Brand
+	resp, err := m.RequestExecutor.Do(ctx, req, &brand)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &brand, resp, nil
+}
+
+// ListBrandDomains lists custom domains associated with the brand
+func (m *ApiSupplement) ListBrandDomains(ctx context.Context, id string) ([]*Domain, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s/domains", id)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var domains []*Domain
+	resp, err := m.RequestExecutor.Do(ctx, req, &domains)
+	if err != nil {
+		return nil, resp, err
+	}
+	return domains, resp, nil
+}
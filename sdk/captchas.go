@@ -0,0 +1,129 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type (
+	// Captcha is the hCaptcha or reCAPTCHA v2 instance, the secret key is never returned by the API.
+	Captcha struct {
+		Id        string `json:"id,omitempty"`
+		Name      string `json:"name,omitempty"`
+		SecretKey string `json:"secretKey,omitempty"`
+		SiteKey   string `json:"siteKey,omitempty"`
+		Type      string `json:"type,omitempty"`
+	}
+
+	// OrgCaptchaSettings binds the CAPTCHA instance to the pages of the org: 'SSR' (self-service registration),
+	// 'SIGN_IN' and 'CHANGE_PASSWORD' (password recovery).
+	OrgCaptchaSettings struct {
+		CaptchaId    *string  `json:"captchaId"`
+		EnabledPages []string `json:"enabledPages"`
+	}
+)
+
+func (m *ApiSupplement) CreateCaptcha(ctx context.Context, body Captcha) (*Captcha, *okta.Response, error) {
+	url := "/api/v1/captchas"
+	req, err := m.RequestExecutor.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var captcha Captcha
+	resp, err := m.RequestExecutor.Do(ctx, req, &captcha)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &captcha, resp, nil
+}
+
+func (m *ApiSupplement) GetCaptcha(ctx context.Context, id string) (*Captcha, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/captchas/%s", id)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var captcha Captcha
+	resp, err := m.RequestExecutor.Do(ctx, req, &captcha)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &captcha, resp, nil
+}
+
+func (m *ApiSupplement) ListCaptchas(ctx context.Context) ([]*Captcha, *okta.Response, error) {
+	url := "/api/v1/captchas"
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var captchas []*Captcha
+	resp, err := m.RequestExecutor.Do(ctx, req, &captchas)
+	if err != nil {
+		return nil, resp, err
+	}
+	return captchas, resp, nil
+}
+
+func (m *ApiSupplement) UpdateCaptcha(ctx context.Context, id string, body Captcha) (*Captcha, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/captchas/%s", id)
+	req, err := m.RequestExecutor.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var captcha Captcha
+	resp, err := m.RequestExecutor.Do(ctx, req, &captcha)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &captcha, resp, nil
+}
+
+func (m *ApiSupplement) DeleteCaptcha(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/captchas/%s", id)
+	req, err := m.RequestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+func (m *ApiSupplement) GetOrgCaptchaSettings(ctx context.Context) (*OrgCaptchaSettings, *okta.Response, error) {
+	url := "/api/v1/org/captcha"
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var settings OrgCaptchaSettings
+	resp, err := m.RequestExecutor.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &settings, resp, nil
+}
+
+func (m *ApiSupplement) UpdateOrgCaptchaSettings(ctx context.Context, body OrgCaptchaSettings) (*OrgCaptchaSettings, *okta.Response, error) {
+	url := "/api/v1/org/captcha"
+	req, err := m.RequestExecutor.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var settings OrgCaptchaSettings
+	resp, err := m.RequestExecutor.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &settings, resp, nil
+}
+
+// DeleteOrgCaptchaSettings unbinds the CAPTCHA instance from the org.
+func (m *ApiSupplement) DeleteOrgCaptchaSettings(ctx context.Context) (*okta.Response, error) {
+	url := "/api/v1/org/captcha"
+	req, err := m.RequestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
@@ -0,0 +1,105 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type (
+	Domain struct {
+		Id                    string                     `json:"id,omitempty"`
+		Domain                string                     `json:"domain,omitempty"`
+		BrandId               string                     `json:"brandId,omitempty"`
+		CertificateSourceType string                     `json:"certificateSourceType,omitempty"`
+		ValidationStatus      string                     `json:"validationStatus,omitempty"`
+		DnsRecords            []*DNSRecord               `json:"dnsRecords,omitempty"`
+		PublicCertificate     *DomainCertificateMetadata `json:"publicCertificate,omitempty"`
+	}
+
+	// DomainCertificate is the user-managed certificate of the custom domain, which is used when the certificate source
+	// type of the domain is 'MANUAL'. Only the metadata of the certificate is returned by the API.
+	DomainCertificate struct {
+		Certificate      string `json:"certificate"`
+		CertificateChain string `json:"certificateChain"`
+		PrivateKey       string `json:"privateKey"`
+		Type             string `json:"type"`
+	}
+
+	DomainCertificateMetadata struct {
+		Expiration  string `json:"expiration,omitempty"`
+		Fingerprint string `json:"fingerprint,omitempty"`
+		Subject     string `json:"subject,omitempty"`
+	}
+
+	DNSRecord struct {
+		Expiration *time.Time `json:"expiration,omitempty"`
+		Fqdn       string     `json:"fqdn,omitempty"`
+		RecordType string     `json:"recordType,omitempty"`
+		Values     []string   `json:"values,omitempty"`
+	}
+)
+
+func (m *ApiSupplement) CreateDomain(ctx context.Context, body Domain) (*Domain, *okta.Response, error) {
+	url := "/api/v1/domains"
+	req, err := m.RequestExecutor.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var domain Domain
+	resp, err := m.RequestExecutor.Do(ctx, req, &domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &domain, resp, nil
+}
+
+func (m *ApiSupplement) GetDomain(ctx context.Context, id string) (*Domain, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/domains/%s", id)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var domain Domain
+	resp, err := m.RequestExecutor.Do(ctx, req, &domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &domain, resp, nil
+}
+
+// UpdateDomain replaces the brand the custom domain is associated with
+func (m *ApiSupplement) UpdateDomain(ctx context.Context, id, brandID string) (*Domain, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/domains/%s", id)
+	req, err := m.RequestExecutor.NewRequest("PUT", url, Domain{BrandId: brandID})
+	if err != nil {
+		return nil, nil, err
+	}
+	var domain Domain
+	resp, err := m.RequestExecutor.Do(ctx, req, &domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &domain, resp, nil
+}
+
+// CreateDomainCertificate uploads the user-managed certificate of the custom domain, the previous certificate is replaced
+func (m *ApiSupplement) CreateDomainCertificate(ctx context.Context, id string, body DomainCertificate) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/domains/%s/certificate", id)
+	req, err := m.RequestExecutor.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+func (m *ApiSupplement) DeleteDomain(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/domains/%s", id)
+	req, err := m.RequestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
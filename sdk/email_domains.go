@@ -0,0 +1,95 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type (
+	EmailDomain struct {
+		Id                   string                  `json:"id,omitempty"`
+		BrandId              string                  `json:"brandId,omitempty"`
+		Domain               string                  `json:"domain,omitempty"`
+		DisplayName          string                  `json:"displayName,omitempty"`
+		UserName             string                  `json:"userName,omitempty"`
+		ValidationStatus     string                  `json:"validationStatus,omitempty"`
+		DnsValidationRecords []*EmailDomainDNSRecord `json:"dnsValidationRecords,omitempty"`
+	}
+
+	EmailDomainDNSRecord struct {
+		Expiration        string `json:"expiration,omitempty"`
+		Fqdn              string `json:"fqdn,omitempty"`
+		RecordType        string `json:"recordType,omitempty"`
+		VerificationValue string `json:"verificationValue,omitempty"`
+	}
+)
+
+func (m *ApiSupplement) CreateEmailDomain(ctx context.Context, body EmailDomain) (*EmailDomain, *okta.Response, error) {
+	url := "/api/v1/email-domains"
+	req, err := m.RequestExecutor.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var domain EmailDomain
+	resp, err := m.RequestExecutor.Do(ctx, req, &domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &domain, resp, nil
+}
+
+func (m *ApiSupplement) GetEmailDomain(ctx context.Context, id string) (*EmailDomain, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-domains/%s", id)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var domain EmailDomain
+	resp, err := m.RequestExecutor.Do(ctx, req, &domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &domain, resp, nil
+}
+
+// UpdateEmailDomain replaces the display name and the user name of the sender, only these fields can be changed
+func (m *ApiSupplement) UpdateEmailDomain(ctx context.Context, id, displayName, userName string) (*EmailDomain, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-domains/%s", id)
+	body := EmailDomain{DisplayName: displayName, UserName: userName}
+	req, err := m.RequestExecutor.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var domain EmailDomain
+	resp, err := m.RequestExecutor.Do(ctx, req, &domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &domain, resp, nil
+}
+
+// VerifyEmailDomain verifies the email domain, the DNS records returned on creation should be added to the DNS zone first
+func (m *ApiSupplement) VerifyEmailDomain(ctx context.Context, id string) (*EmailDomain, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-domains/%s/verify", id)
+	req, err := m.RequestExecutor.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var domain EmailDomain
+	resp, err := m.RequestExecutor.Do(ctx, req, &domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &domain, resp, nil
+}
+
+func (m *ApiSupplement) DeleteEmailDomain(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-domains/%s", id)
+	req, err := m.RequestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
@@ -0,0 +1,83 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type (
+	EmailSender struct {
+		Id          string                  `json:"id,omitempty"`
+		FromName    string                  `json:"fromName,omitempty"`
+		FromAddress string                  `json:"fromAddress,omitempty"`
+		Subdomain   string                  `json:"subdomain,omitempty"`
+		Status      string                  `json:"status,omitempty"`
+		DnsRecords  []*EmailSenderDNSRecord `json:"dnsRecords,omitempty"`
+	}
+
+	EmailSenderDNSRecord struct {
+		Fqdn       string `json:"fqdn,omitempty"`
+		RecordType string `json:"recordType,omitempty"`
+		Value      string `json:"value,omitempty"`
+	}
+
+	DisableEmailSenders struct {
+		SenderIds []string `json:"senderIds"`
+	}
+)
+
+// CreateEmailSender registers the custom email sender of the organization, it stays unverified until the DNS records
+// returned on creation are added to the DNS zone and the sender is verified
+func (m *ApiSupplement) CreateEmailSender(ctx context.Context, body EmailSender) (*EmailSender, *okta.Response, error) {
+	url := "/api/v1/org/email/sender"
+	req, err := m.RequestExecutor.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var sender EmailSender
+	resp, err := m.RequestExecutor.Do(ctx, req, &sender)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &sender, resp, nil
+}
+
+func (m *ApiSupplement) GetEmailSender(ctx context.Context, id string) (*EmailSender, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/org/email/sender/%s", id)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var sender EmailSender
+	resp, err := m.RequestExecutor.Do(ctx, req, &sender)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &sender, resp, nil
+}
+
+func (m *ApiSupplement) VerifyEmailSender(ctx context.Context, id string) (*EmailSender, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/org/email/sender/%s/verify", id)
+	req, err := m.RequestExecutor.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var sender EmailSender
+	resp, err := m.RequestExecutor.Do(ctx, req, &sender)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &sender, resp, nil
+}
+
+// DisableVerifiedEmailSender makes Okta send the emails from the default address again
+func (m *ApiSupplement) DisableVerifiedEmailSender(ctx context.Context, body DisableEmailSenders) (*okta.Response, error) {
+	url := "/api/v1/org/email/sender/disable"
+	req, err := m.RequestExecutor.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
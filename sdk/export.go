@@ -0,0 +1,48 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// ListObjects lists the objects at the given URL as generic maps following all the pages, so no attribute is lost
+// in the conversion to the typed structs of the Okta SDK
+func (m *ApiSupplement) ListObjects(ctx context.Context, url string, qp *query.Params) ([]map[string]interface{}, *okta.Response, error) {
+	if qp != nil {
+		url += qp.String()
+	}
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var objects []map[string]interface{}
+	resp, err := m.RequestExecutor.Do(ctx, req, &objects)
+	if err != nil {
+		return nil, resp, err
+	}
+	for resp.HasNextPage() {
+		var nextObjects []map[string]interface{}
+		resp, err = resp.Next(ctx, &nextObjects)
+		if err != nil {
+			return nil, resp, err
+		}
+		objects = append(objects, nextObjects...)
+	}
+	return objects, resp, nil
+}
+
+// GetObject gets the object at the given URL as generic map, so the attributes unknown to the Okta SDK are kept
+func (m *ApiSupplement) GetObject(ctx context.Context, url string) (map[string]interface{}, *okta.Response, error) {
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var object map[string]interface{}
+	resp, err := m.RequestExecutor.Do(ctx, req, &object)
+	if err != nil {
+		return nil, resp, err
+	}
+	return object, resp, nil
+}
@@ -33,6 +33,22 @@ const (
 	HotpFactor         = "hotp"
 )
 
+// Authenticators, which are available in the MFA enrollment policies of Okta Identity Engine orgs
+const (
+	CustomOtpAuthenticator        = "custom_otp"
+	DuoAuthenticator              = "duo"
+	GoogleOtpAuthenticator        = "google_otp"
+	OktaEmailAuthenticator        = "okta_email"
+	OktaPasswordAuthenticator     = "okta_password"
+	OktaVerifyAuthenticator       = "okta_verify"
+	PhoneNumberAuthenticator      = "phone_number"
+	RsaTokenAuthenticator         = "rsa_token"
+	SecurityQuestionAuthenticator = "security_question"
+	SymantecVipAuthenticator      = "symantec_vip"
+	WebauthnAuthenticator         = "webauthn"
+	YubikeyTokenAuthenticator     = "yubikey_token"
+)
+
 // GetFactor gets a factor by ID.
 func (m *ApiSupplement) GetFactor(ctx context.Context, id string) (*Factor, *okta.Response, error) {
 	url := fmt.Sprintf("/api/v1/org/factors/%s", id)
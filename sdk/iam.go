@@ -0,0 +1,366 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type CustomRole struct {
+	Id          string   `json:"id,omitempty"`
+	Label       string   `json:"label"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+type CustomRolePermission struct {
+	Label string `json:"label"`
+}
+
+type ResourceSet struct {
+	Id          string   `json:"id,omitempty"`
+	Label       string   `json:"label"`
+	Description string   `json:"description"`
+	Resources   []string `json:"resources,omitempty"`
+}
+
+// IAMObject is either a resource of the resource set, or a member of the custom role assignment. It's identified by the
+// URL of the object in Okta, e.g. 'https://example.okta.com/api/v1/groups/00g1234', which is available in the links.
+type IAMObject struct {
+	Id    string    `json:"id"`
+	Links *IAMLinks `json:"_links,omitempty"`
+}
+
+type IAMLinks struct {
+	Self *IAMLink `json:"self,omitempty"`
+	Next *IAMLink `json:"next,omitempty"`
+}
+
+type IAMLink struct {
+	Href string `json:"href"`
+}
+
+// Href returns the URL of the object in Okta.
+func (o *IAMObject) Href() string {
+	if o.Links == nil || o.Links.Self == nil {
+		return ""
+	}
+	return o.Links.Self.Href
+}
+
+type ResourceSetBinding struct {
+	Role    string   `json:"role"`
+	Members []string `json:"members,omitempty"`
+}
+
+type IAMAdditions struct {
+	Additions []string `json:"additions"`
+}
+
+// Creates a custom admin role with the permissions.
+func (m *ApiSupplement) CreateCustomRole(ctx context.Context, body CustomRole) (*CustomRole, *okta.Response, error) {
+	url := "/api/v1/iam/roles"
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var role CustomRole
+	resp, err := m.RequestExecutor.Do(ctx, req, &role)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &role, resp, nil
+}
+
+// Gets a custom admin role.
+func (m *ApiSupplement) GetCustomRole(ctx context.Context, roleID string) (*CustomRole, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/roles/%s", roleID)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var role CustomRole
+	resp, err := m.RequestExecutor.Do(ctx, req, &role)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &role, resp, nil
+}
+
+// Updates the label and the description of a custom admin role, the permissions are managed separately.
+func (m *ApiSupplement) UpdateCustomRole(ctx context.Context, roleID string, body CustomRole) (*CustomRole, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/roles/%s", roleID)
+	body.Permissions = nil
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var role CustomRole
+	resp, err := m.RequestExecutor.Do(ctx, req, &role)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &role, resp, nil
+}
+
+// Deletes a custom admin role.
+func (m *ApiSupplement) DeleteCustomRole(ctx context.Context, roleID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/roles/%s", roleID)
+	req, err := m.RequestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// Lists all the custom admin roles.
+func (m *ApiSupplement) ListCustomRoles(ctx context.Context) ([]*CustomRole, *okta.Response, error) {
+	var roles []*CustomRole
+	resp, err := m.listIAMPages(ctx, "/api/v1/iam/roles", "roles", func(raw json.RawMessage) error {
+		var pageRoles []*CustomRole
+		if err := json.Unmarshal(raw, &pageRoles); err != nil {
+			return err
+		}
+		roles = append(roles, pageRoles...)
+		return nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	return roles, resp, nil
+}
+
+// Lists the permissions of a custom admin role.
+func (m *ApiSupplement) ListCustomRolePermissions(ctx context.Context, roleID string) ([]*CustomRolePermission, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/roles/%s/permissions", roleID)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var page struct {
+		Permissions []*CustomRolePermission `json:"permissions"`
+	}
+	resp, err := m.RequestExecutor.Do(ctx, req, &page)
+	if err != nil {
+		return nil, resp, err
+	}
+	return page.Permissions, resp, nil
+}
+
+// Adds a permission to a custom admin role.
+func (m *ApiSupplement) AddCustomRolePermission(ctx context.Context, roleID, permission string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/roles/%s/permissions/%s", roleID, permission)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// Removes a permission from a custom admin role.
+func (m *ApiSupplement) DeleteCustomRolePermission(ctx context.Context, roleID, permission string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/roles/%s/permissions/%s", roleID, permission)
+	req, err := m.RequestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// Creates a resource set with the resources.
+func (m *ApiSupplement) CreateResourceSet(ctx context.Context, body ResourceSet) (*ResourceSet, *okta.Response, error) {
+	url := "/api/v1/iam/resource-sets"
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var set ResourceSet
+	resp, err := m.RequestExecutor.Do(ctx, req, &set)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &set, resp, nil
+}
+
+// Gets a resource set.
+func (m *ApiSupplement) GetResourceSet(ctx context.Context, setID string) (*ResourceSet, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/resource-sets/%s", setID)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var set ResourceSet
+	resp, err := m.RequestExecutor.Do(ctx, req, &set)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &set, resp, nil
+}
+
+// Updates the label and the description of a resource set, the resources are managed separately.
+func (m *ApiSupplement) UpdateResourceSet(ctx context.Context, setID string, body ResourceSet) (*ResourceSet, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/resource-sets/%s", setID)
+	body.Resources = nil
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var set ResourceSet
+	resp, err := m.RequestExecutor.Do(ctx, req, &set)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &set, resp, nil
+}
+
+// Deletes a resource set.
+func (m *ApiSupplement) DeleteResourceSet(ctx context.Context, setID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/resource-sets/%s", setID)
+	req, err := m.RequestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// Lists all the resources of a resource set.
+func (m *ApiSupplement) ListResourceSetResources(ctx context.Context, setID string) ([]*IAMObject, *okta.Response, error) {
+	return m.listIAMObjects(ctx, fmt.Sprintf("/api/v1/iam/resource-sets/%s/resources", setID), "resources")
+}
+
+// Adds the resources to a resource set.
+func (m *ApiSupplement) AddResourceSetResources(ctx context.Context, setID string, body IAMAdditions) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/resource-sets/%s/resources", setID)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("PATCH", url, body)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// Removes a resource from a resource set.
+func (m *ApiSupplement) DeleteResourceSetResource(ctx context.Context, setID, resourceID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/resource-sets/%s/resources/%s", setID, resourceID)
+	req, err := m.RequestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// Assigns a custom admin role to the members over the resources of a resource set.
+func (m *ApiSupplement) CreateResourceSetBinding(ctx context.Context, setID string, body ResourceSetBinding) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/resource-sets/%s/bindings", setID)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// Gets the assignment of a custom admin role over the resources of a resource set.
+func (m *ApiSupplement) GetResourceSetBinding(ctx context.Context, setID, roleID string) (*IAMObject, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/resource-sets/%s/bindings/%s", setID, roleID)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var binding IAMObject
+	resp, err := m.RequestExecutor.Do(ctx, req, &binding)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &binding, resp, nil
+}
+
+// Removes the assignment of a custom admin role over the resources of a resource set from all the members.
+func (m *ApiSupplement) DeleteResourceSetBinding(ctx context.Context, setID, roleID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/resource-sets/%s/bindings/%s", setID, roleID)
+	req, err := m.RequestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// Lists all the members of the custom admin role assignment.
+func (m *ApiSupplement) ListResourceSetBindingMembers(ctx context.Context, setID, roleID string) ([]*IAMObject, *okta.Response, error) {
+	return m.listIAMObjects(ctx, fmt.Sprintf("/api/v1/iam/resource-sets/%s/bindings/%s/members", setID, roleID), "members")
+}
+
+// Adds the members to the custom admin role assignment.
+func (m *ApiSupplement) AddResourceSetBindingMembers(ctx context.Context, setID, roleID string, body IAMAdditions) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/resource-sets/%s/bindings/%s/members", setID, roleID)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("PATCH", url, body)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// Removes a member from the custom admin role assignment.
+func (m *ApiSupplement) DeleteResourceSetBindingMember(ctx context.Context, setID, roleID, memberID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/iam/resource-sets/%s/bindings/%s/members/%s", setID, roleID, memberID)
+	req, err := m.RequestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+// listIAMObjects lists the objects under the given key of all the pages.
+func (m *ApiSupplement) listIAMObjects(ctx context.Context, path, key string) ([]*IAMObject, *okta.Response, error) {
+	var objects []*IAMObject
+	resp, err := m.listIAMPages(ctx, path, key, func(raw json.RawMessage) error {
+		var pageObjects []*IAMObject
+		if err := json.Unmarshal(raw, &pageObjects); err != nil {
+			return err
+		}
+		objects = append(objects, pageObjects...)
+		return nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	return objects, resp, nil
+}
+
+// listIAMPages follows the 'next' links in the body of the response, since the IAM API does not use the 'Link'
+// header for the pagination. The raw value under the given key of every page is passed to the collect function.
+func (m *ApiSupplement) listIAMPages(ctx context.Context, path, key string, collect func(json.RawMessage) error) (*okta.Response, error) {
+	var resp *okta.Response
+	for path != "" {
+		req, err := m.RequestExecutor.NewRequest("GET", path, nil)
+		if err != nil {
+			return nil, err
+		}
+		var page map[string]json.RawMessage
+		resp, err = m.RequestExecutor.Do(ctx, req, &page)
+		if err != nil {
+			return resp, err
+		}
+		if raw, ok := page[key]; ok {
+			if err := collect(raw); err != nil {
+				return resp, err
+			}
+		}
+		var links IAMLinks
+		if raw, ok := page["_links"]; ok {
+			if err := json.Unmarshal(raw, &links); err != nil {
+				return resp, err
+			}
+		}
+		path = ""
+		if links.Next != nil {
+			next, err := url.Parse(links.Next.Href)
+			if err != nil {
+				return resp, err
+			}
+			path = next.RequestURI()
+		}
+	}
+	return resp, nil
+}
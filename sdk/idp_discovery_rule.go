@@ -57,7 +57,7 @@ type (
 	}
 
 	IdpDiscoveryRulePlatform struct {
-		Exclude []interface{}                      `json:"exclude,omitempty"`
+		Exclude []*IdpDiscoveryRulePlatformInclude `json:"exclude,omitempty"`
 		Include []*IdpDiscoveryRulePlatformInclude `json:"include,omitempty"`
 	}
 
@@ -139,3 +139,17 @@ func (m *ApiSupplement) GetIdpDiscoveryRule(ctx context.Context, policyID, id st
 	resp, err := m.RequestExecutor.Do(ctx, req, rule)
 	return rule, resp, err
 }
+
+func (m *ApiSupplement) ListIdpDiscoveryRules(ctx context.Context, policyID string) ([]*IdpDiscoveryRule, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%s/rules", policyID)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rules []*IdpDiscoveryRule
+	resp, err := m.RequestExecutor.Do(ctx, req, &rules)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rules, resp, nil
+}
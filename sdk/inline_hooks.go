@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// InlineHook extends okta.InlineHook with the OAuth 2.0 channel configuration, which is not supported by the official
+// SDK.
+type InlineHook struct {
+	*okta.InlineHook
+	Channel *InlineHookChannel `json:"channel,omitempty"`
+}
+
+type InlineHookChannel struct {
+	*okta.InlineHookChannel
+	Config *InlineHookChannelConfig `json:"config,omitempty"`
+}
+
+// InlineHookChannelConfig holds the client credentials of the OAUTH channel: 'clientSecret' is used with the
+// 'client_secret_post' auth type, and 'hookKeyId' with the 'private_key_jwt' one. The secret is never returned by the
+// API.
+type InlineHookChannelConfig struct {
+	*okta.InlineHookChannelConfig
+	AuthType     string `json:"authType,omitempty"`
+	ClientId     string `json:"clientId,omitempty"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+	HookKeyId    string `json:"hookKeyId,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	TokenUrl     string `json:"tokenUrl,omitempty"`
+}
+
+func (m *ApiSupplement) CreateInlineHook(ctx context.Context, body InlineHook) (*InlineHook, *okta.Response, error) {
+	url := "/api/v1/inlineHooks"
+	req, err := m.RequestExecutor.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var hook InlineHook
+	resp, err := m.RequestExecutor.Do(ctx, req, &hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &hook, resp, nil
+}
+
+func (m *ApiSupplement) GetInlineHook(ctx context.Context, id string) (*InlineHook, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/inlineHooks/%s", id)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var hook InlineHook
+	resp, err := m.RequestExecutor.Do(ctx, req, &hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &hook, resp, nil
+}
+
+func (m *ApiSupplement) UpdateInlineHook(ctx context.Context, id string, body InlineHook) (*InlineHook, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/inlineHooks/%s", id)
+	req, err := m.RequestExecutor.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var hook InlineHook
+	resp, err := m.RequestExecutor.Do(ctx, req, &hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &hook, resp, nil
+}
@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/okta/okta-sdk-golang/v2/okta"
@@ -19,18 +20,112 @@ type (
 		Region  string `json:"region,omitempty"`
 	}
 
+	// NetworkZone is either IP, DYNAMIC or DYNAMIC_V2 (enhanced dynamic) zone. The 'locations' and 'asns' of the
+	// DYNAMIC zones are plain lists, while the DYNAMIC_V2 ones have the included and excluded values, so they are
+	// (un)marshalled depending on the type of the zone. For the DYNAMIC_V2 zones, Locations and Asns hold the
+	// included values.
 	NetworkZone struct {
-		Gateways  []*AddressObj `json:"gateways,omitempty"`
-		ID        string        `json:"id,omitempty"`
-		Locations []*Location   `json:"locations,omitempty"`
-		Name      string        `json:"name,omitempty"`
-		Proxies   []*AddressObj `json:"proxies,omitempty"`
-		System    bool          `json:"system,omitempty"`
-		Type      string        `json:"type,omitempty"`
-		Usage     string        `json:"usage,omitempty"`
+		Asns                []string                `json:"-"`
+		ExcludedLocations   []*Location             `json:"-"`
+		Gateways            []*AddressObj           `json:"gateways,omitempty"`
+		ID                  string                  `json:"id,omitempty"`
+		IPServiceCategories *NetworkZoneIncludeList `json:"ipServiceCategories,omitempty"`
+		Locations           []*Location             `json:"-"`
+		Name                string                  `json:"name,omitempty"`
+		Proxies             []*AddressObj           `json:"proxies,omitempty"`
+		Status              string                  `json:"status,omitempty"`
+		System              bool                    `json:"system,omitempty"`
+		Type                string                  `json:"type,omitempty"`
+		Usage               string                  `json:"usage,omitempty"`
 	}
+
+	NetworkZoneIncludeList struct {
+		Include []string `json:"include,omitempty"`
+		Exclude []string `json:"exclude,omitempty"`
+	}
+
+	networkZoneLocations struct {
+		Include []*Location `json:"include,omitempty"`
+		Exclude []*Location `json:"exclude,omitempty"`
+	}
+
+	networkZoneAlias NetworkZone
 )
 
+const NetworkZoneTypeDynamicV2 = "DYNAMIC_V2"
+
+func (z NetworkZone) MarshalJSON() ([]byte, error) {
+	alias := networkZoneAlias(z)
+	body := struct {
+		*networkZoneAlias
+		Asns      interface{} `json:"asns,omitempty"`
+		Locations interface{} `json:"locations,omitempty"`
+	}{networkZoneAlias: &alias}
+	if z.Type == NetworkZoneTypeDynamicV2 {
+		if len(z.Asns) != 0 {
+			body.Asns = &NetworkZoneIncludeList{Include: z.Asns}
+		}
+		if len(z.Locations) != 0 || len(z.ExcludedLocations) != 0 {
+			body.Locations = &networkZoneLocations{Include: z.Locations, Exclude: z.ExcludedLocations}
+		}
+	} else {
+		if len(z.Asns) != 0 {
+			body.Asns = z.Asns
+		}
+		if len(z.Locations) != 0 {
+			body.Locations = z.Locations
+		}
+	}
+	return json.Marshal(body)
+}
+
+func (z *NetworkZone) UnmarshalJSON(data []byte) error {
+	body := struct {
+		*networkZoneAlias
+		Asns      json.RawMessage `json:"asns,omitempty"`
+		Locations json.RawMessage `json:"locations,omitempty"`
+	}{networkZoneAlias: (*networkZoneAlias)(z)}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	z.Asns, z.Locations, z.ExcludedLocations = nil, nil, nil
+	if isJSONObject(body.Asns) {
+		var asns NetworkZoneIncludeList
+		if err := json.Unmarshal(body.Asns, &asns); err != nil {
+			return err
+		}
+		z.Asns = asns.Include
+	} else if len(body.Asns) != 0 {
+		if err := json.Unmarshal(body.Asns, &z.Asns); err != nil {
+			return err
+		}
+	}
+	if isJSONObject(body.Locations) {
+		var locations networkZoneLocations
+		if err := json.Unmarshal(body.Locations, &locations); err != nil {
+			return err
+		}
+		z.Locations, z.ExcludedLocations = locations.Include, locations.Exclude
+	} else if len(body.Locations) != 0 {
+		if err := json.Unmarshal(body.Locations, &z.Locations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isJSONObject(data json.RawMessage) bool {
+	for _, b := range data {
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		default:
+			return b == '{'
+		}
+	}
+	return false
+}
+
 func (m *ApiSupplement) CreateNetworkZone(ctx context.Context, body *NetworkZone, qp *query.Params) (*NetworkZone, *okta.Response, error) {
 	url := "/api/v1/zones"
 	if qp != nil {
@@ -102,3 +197,25 @@ func (m *ApiSupplement) UpdateNetworkZone(ctx context.Context, id string, body N
 	}
 	return &zone, resp, nil
 }
+
+func (m *ApiSupplement) ActivateNetworkZone(ctx context.Context, id string) (*NetworkZone, *okta.Response, error) {
+	return m.networkZoneLifecycle(ctx, id, "activate")
+}
+
+func (m *ApiSupplement) DeactivateNetworkZone(ctx context.Context, id string) (*NetworkZone, *okta.Response, error) {
+	return m.networkZoneLifecycle(ctx, id, "deactivate")
+}
+
+func (m *ApiSupplement) networkZoneLifecycle(ctx context.Context, id, action string) (*NetworkZone, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/zones/%s/lifecycle/%s", id, action)
+	req, err := m.RequestExecutor.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	zone := &NetworkZone{}
+	resp, err := m.RequestExecutor.Do(ctx, req, zone)
+	if err != nil {
+		return nil, resp, err
+	}
+	return zone, resp, nil
+}
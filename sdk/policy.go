@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 const (
@@ -15,6 +16,8 @@ const (
 	MfaPolicyType                = "MFA_ENROLL"
 	IdpDiscoveryType             = "IDP_DISCOVERY"
 	OauthAuthorizationPolicyType = "OAUTH_AUTHORIZATION_POLICY"
+	ProfileEnrollmentPolicyType  = "PROFILE_ENROLLMENT"
+	AccessPolicyType             = "ACCESS_POLICY"
 )
 
 // Return the PasswordPolicy object. Used to create & update the password policy
@@ -33,6 +36,11 @@ func MfaPolicy() Policy {
 	return Policy{Type: MfaPolicyType}
 }
 
+// Return the AccessPolicy object. Used to create & update the app sign-on policy
+func AccessPolicy() Policy {
+	return Policy{Type: AccessPolicyType}
+}
+
 type Policy struct {
 	Embedded    interface{}                `json:"_embedded,omitempty"`
 	Links       interface{}                `json:"_links,omitempty"`
@@ -50,10 +58,12 @@ type Policy struct {
 }
 
 type PolicySettings struct {
-	Factors    *PolicyFactorsSettings                 `json:"factors,omitempty"`
-	Delegation *okta.PasswordPolicyDelegationSettings `json:"delegation,omitempty"`
-	Password   *PasswordPolicyPasswordSettings        `json:"password,omitempty"`
-	Recovery   *PasswordPolicyRecoverySettings        `json:"recovery,omitempty"`
+	Type           string                                 `json:"type,omitempty"`
+	Authenticators []*PolicyAuthenticator                 `json:"authenticators,omitempty"`
+	Factors        *PolicyFactorsSettings                 `json:"factors,omitempty"`
+	Delegation     *okta.PasswordPolicyDelegationSettings `json:"delegation,omitempty"`
+	Password       *PasswordPolicyPasswordSettings        `json:"password,omitempty"`
+	Recovery       *PasswordPolicyRecoverySettings        `json:"recovery,omitempty"`
 }
 
 type PasswordPolicyPasswordSettings struct {
@@ -142,6 +152,15 @@ type PolicyFactorsSettings struct {
 	Hotp         *PolicyFactor `json:"hotp,omitempty"`
 }
 
+// AuthenticatorsPolicySettingsType is the type of the MFA enrollment policy settings in Okta Identity Engine orgs,
+// which contain the authenticators instead of the factors.
+const AuthenticatorsPolicySettingsType = "AUTHENTICATORS"
+
+type PolicyAuthenticator struct {
+	Key    string  `json:"key"`
+	Enroll *Enroll `json:"enroll,omitempty"`
+}
+
 type PolicyFactor struct {
 	Consent *Consent `json:"consent,omitempty"`
 	Enroll  *Enroll  `json:"enroll,omitempty"`
@@ -205,3 +224,51 @@ func (m *ApiSupplement) CreatePolicy(ctx context.Context, body Policy) (*Policy,
 	}
 	return &policy, resp, nil
 }
+
+// Lists the applications mapped to a policy.
+func (m *ApiSupplement) ListPolicyApps(ctx context.Context, policyID string, qp *query.Params) ([]*okta.Application, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%v/app", policyID)
+	if qp != nil {
+		url += qp.String()
+	}
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var apps []*okta.Application
+	resp, err := m.RequestExecutor.Do(ctx, req, &apps)
+	if err != nil {
+		return nil, resp, err
+	}
+	return apps, resp, nil
+}
+
+// Creates a policy from its raw representation, so the attributes unknown to the Okta SDK are kept.
+func (m *ApiSupplement) CreateRawPolicy(ctx context.Context, body map[string]interface{}) (map[string]interface{}, *okta.Response, error) {
+	url := "/api/v1/policies"
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var policy map[string]interface{}
+	resp, err := m.RequestExecutor.Do(ctx, req, &policy)
+	if err != nil {
+		return nil, resp, err
+	}
+	return policy, resp, nil
+}
+
+// Updates a policy with its raw representation, so the attributes unknown to the Okta SDK are kept.
+func (m *ApiSupplement) UpdateRawPolicy(ctx context.Context, policyID string, body map[string]interface{}) (map[string]interface{}, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%v", policyID)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var policy map[string]interface{}
+	resp, err := m.RequestExecutor.Do(ctx, req, &policy)
+	if err != nil {
+		return nil, resp, err
+	}
+	return policy, resp, nil
+}
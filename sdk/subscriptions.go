@@ -0,0 +1,53 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type Subscription struct {
+	NotificationType string   `json:"notificationType,omitempty"`
+	Channels         []string `json:"channels,omitempty"`
+	Status           string   `json:"status,omitempty"`
+}
+
+const (
+	SubscriptionStatusSubscribed   = "subscribed"
+	SubscriptionStatusUnsubscribed = "unsubscribed"
+)
+
+// GetUserSubscription gets the subscription of the admin user to the given notification type
+func (m *ApiSupplement) GetUserSubscription(ctx context.Context, userID, notificationType string) (*Subscription, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/users/%s/subscriptions/%s", userID, notificationType)
+	req, err := m.RequestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var subscription Subscription
+	resp, err := m.RequestExecutor.Do(ctx, req, &subscription)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &subscription, resp, nil
+}
+
+// SubscribeUser subscribes the admin user to the given notification type
+func (m *ApiSupplement) SubscribeUser(ctx context.Context, userID, notificationType string) (*okta.Response, error) {
+	return m.userSubscriptionLifecycle(ctx, userID, notificationType, "subscribe")
+}
+
+// UnsubscribeUser unsubscribes the admin user from the given notification type
+func (m *ApiSupplement) UnsubscribeUser(ctx context.Context, userID, notificationType string) (*okta.Response, error) {
+	return m.userSubscriptionLifecycle(ctx, userID, notificationType, "unsubscribe")
+}
+
+func (m *ApiSupplement) userSubscriptionLifecycle(ctx context.Context, userID, notificationType, action string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/users/%s/subscriptions/%s/%s", userID, notificationType, action)
+	req, err := m.RequestExecutor.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
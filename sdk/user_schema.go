@@ -34,10 +34,12 @@ type (
 		Custom *UserSubSchemaProperties `json:"custom,omitempty"`
 	}
 
+	// UserSchemaItem describes the items of the 'array' property, 'Properties' are only set for items of type 'object'.
 	UserSchemaItem struct {
-		Enum  []string          `json:"enum,omitempty"`
-		OneOf []*UserSchemaEnum `json:"oneOf,omitempty"`
-		Type  string            `json:"type,omitempty"`
+		Enum       []string                  `json:"enum,omitempty"`
+		OneOf      []*UserSchemaEnum         `json:"oneOf,omitempty"`
+		Properties map[string]*UserSubSchema `json:"properties,omitempty"`
+		Type       string                    `json:"type,omitempty"`
 	}
 
 	UserSchemaMaster struct {